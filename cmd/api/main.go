@@ -7,7 +7,9 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
@@ -21,9 +23,11 @@ import (
 	guestREST "github.com/luiszkm/wedding_backend/internal/guest/interfaces/rest"
 
 	giftApp "github.com/luiszkm/wedding_backend/internal/gift/application"
+	giftDomain "github.com/luiszkm/wedding_backend/internal/gift/domain"
 	giftInfra "github.com/luiszkm/wedding_backend/internal/gift/infrastructure"
 	giftREST "github.com/luiszkm/wedding_backend/internal/gift/interfaces/rest"
 	"github.com/luiszkm/wedding_backend/internal/platform/auth"
+	"github.com/luiszkm/wedding_backend/internal/platform/ratelimit"
 	"github.com/luiszkm/wedding_backend/internal/platform/storage"
 
 	mbApp "github.com/luiszkm/wedding_backend/internal/messageboard/application"
@@ -53,6 +57,14 @@ import (
 	itineraryApp "github.com/luiszkm/wedding_backend/internal/itinerary/application"
 	itineraryInfra "github.com/luiszkm/wedding_backend/internal/itinerary/infrastructure"
 	itineraryREST "github.com/luiszkm/wedding_backend/internal/itinerary/interfaces/rest"
+
+	seatingApp "github.com/luiszkm/wedding_backend/internal/seating/application"
+	seatingInfra "github.com/luiszkm/wedding_backend/internal/seating/infrastructure"
+	seatingREST "github.com/luiszkm/wedding_backend/internal/seating/interfaces/rest"
+
+	pageTemplateApp "github.com/luiszkm/wedding_backend/internal/pagetemplate/application"
+	pageTemplateREST "github.com/luiszkm/wedding_backend/internal/pagetemplate/interfaces/rest"
+	platformTemplate "github.com/luiszkm/wedding_backend/internal/platform/template"
 )
 
 func main() {
@@ -72,6 +84,55 @@ func main() {
 	stripe.Key = os.Getenv("STRIPE_SECRET_KEY")
 	// Lemos o segredo do webhook aqui, uma única vez.
 	stripeWebhookSecret := os.Getenv("STRIPE_WEBHOOK_SECRET")
+	templatesDir := os.Getenv("TEMPLATES_DIR")
+	if templatesDir == "" {
+		templatesDir = "templates"
+	}
+	rateLimitStore := os.Getenv("RATE_LIMIT_STORE")
+	// Endereço do site público, usado nos links e QR codes dos convites.
+	sitePublicoURL := os.Getenv("SITE_PUBLICO_URL")
+	if sitePublicoURL == "" {
+		sitePublicoURL = "http://localhost:3000"
+	}
+	// Validade dos tokens de acesso embutidos nos links de convite.
+	validadeTokenConvidado := 365 * 24 * time.Hour
+	if dias := os.Getenv("TOKEN_CONVIDADO_VALIDADE_DIAS"); dias != "" {
+		n, err := strconv.Atoi(dias)
+		if err != nil || n <= 0 {
+			log.Fatalf("TOKEN_CONVIDADO_VALIDADE_DIAS inválido: %q", dias)
+		}
+		validadeTokenConvidado = time.Duration(n) * 24 * time.Hour
+	}
+	// Por quanto tempo uma reserva segura cotas e presentes para o convidado.
+	duracaoReservaPresentes := giftApp.DuracaoReservaPadrao
+	if minutos := os.Getenv("RESERVA_PRESENTES_MINUTOS"); minutos != "" {
+		n, err := strconv.Atoi(minutos)
+		if err != nil || n <= 0 {
+			log.Fatalf("RESERVA_PRESENTES_MINUTOS inválido: %q", minutos)
+		}
+		duracaoReservaPresentes = time.Duration(n) * time.Minute
+	}
+	// Pagamento online das seleções de presentes: stripe, fake ou desativado.
+	gatewayPresentes := os.Getenv("PAGAMENTO_PRESENTES_GATEWAY")
+	if gatewayPresentes == "" {
+		gatewayPresentes = "desativado"
+		if stripe.Key != "" {
+			gatewayPresentes = "stripe"
+		}
+	}
+	webhookSecretPresentes := os.Getenv("PAGAMENTO_PRESENTES_WEBHOOK_SECRET")
+	urlRetornoPagamento := os.Getenv("PAGAMENTO_PRESENTES_URL_RETORNO")
+	if urlRetornoPagamento == "" {
+		urlRetornoPagamento = strings.TrimRight(sitePublicoURL, "/") + "/presentes/pagamento"
+	}
+	validadePagamento := 60 * time.Minute
+	if minutos := os.Getenv("PAGAMENTO_PRESENTES_VALIDADE_MINUTOS"); minutos != "" {
+		n, err := strconv.Atoi(minutos)
+		if err != nil || n <= 0 {
+			log.Fatalf("PAGAMENTO_PRESENTES_VALIDADE_MINUTOS inválido: %q", minutos)
+		}
+		validadePagamento = time.Duration(n) * time.Minute
+	}
 
 	// CORS configuration
 	corsAllowedOrigins := os.Getenv("CORS_ALLOWED_ORIGINS")
@@ -107,8 +168,13 @@ func main() {
 
 	// --- Repositórios ---
 	guestRepo := guestInfra.NewPostgresGroupRepository(dbpool)
+	formularioRSVPRepo := guestInfra.NewPostgresFormularioRSVPRepository(dbpool)
+	historicoRSVPRepo := guestInfra.NewPostgresHistoricoRSVPRepository(dbpool)
+	etiquetaRepo := guestInfra.NewPostgresEtiquetaRepository(dbpool)
+	perfilConvidadoRepo := guestInfra.NewPostgresPerfilConvidadoRepository(dbpool)
 	presenteRepo := giftInfra.NewPostgresPresenteRepository(dbpool)
 	selecaoRepo := giftInfra.NewPostgresSelecaoRepository(dbpool) // Novo repo
+	pagamentoRepo := giftInfra.NewPostgresPagamentoRepository(dbpool)
 	recadoRepo := mbInfra.NewPostgresRecadoRepository(dbpool)
 	fotoRepo := galleryInfra.NewPostgresFotoRepository(dbpool)
 	usuarioRepo := iamInfra.NewPostgresUsuarioRepository(dbpool)
@@ -117,10 +183,32 @@ func main() {
 	billingRepo := billingInfra.NewPostgresAssinaturaRepository(dbpool)
 	communicationRepo := communicationInfra.NewPostgresComunicadoRepository(dbpool)
 	itineraryRepo := itineraryInfra.NewPostgresItineraryRepository(dbpool)
+	mesaRepo := seatingInfra.NewPostgresMesaRepository(dbpool)
+	convidadoMesaRepo := seatingInfra.NewPostgresConvidadoRepository(dbpool)
 
 	// --- Serviços de Aplicação ---
-	guestService := guestApp.NewGuestService(guestRepo)
-	presenteService := giftApp.NewGiftService(presenteRepo, selecaoRepo, eventRepo)
+	guestService := guestApp.NewGuestService(guestRepo, formularioRSVPRepo, historicoRSVPRepo, etiquetaRepo, perfilConvidadoRepo)
+	conviteService := guestApp.NewConviteService(guestRepo, eventRepo, jwtService, sitePublicoURL, validadeTokenConvidado)
+	var gatewaySelecoes giftDomain.PaymentGateway
+	switch gatewayPresentes {
+	case "stripe":
+		// A Stripe só aceita checkouts que expiram entre 30 minutos e 24 horas.
+		if validadePagamento <= 30*time.Minute || validadePagamento > 24*time.Hour {
+			log.Fatalf("PAGAMENTO_PRESENTES_VALIDADE_MINUTOS deve ficar entre 31 e 1440 com a Stripe")
+		}
+		gatewaySelecoes = giftInfra.NewStripeGateway(stripe.Key, webhookSecretPresentes, urlRetornoPagamento, validadePagamento)
+	case "fake":
+		gatewaySelecoes = giftInfra.NewFakePaymentGateway(webhookSecretPresentes, validadePagamento)
+	case "desativado":
+		log.Println("Pagamento online de presentes desativado.")
+	default:
+		log.Fatalf("PAGAMENTO_PRESENTES_GATEWAY inválido: %q", gatewayPresentes)
+	}
+	if gatewaySelecoes != nil && webhookSecretPresentes == "" {
+		log.Fatalf("PAGAMENTO_PRESENTES_WEBHOOK_SECRET é obrigatório com PAGAMENTO_PRESENTES_GATEWAY=%s", gatewayPresentes)
+	}
+	pagamentoService := giftApp.NewPagamentoService(pagamentoRepo, gatewaySelecoes, validadePagamento)
+	presenteService := giftApp.NewGiftService(presenteRepo, selecaoRepo, eventRepo, pagamentoService, duracaoReservaPresentes)
 	recadoService := mbApp.NewMessageBoardService(recadoRepo, guestRepo, eventRepo)
 	galleryService := galleryApp.NewGalleryService(fotoRepo, storageSvc)
 	iamService := iamApp.NewIAMService(usuarioRepo, jwtService)
@@ -128,17 +216,30 @@ func main() {
 	billingService := billingApp.NewBillingService(planoRepo, billingRepo, paymentGateway)
 	communicationService := communicationApp.NewCommunicationService(communicationRepo, eventRepo)
 	itineraryService := itineraryApp.NewItineraryService(itineraryRepo)
+	seatingService := seatingApp.NewSeatingService(mesaRepo, convidadoMesaRepo)
+	templateEngine := platformTemplate.NewGoTemplateEngine(templatesDir)
+	pageTemplateService := pageTemplateApp.NewPageTemplateService(eventRepo, presenteRepo, recadoRepo, fotoRepo, itineraryRepo, communicationRepo)
 
 	// --- Handlers ---
-	guestHandler := guestREST.NewGuestHandler(guestService)
-	presenteHandler := giftREST.NewGiftHandler(presenteService, storageSvc)
-	recadoHandler := mbREST.NewMessageBoardHandler(recadoService)
+	guestHandler := guestREST.NewGuestHandler(guestService, conviteService)
+	conviteHandler := guestREST.NewConviteHandler(conviteService)
+	presenteHandler := giftREST.NewGiftHandler(presenteService, storageSvc, conviteService, pagamentoService)
+	recadoHandler := mbREST.NewMessageBoardHandler(recadoService, conviteService)
 	galleryHandler := galleryREST.NewGalleryHandler(galleryService)
 	iamHandler := iamREST.NewIAMHandler(iamService)
 	eventHandler := eventREST.NewEventHandler(eventService)
 	billingHandler := billingREST.NewBillingHandler(billingService, stripeWebhookSecret)
 	communicationHandler := communicationREST.NewCommunicationHandler(communicationService)
 	itineraryHandler := itineraryREST.NewItineraryHandler(itineraryService)
+	seatingHandler := seatingREST.NewSeatingHandler(seatingService)
+	pageTemplateHandler := pageTemplateREST.NewPageTemplateHandler(pageTemplateService, templateEngine)
+
+	// --- Limitador das rotas com chave de acesso ---
+	var tentativasStore ratelimit.Store = ratelimit.NewMemoryStore()
+	if rateLimitStore == "postgres" {
+		tentativasStore = ratelimit.NewPostgresStore(dbpool)
+	}
+	limitador := ratelimit.NewLimitador(tentativasStore, ratelimit.ConfigPadrao())
 
 	// --- Roteador e Rotas ---
 	r := chi.NewRouter()
@@ -164,13 +265,23 @@ func main() {
 		r.Post("/usuarios/login", iamHandler.HandleLogin)
 		r.Get("/eventos/{idCasamento}/recados/publico", recadoHandler.HandleListarRecadosPublicos)
 		r.Get("/eventos/{idCasamento}/presentes-publico", presenteHandler.HandleListarPresentesPublicos)
+		r.Get("/eventos/{idCasamento}/presentes-publico/{idPresente}/pix", presenteHandler.HandleObterPix)
+		r.Get("/eventos/{idCasamento}/presentes-publico/{idPresente}/pix/qrcode", presenteHandler.HandleObterQRCodePix)
 		r.Get("/eventos/{idEvento}/comunicados", communicationHandler.HandleListarComunicados)
 		r.Get("/eventos/{idEvento}/roteiro", itineraryHandler.HandleGetItinerary) // Rota pública do roteiro
-		r.Post("/rsvps", guestHandler.HandleConfirmarPresenca)
+		r.Get("/eventos/{urlSlug}/pagina", pageTemplateHandler.HandleRenderizarPagina)
+		r.With(limitador.Proteger("rsvps", ratelimit.EventoDoCorpoJSON("idEvento"))).Post("/rsvps", guestHandler.HandleConfirmarPresenca)
 		r.Get("/planos", billingHandler.HandleListarPlanos)                       // Nova rota pública
 		r.Post("/webhooks/stripe", billingHandler.HandleStripeWebhook)            // <-- Rota do Webhook
-		r.Get("/acesso-convidado", guestHandler.HandleObterGrupoPorChaveDeAcesso) // acesso convidado
-		r.Post("/selecoes-de-presente", presenteHandler.HandleFinalizarSelecao)
+		r.Post("/webhooks/pagamentos-de-presentes", presenteHandler.HandleWebhookPagamento)
+		r.Get("/selecoes-de-presente/{idSelecao}/pagamento", presenteHandler.HandleObterPagamentoSelecao)
+		r.With(limitador.Proteger("acesso-convidado", ratelimit.EventoDaQuery("idEvento"))).Get("/acesso-convidado", guestHandler.HandleObterGrupoPorChaveDeAcesso) // acesso convidado
+		r.With(limitador.Proteger("perfil-convidado", ratelimit.EventoDoCorpoJSON("idEvento"))).Put("/acesso-convidado/perfil", guestHandler.HandleAtualizarPerfilConvidado)
+		r.With(limitador.Proteger("selecoes-de-presente", ratelimit.EventoDoCorpoJSON("idEvento"))).Post("/selecoes-de-presente", presenteHandler.HandleFinalizarSelecao)
+		r.With(limitador.Proteger("reservas-de-presente", ratelimit.EventoDoCorpoJSON("idEvento"))).Post("/reservas-de-presente", presenteHandler.HandleReservarPresentes)
+		r.With(limitador.Proteger("selecoes-de-presente", ratelimit.EventoDoCorpoJSON("idEvento"))).Put("/selecoes-de-presente/{idSelecao}", presenteHandler.HandleEditarSelecao)
+		r.With(limitador.Proteger("selecoes-de-presente", ratelimit.EventoDoCorpoJSON("idEvento"))).Post("/selecoes-de-presente/{idSelecao}/cancelamento", presenteHandler.HandleCancelarSelecao)
+		r.With(limitador.Proteger("recados", ratelimit.EventoDoCorpoJSON("idEvento"))).Post("/recados", recadoHandler.HandleDeixarRecado)
 		// ... outras rotas públicas
 		// --- Rotas Protegidas ---
 		// Todas as rotas dentro deste grupo exigirão um token JWT válido.
@@ -179,18 +290,47 @@ func main() {
 
 			r.Post("/eventos/{idCasamento}/grupos-de-convidados", guestHandler.HandleCriarGrupoDeConvidados)
 			r.Get("/eventos/{idEvento}/grupos-de-convidados", guestHandler.HandleListarGruposPorEvento)
+			r.Post("/eventos/{idEvento}/grupos-de-convidados/importacao", guestHandler.HandleImportarGrupos)
+			r.Get("/eventos/{idEvento}/grupos-de-convidados/exportacao", guestHandler.HandleExportarConvidados)
+			r.Post("/eventos/{idEvento}/grupos-de-convidados/chaves-de-acesso/regeneracao", guestHandler.HandleRegenerarChavesDeAcesso)
 			r.Get("/grupos-de-convidados/{idGrupo}", guestHandler.HandleObterGrupoPorID)
 			r.Put("/grupos-de-convidados/{idGrupo}", guestHandler.HandleRevisarGrupo)
 			r.Delete("/grupos-de-convidados/{idGrupo}", guestHandler.HandleRemoverGrupo)
 			r.Get("/eventos/{idEvento}/rsvp-stats", guestHandler.HandleObterEstatisticasRSVP)
+			r.Get("/eventos/{idEvento}/relatorio-buffet", guestHandler.HandleObterRelatorioBuffet)
+			r.Get("/eventos/{idEvento}/formulario-rsvp", guestHandler.HandleObterFormularioRSVP)
+			r.Put("/eventos/{idEvento}/formulario-rsvp", guestHandler.HandleDefinirFormularioRSVP)
+			r.Get("/eventos/{idEvento}/rsvps-atrasados", guestHandler.HandleListarRSVPsAtrasados)
+			r.Post("/grupos-de-convidados/{idGrupo}/rsvp", guestHandler.HandleRegistrarRSVPAnfitriao)
+			r.Put("/grupos-de-convidados/{idGrupo}/prazo-rsvp", guestHandler.HandleEstenderPrazoRSVP)
+			r.Get("/grupos-de-convidados/{idGrupo}/historico-rsvp", guestHandler.HandleListarHistoricoRSVPGrupo)
+			r.Get("/eventos/{idEvento}/historico-rsvp", guestHandler.HandleListarHistoricoRSVPEvento)
+			r.Get("/eventos/{idEvento}/etiquetas", guestHandler.HandleListarEtiquetas)
+			r.Post("/eventos/{idEvento}/etiquetas", guestHandler.HandleCriarEtiqueta)
+			r.Put("/etiquetas/{idEtiqueta}", guestHandler.HandleRenomearEtiqueta)
+			r.Delete("/etiquetas/{idEtiqueta}", guestHandler.HandleRemoverEtiqueta)
+			r.Put("/grupos-de-convidados/{idGrupo}/etiquetas", guestHandler.HandleDefinirEtiquetasGrupo)
+			r.Post("/grupos-de-convidados/{idGrupo}/juncao", guestHandler.HandleJuntarGrupos)
+			r.Post("/grupos-de-convidados/{idGrupo}/divisao", guestHandler.HandleDividirGrupo)
+			r.Post("/grupos-de-convidados/{idGrupo}/convidados/{idConvidado}/remocao", guestHandler.HandleRemoverConvidado)
+			r.Post("/grupos-de-convidados/{idGrupo}/convidados/{idConvidado}/restauracao", guestHandler.HandleRestaurarConvidado)
+			r.Get("/grupos-de-convidados/{idGrupo}/qrcode", conviteHandler.HandleObterQRCodeGrupo)
+			r.Get("/grupos-de-convidados/{idGrupo}/link-de-acesso", conviteHandler.HandleObterLinkDeAcesso)
+			r.Get("/eventos/{idEvento}/convites", conviteHandler.HandleGerarConvitesPDF)
+			r.Get("/eventos/{idEvento}/perfil-convidado", guestHandler.HandleObterConfiguracaoPerfil)
+			r.Put("/eventos/{idEvento}/perfil-convidado", guestHandler.HandleDefinirConfiguracaoPerfil)
+			r.Get("/eventos/{idEvento}/alteracoes-perfil", guestHandler.HandleListarAlteracoesPerfil)
+			r.Put("/alteracoes-perfil/{idAlteracao}/revisao", guestHandler.HandleMarcarAlteracaoPerfilRevisada)
 			// rota de presentes
 			r.Post("/eventos/{idCasamento}/presentes", presenteHandler.HandleCriarPresente)
 			r.Get("/eventos/{idCasamento}/presentes", presenteHandler.HandleListarPresentesAdmin)
 			r.Put("/eventos/{idCasamento}/presentes/{idPresente}", presenteHandler.HandleAtualizarPresente)
 			r.Delete("/eventos/{idCasamento}/presentes/{idPresente}", presenteHandler.HandleDeletarPresente)
+			r.Get("/eventos/{idCasamento}/selecoes-de-presente/historico", presenteHandler.HandleListarAlteracoesDeSelecoes)
+			r.Put("/eventos/{idCasamento}/selecoes-de-presente/{idSelecao}", presenteHandler.HandleEditarSelecaoDoEvento)
+			r.Delete("/eventos/{idCasamento}/selecoes-de-presente/{idSelecao}", presenteHandler.HandleCancelarSelecaoDoEvento)
 
 			//  rota de Recados
-			r.Post("/recados", recadoHandler.HandleDeixarRecado)
 			r.Get("/eventos/{idCasamento}/recados/admin", recadoHandler.HandleListarRecadosAdmin)
 			r.Patch("/recados/{idRecado}", recadoHandler.HandleModerarRecado)
 			// rota de Comunicados
@@ -201,6 +341,15 @@ func main() {
 			r.Post("/eventos/{idEvento}/roteiro", itineraryHandler.HandleCreateItineraryItem)
 			r.Put("/roteiro/{idItemRoteiro}", itineraryHandler.HandleUpdateItineraryItem)
 			r.Delete("/roteiro/{idItemRoteiro}", itineraryHandler.HandleDeleteItineraryItem)
+			// rotas de Mesas
+			r.Get("/eventos/{idEvento}/mesas", seatingHandler.HandleObterPlanoDeMesas)
+			r.Post("/eventos/{idEvento}/mesas", seatingHandler.HandleCriarMesa)
+			r.Post("/eventos/{idEvento}/mesas/distribuicao", seatingHandler.HandleDistribuirConvidados)
+			r.Get("/eventos/{idEvento}/mesas/exportacao", seatingHandler.HandleExportarPlanoDeMesas)
+			r.Put("/mesas/{idMesa}", seatingHandler.HandleEditarMesa)
+			r.Delete("/mesas/{idMesa}", seatingHandler.HandleRemoverMesa)
+			r.Put("/mesas/{idMesa}/convidados/{idConvidado}", seatingHandler.HandleSentarConvidado)
+			r.Delete("/mesas/{idMesa}/convidados/{idConvidado}", seatingHandler.HandleLevantarConvidado)
 			// rota de Galeria
 			r.Post("/eventos/{idCasamento}/fotos", galleryHandler.HandleFazerUpload)
 			r.Get("/eventos/{idCasamento}/fotos/publico", galleryHandler.HandleListarFotosPublicas)
@@ -217,9 +366,20 @@ func main() {
 			r.Get("/eventos", eventHandler.HandleListarEventosPorUsuario)
 			r.Get("/eventos/id/{id}", eventHandler.HandleObterEventoPorID)
 
+			// rotas de templates de página
+			r.Get("/templates/disponiveis", pageTemplateHandler.HandleListarTemplatesDisponiveis)
+			r.Put("/eventos/{idEvento}/template", eventHandler.HandleAtualizarTemplate)
+			r.Put("/eventos/{idEvento}/paleta", eventHandler.HandleAtualizarPaleta)
+			r.Put("/eventos/{idEvento}/prazo-rsvp", eventHandler.HandleDefinirPrazoRSVP)
+
 		})
 	})
 
+	// Libera as cotas de pagamentos que venceram sem notificação do gateway.
+	go pagamentoService.VarrerPagamentosVencidos(context.Background(), time.Minute)
+	// Devolve os itens das reservas que o convidado não concluiu.
+	go presenteService.VarrerReservasVencidas(context.Background(), time.Minute)
+
 	log.Printf("Servidor iniciado na porta %s", port)
 	if err := http.ListenAndServe(port, r); err != nil {
 		log.Fatalf("Falha ao iniciar o servidor: %v", err)
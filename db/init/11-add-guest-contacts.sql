@@ -0,0 +1,8 @@
+-- file: db/init/11-add-guest-contacts.sql
+-- Adiciona dados de contato aos convidados (preenchidos pela importação de planilhas)
+
+ALTER TABLE convidados ADD COLUMN IF NOT EXISTS telefone VARCHAR(30) DEFAULT NULL;
+ALTER TABLE convidados ADD COLUMN IF NOT EXISTS email VARCHAR(255) DEFAULT NULL;
+
+COMMENT ON COLUMN convidados.telefone IS 'Telefone do convidado, como informado pelo anfitrião';
+COMMENT ON COLUMN convidados.email IS 'E-mail do convidado';
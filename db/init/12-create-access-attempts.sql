@@ -0,0 +1,15 @@
+-- file: db/init/12-create-access-attempts.sql
+-- Tentativas nas rotas públicas protegidas por chave de acesso, usadas pelo
+-- limitador de requisições quando RATE_LIMIT_STORE=postgres
+
+CREATE TABLE IF NOT EXISTS tentativas_de_acesso (
+    id BIGSERIAL PRIMARY KEY,
+    chave VARCHAR(255) NOT NULL,
+    instante TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_tentativas_de_acesso_chave_instante ON tentativas_de_acesso (chave, instante);
+CREATE INDEX IF NOT EXISTS idx_tentativas_de_acesso_instante ON tentativas_de_acesso (instante);
+
+COMMENT ON TABLE tentativas_de_acesso IS 'Janela deslizante do limitador de tentativas (por IP e por evento)';
+COMMENT ON COLUMN tentativas_de_acesso.chave IS 'Contador, ex.: falha:ip:203.0.113.7 ou falha:evento:<uuid>';
@@ -0,0 +1,17 @@
+-- file: db/init/13-add-guest-companions.sql
+-- Acompanhantes: cada grupo pode trazer até N pessoas extras, nomeadas pelo próprio grupo no RSVP
+
+ALTER TABLE convidados_grupos ADD COLUMN IF NOT EXISTS limite_acompanhantes INTEGER NOT NULL DEFAULT 0
+    CHECK (limite_acompanhantes >= 0);
+
+CREATE TABLE IF NOT EXISTS convidados_acompanhantes (
+    id UUID PRIMARY KEY,
+    id_grupo UUID NOT NULL REFERENCES convidados_grupos(id) ON DELETE CASCADE,
+    nome VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT timezone('America/Sao_Paulo', now())
+);
+
+CREATE INDEX IF NOT EXISTS idx_convidados_acompanhantes_grupo ON convidados_acompanhantes(id_grupo);
+
+COMMENT ON COLUMN convidados_grupos.limite_acompanhantes IS 'Quantos acompanhantes sem convite nominal o grupo pode trazer';
+COMMENT ON TABLE convidados_acompanhantes IS 'Acompanhantes informados pelo grupo ao confirmar presença; todos estão confirmados';
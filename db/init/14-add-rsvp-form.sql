@@ -0,0 +1,33 @@
+-- file: db/init/14-add-rsvp-form.sql
+-- Formulário de RSVP: perguntas definidas por evento (prato, restrições alimentares,
+-- transporte...) e as respostas de cada convidado confirmado
+
+CREATE TABLE IF NOT EXISTS rsvp_perguntas (
+    id UUID PRIMARY KEY,
+    id_evento UUID NOT NULL REFERENCES eventos(id) ON DELETE CASCADE,
+    ordem INTEGER NOT NULL,
+    texto VARCHAR(500) NOT NULL,
+    tipo VARCHAR(20) NOT NULL CHECK (tipo IN ('TEXTO', 'ESCOLHA_UNICA', 'ESCOLHA_MULTIPLA', 'NUMERO')),
+    opcoes TEXT[] NOT NULL DEFAULT '{}',
+    obrigatoria BOOLEAN NOT NULL DEFAULT FALSE,
+    minimo INTEGER DEFAULT NULL,
+    maximo INTEGER DEFAULT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT timezone('America/Sao_Paulo', now()),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT timezone('America/Sao_Paulo', now())
+);
+
+CREATE INDEX IF NOT EXISTS idx_rsvp_perguntas_evento ON rsvp_perguntas(id_evento, ordem);
+
+CREATE TABLE IF NOT EXISTS convidados_respostas (
+    id_convidado UUID NOT NULL REFERENCES convidados(id) ON DELETE CASCADE,
+    id_pergunta UUID NOT NULL REFERENCES rsvp_perguntas(id) ON DELETE CASCADE,
+    valores TEXT[] NOT NULL,
+    PRIMARY KEY (id_convidado, id_pergunta)
+);
+
+CREATE INDEX IF NOT EXISTS idx_convidados_respostas_pergunta ON convidados_respostas(id_pergunta);
+
+COMMENT ON TABLE rsvp_perguntas IS 'Perguntas do formulário de RSVP de cada evento';
+COMMENT ON COLUMN rsvp_perguntas.opcoes IS 'Opções das perguntas de escolha; vazio para TEXTO e NUMERO';
+COMMENT ON TABLE convidados_respostas IS 'Respostas dos convidados confirmados ao formulário de RSVP';
+COMMENT ON COLUMN convidados_respostas.valores IS 'Um valor para TEXTO e NUMERO; as opções marcadas para perguntas de escolha';
@@ -0,0 +1,10 @@
+-- file: db/init/15-add-rsvp-deadline.sql
+-- Prazo de RSVP: depois dele o link público deixa de aceitar respostas
+
+ALTER TABLE eventos ADD COLUMN IF NOT EXISTS prazo_rsvp TIMESTAMP WITH TIME ZONE DEFAULT NULL;
+ALTER TABLE convidados_grupos ADD COLUMN IF NOT EXISTS prazo_rsvp_estendido TIMESTAMP WITH TIME ZONE DEFAULT NULL;
+ALTER TABLE convidados_grupos ADD COLUMN IF NOT EXISTS ultima_resposta_em TIMESTAMP WITH TIME ZONE DEFAULT NULL;
+
+COMMENT ON COLUMN eventos.prazo_rsvp IS 'Até quando os convidados podem responder pelo link público; NULL sem prazo';
+COMMENT ON COLUMN convidados_grupos.prazo_rsvp_estendido IS 'Prorrogação do prazo de RSVP concedida ao grupo';
+COMMENT ON COLUMN convidados_grupos.ultima_resposta_em IS 'Última confirmação ou recusa registrada para o grupo';
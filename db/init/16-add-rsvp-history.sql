@@ -0,0 +1,24 @@
+-- file: db/init/16-add-rsvp-history.sql
+-- Histórico de RSVP: cada resposta é acrescentada, nunca sobrescrita
+
+CREATE TABLE IF NOT EXISTS rsvp_historico (
+    id UUID PRIMARY KEY,
+    id_grupo UUID NOT NULL REFERENCES convidados_grupos(id) ON DELETE CASCADE,
+    -- Sem chave estrangeira: convidados removidos na revisão do grupo mantêm o histórico
+    id_convidado UUID NOT NULL,
+    nome_convidado VARCHAR(255) NOT NULL,
+    status_anterior VARCHAR(20) NOT NULL,
+    status_novo VARCHAR(20) NOT NULL,
+    canal VARCHAR(20) NOT NULL CHECK (canal IN ('CHAVE_DE_ACESSO', 'ANFITRIAO')),
+    id_usuario UUID REFERENCES usuarios(id) ON DELETE SET NULL,
+    ip VARCHAR(45),
+    user_agent TEXT,
+    registrado_em TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_rsvp_historico_grupo ON rsvp_historico(id_grupo, registrado_em);
+CREATE INDEX IF NOT EXISTS idx_rsvp_historico_convidado ON rsvp_historico(id_convidado, registrado_em);
+
+COMMENT ON TABLE rsvp_historico IS 'Trilha de auditoria das respostas de RSVP, por convidado';
+COMMENT ON COLUMN rsvp_historico.canal IS 'CHAVE_DE_ACESSO quando o grupo respondeu pelo link; ANFITRIAO quando o dono do evento registrou';
+COMMENT ON COLUMN rsvp_historico.id_usuario IS 'Usuário que registrou a resposta pelo canal ANFITRIAO';
@@ -0,0 +1,13 @@
+-- file: db/init/17-add-guest-metadata.sql
+-- Metadados do convidado usados na organização do evento
+
+ALTER TABLE convidados ADD COLUMN IF NOT EXISTS faixa_etaria VARCHAR(10) DEFAULT NULL
+    CHECK (faixa_etaria IN ('ADULTO', 'CRIANCA'));
+ALTER TABLE convidados ADD COLUMN IF NOT EXISTS lado VARCHAR(10) DEFAULT NULL
+    CHECK (lado IN ('NOIVA', 'NOIVO'));
+ALTER TABLE convidados ADD COLUMN IF NOT EXISTS observacoes TEXT DEFAULT NULL;
+
+COMMENT ON COLUMN convidados.telefone IS 'Telefone do convidado no formato E.164 (+5511999990000)';
+COMMENT ON COLUMN convidados.faixa_etaria IS 'ADULTO ou CRIANCA; NULL quando não informada';
+COMMENT ON COLUMN convidados.lado IS 'Lado dos noivos a que o convidado pertence: NOIVA ou NOIVO';
+COMMENT ON COLUMN convidados.observacoes IS 'Anotações livres do anfitrião sobre o convidado';
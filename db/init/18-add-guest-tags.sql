@@ -0,0 +1,23 @@
+-- file: db/init/18-add-guest-tags.sql
+-- Etiquetas do evento para segmentar os grupos de convidados
+
+CREATE TABLE IF NOT EXISTS etiquetas (
+    id UUID PRIMARY KEY,
+    id_evento UUID NOT NULL REFERENCES eventos(id) ON DELETE CASCADE,
+    nome VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- O nome é único no evento sem diferenciar maiúsculas de minúsculas
+CREATE UNIQUE INDEX IF NOT EXISTS idx_etiquetas_evento_nome ON etiquetas(id_evento, lower(nome));
+
+CREATE TABLE IF NOT EXISTS convidados_grupos_etiquetas (
+    id_grupo UUID NOT NULL REFERENCES convidados_grupos(id) ON DELETE CASCADE,
+    id_etiqueta UUID NOT NULL REFERENCES etiquetas(id) ON DELETE CASCADE,
+    PRIMARY KEY (id_grupo, id_etiqueta)
+);
+
+CREATE INDEX IF NOT EXISTS idx_convidados_grupos_etiquetas_etiqueta ON convidados_grupos_etiquetas(id_etiqueta);
+
+COMMENT ON TABLE etiquetas IS 'Catálogo de etiquetas do evento, como padrinhos ou trabalho';
+COMMENT ON TABLE convidados_grupos_etiquetas IS 'Associação entre grupos de convidados e etiquetas';
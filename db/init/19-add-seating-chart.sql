@@ -0,0 +1,30 @@
+-- file: db/init/19-add-seating-chart.sql
+-- Plano de mesas: mesas do evento e os assentos ocupados pelos convidados confirmados
+
+CREATE TABLE IF NOT EXISTS mesas (
+    id UUID PRIMARY KEY,
+    id_evento UUID NOT NULL REFERENCES eventos(id) ON DELETE CASCADE,
+    nome VARCHAR(100) NOT NULL,
+    capacidade INTEGER NOT NULL CHECK (capacidade BETWEEN 1 AND 50),
+    formato VARCHAR(20) NOT NULL CHECK (formato IN ('REDONDA', 'RETANGULAR', 'QUADRADA')),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_mesas_evento ON mesas(id_evento);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_mesas_evento_nome ON mesas(id_evento, lower(nome));
+
+-- id_convidado não tem chave estrangeira: a revisão do grupo regrava as linhas de convidados
+-- com os mesmos IDs, e uma cascata apagaria os assentos. As leituras fazem JOIN com convidados,
+-- então o assento de um convidado removido deixa de aparecer e é descartado na próxima gravação.
+CREATE TABLE IF NOT EXISTS mesas_assentos (
+    id_mesa UUID NOT NULL REFERENCES mesas(id) ON DELETE CASCADE,
+    numero INTEGER NOT NULL CHECK (numero >= 1),
+    id_convidado UUID NOT NULL,
+    PRIMARY KEY (id_mesa, numero)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_mesas_assentos_convidado ON mesas_assentos(id_convidado);
+
+COMMENT ON TABLE mesas IS 'Mesas do salão; o formato só orienta a montagem';
+COMMENT ON TABLE mesas_assentos IS 'Assentos numerados ocupados por convidados confirmados, no máximo um por convidado';
@@ -0,0 +1,7 @@
+-- file: db/init/20-add-catering-report.sql
+-- Finalidade das perguntas do formulário de RSVP usadas no relatório do buffet
+
+ALTER TABLE rsvp_perguntas ADD COLUMN IF NOT EXISTS finalidade VARCHAR(20) DEFAULT NULL
+    CHECK (finalidade IN ('PRATO', 'RESTRICAO_ALIMENTAR'));
+
+COMMENT ON COLUMN rsvp_perguntas.finalidade IS 'PRATO ou RESTRICAO_ALIMENTAR; no máximo uma pergunta de cada finalidade por evento, garantido pelo domínio';
@@ -0,0 +1,12 @@
+-- file: db/init/21-add-guest-list-search.sql
+-- Busca e ordenação da listagem de grupos de convidados
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Índices trigram atendem a busca por trecho (ILIKE '%texto%') na chave e nos nomes
+CREATE INDEX IF NOT EXISTS idx_convidados_grupos_chave_trgm ON convidados_grupos USING gin (chave_de_acesso gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_convidados_nome_trgm ON convidados USING gin (nome gin_trgm_ops);
+
+-- Ordenação e paginação por data dentro do evento
+CREATE INDEX IF NOT EXISTS idx_convidados_grupos_evento_criacao ON convidados_grupos(id_evento, created_at, id);
+CREATE INDEX IF NOT EXISTS idx_convidados_grupos_evento_atualizacao ON convidados_grupos(id_evento, updated_at, id);
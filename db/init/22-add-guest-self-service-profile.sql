@@ -0,0 +1,31 @@
+-- file: db/init/22-add-guest-self-service-profile.sql
+-- Edição do cadastro pelo próprio grupo, pelo link da chave de acesso
+
+CREATE TABLE IF NOT EXISTS perfil_convidado_configuracoes (
+    id_evento UUID PRIMARY KEY REFERENCES eventos(id) ON DELETE CASCADE,
+    campos_editaveis TEXT[] NOT NULL DEFAULT '{}'
+        CHECK (campos_editaveis <@ ARRAY['NOME', 'TELEFONE', 'EMAIL', 'ACOMPANHANTES']::TEXT[]),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS convidados_alteracoes_perfil (
+    id UUID PRIMARY KEY,
+    id_grupo UUID NOT NULL REFERENCES convidados_grupos(id) ON DELETE CASCADE,
+    -- Sem chave estrangeira: convidados removidos na revisão do grupo mantêm as alterações; NULL para acompanhantes
+    id_convidado UUID,
+    nome_convidado VARCHAR(255) NOT NULL DEFAULT '',
+    campo VARCHAR(20) NOT NULL CHECK (campo IN ('NOME', 'TELEFONE', 'EMAIL', 'ACOMPANHANTES')),
+    valor_anterior VARCHAR(255) NOT NULL DEFAULT '',
+    valor_novo VARCHAR(255) NOT NULL DEFAULT '',
+    ip VARCHAR(45),
+    user_agent TEXT,
+    registrado_em TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    revisada_em TIMESTAMP WITH TIME ZONE DEFAULT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_convidados_alteracoes_perfil_grupo ON convidados_alteracoes_perfil(id_grupo, registrado_em);
+CREATE INDEX IF NOT EXISTS idx_convidados_alteracoes_perfil_pendentes ON convidados_alteracoes_perfil(id_grupo) WHERE revisada_em IS NULL;
+
+COMMENT ON TABLE perfil_convidado_configuracoes IS 'Campos do cadastro que o grupo pode editar pelo link; sem linha, nenhum';
+COMMENT ON TABLE convidados_alteracoes_perfil IS 'Alterações feitas pelo próprio grupo, para revisão do anfitrião';
+COMMENT ON COLUMN convidados_alteracoes_perfil.valor_anterior IS 'Vazio quando o dado não existia, como um acompanhante incluído';
@@ -0,0 +1,14 @@
+-- file: db/init/23-add-guest-soft-delete.sql
+-- Remoção de convidados pelo anfitrião sem apagar o RSVP, com motivo e possibilidade de restaurar
+
+ALTER TABLE convidados
+    ADD COLUMN IF NOT EXISTS removido_em TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS motivo_remocao VARCHAR(500),
+    ADD COLUMN IF NOT EXISTS removido_por UUID REFERENCES usuarios(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_convidados_grupo_ativos ON convidados(id_grupo) WHERE removido_em IS NULL;
+
+COMMENT ON COLUMN convidados.removido_em IS 'Preenchido quando o anfitrião remove o convidado; removidos ficam fora das listas e estatísticas';
+COMMENT ON COLUMN convidados.motivo_remocao IS 'Motivo informado pelo anfitrião ao remover o convidado';
+COMMENT ON COLUMN convidados.removido_por IS 'Usuário que removeu o convidado';
+COMMENT ON COLUMN rsvp_historico.status_novo IS 'REMOVIDO quando o anfitrião remove o convidado; na restauração, o status anterior passa a ser REMOVIDO';
@@ -0,0 +1,22 @@
+-- file: db/init/24-add-gift-pix.sql
+-- Presentes pagos por PIX: tipo da chave e dados do recebedor exigidos pelo BR Code
+
+ALTER TABLE presentes
+    ADD COLUMN IF NOT EXISTS detalhes_tipo_chave_pix VARCHAR(10),
+    ADD COLUMN IF NOT EXISTS detalhes_nome_recebedor_pix VARCHAR(25),
+    ADD COLUMN IF NOT EXISTS detalhes_cidade_recebedor_pix VARCHAR(15);
+
+ALTER TABLE presentes DROP CONSTRAINT IF EXISTS chk_detalhes;
+ALTER TABLE presentes ADD CONSTRAINT chk_detalhes CHECK (
+    (detalhes_tipo = 'PRODUTO_EXTERNO' AND detalhes_link_loja IS NOT NULL) OR
+    (detalhes_tipo = 'PIX' AND detalhes_chave_pix IS NOT NULL AND detalhes_tipo_chave_pix IS NOT NULL
+        AND detalhes_nome_recebedor_pix IS NOT NULL AND detalhes_cidade_recebedor_pix IS NOT NULL)
+);
+
+ALTER TABLE presentes ADD CONSTRAINT chk_detalhes_tipo_chave_pix
+    CHECK (detalhes_tipo_chave_pix IS NULL OR detalhes_tipo_chave_pix IN ('CPF', 'CNPJ', 'EMAIL', 'TELEFONE', 'ALEATORIA'));
+
+COMMENT ON COLUMN presentes.detalhes_chave_pix IS 'Chave PIX normalizada: dígitos para CPF/CNPJ, +55 e DDD para telefone, minúsculas para e-mail e chave aleatória';
+COMMENT ON COLUMN presentes.detalhes_tipo_chave_pix IS 'CPF, CNPJ, EMAIL, TELEFONE ou ALEATORIA (EVP)';
+COMMENT ON COLUMN presentes.detalhes_nome_recebedor_pix IS 'Nome de quem recebe o PIX, campo 59 do BR Code';
+COMMENT ON COLUMN presentes.detalhes_cidade_recebedor_pix IS 'Cidade de quem recebe o PIX, campo 60 do BR Code';
@@ -0,0 +1,26 @@
+-- file: db/init/25-add-gift-selection-payments.sql
+-- Pagamento online das seleções de presentes pelo gateway de pagamento
+
+ALTER TABLE presentes_selecoes
+    ADD COLUMN IF NOT EXISTS status_pagamento VARCHAR(10),
+    ADD COLUMN IF NOT EXISTS valor_pagamento NUMERIC(10,2),
+    ADD COLUMN IF NOT EXISTS id_pagamento_externo VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS url_checkout TEXT,
+    ADD COLUMN IF NOT EXISTS pagamento_expira_em TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS pagamento_atualizado_em TIMESTAMP WITH TIME ZONE;
+
+ALTER TABLE presentes_selecoes ADD CONSTRAINT chk_status_pagamento
+    CHECK (status_pagamento IS NULL OR status_pagamento IN ('PENDENTE', 'PAGO', 'FALHOU', 'EXPIRADO'));
+
+ALTER TABLE presentes_selecoes ADD CONSTRAINT chk_pagamento_completo
+    CHECK (status_pagamento IS NULL OR (valor_pagamento > 0 AND pagamento_expira_em IS NOT NULL));
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_presentes_selecoes_pagamento_externo
+    ON presentes_selecoes(id_pagamento_externo) WHERE id_pagamento_externo IS NOT NULL;
+
+-- Varredura dos pagamentos pendentes que passaram do prazo
+CREATE INDEX IF NOT EXISTS idx_presentes_selecoes_pagamento_pendente
+    ON presentes_selecoes(pagamento_expira_em) WHERE status_pagamento = 'PENDENTE';
+
+COMMENT ON COLUMN presentes_selecoes.status_pagamento IS 'NULL quando a seleção não foi paga pela plataforma; PENDENTE reserva as cotas até o pagamento, FALHOU e EXPIRADO as liberam';
+COMMENT ON COLUMN presentes_selecoes.id_pagamento_externo IS 'ID do checkout no gateway (ex.: cs_... da Stripe)';
@@ -0,0 +1,17 @@
+-- file: db/init/26-add-gift-reservation-holds.sql
+-- Reservas temporárias de cotas e presentes integrais enquanto o convidado conclui a seleção
+
+ALTER TYPE status_presente ADD VALUE IF NOT EXISTS 'RESERVADO';
+
+-- Uma reserva é uma seleção com prazo: reservada_ate fica NULL depois de confirmada
+ALTER TABLE presentes_selecoes ADD COLUMN IF NOT EXISTS reservada_ate TIMESTAMP WITH TIME ZONE;
+
+-- Varredura das reservas vencidas
+CREATE INDEX IF NOT EXISTS idx_presentes_selecoes_reservada_ate
+    ON presentes_selecoes(reservada_ate) WHERE reservada_ate IS NOT NULL;
+
+-- Reserva ativa de cada grupo, desfeita quando o grupo reserva de novo
+CREATE INDEX IF NOT EXISTS idx_presentes_selecoes_reserva_grupo
+    ON presentes_selecoes(id_grupo_de_convidados) WHERE reservada_ate IS NOT NULL;
+
+COMMENT ON COLUMN presentes_selecoes.reservada_ate IS 'Prazo da reserva; NULL nas seleções confirmadas. Vencida, a varredura libera os itens e apaga a linha';
@@ -0,0 +1,24 @@
+-- file: db/init/27-add-gift-selection-history.sql
+-- Histórico das seleções de presentes editadas ou canceladas depois de confirmadas
+
+CREATE TABLE IF NOT EXISTS presentes_selecoes_historico (
+    id UUID PRIMARY KEY,
+    -- Sem chave estrangeira: a seleção cancelada é apagada e o histórico fica
+    id_selecao UUID NOT NULL,
+    id_evento UUID NOT NULL REFERENCES eventos(id) ON DELETE CASCADE,
+    -- RESTRICT: a junção de grupos move o histórico; apagar o grupo não pode apagar a auditoria
+    id_grupo UUID NOT NULL REFERENCES convidados_grupos(id) ON DELETE RESTRICT,
+    tipo VARCHAR(10) NOT NULL CHECK (tipo IN ('EDITADA', 'CANCELADA')),
+    itens_anteriores JSONB NOT NULL,
+    itens_novos JSONB NOT NULL,
+    canal VARCHAR(20) NOT NULL CHECK (canal IN ('CHAVE_DE_ACESSO', 'ANFITRIAO')),
+    id_usuario UUID REFERENCES usuarios(id) ON DELETE SET NULL,
+    registrado_em TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_presentes_selecoes_historico_evento ON presentes_selecoes_historico(id_evento, registrado_em);
+CREATE INDEX IF NOT EXISTS idx_presentes_selecoes_historico_selecao ON presentes_selecoes_historico(id_selecao, registrado_em);
+
+COMMENT ON TABLE presentes_selecoes_historico IS 'Trilha de auditoria das alterações de seleções de presentes';
+COMMENT ON COLUMN presentes_selecoes_historico.itens_anteriores IS 'Itens da seleção antes da alteração: [{id, nome, quantidade, valorCota}]';
+COMMENT ON COLUMN presentes_selecoes_historico.canal IS 'CHAVE_DE_ACESSO quando o grupo alterou pelo link; ANFITRIAO quando o dono do evento alterou';
@@ -9,6 +9,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/luiszkm/wedding_backend/internal/event/domain"
+	pageTemplateDomain "github.com/luiszkm/wedding_backend/internal/pagetemplate/domain"
 )
 
 type EventService struct {
@@ -51,3 +52,71 @@ func (s *EventService) ListarEventosPorUsuario(ctx context.Context, userID uuid.
 func (s *EventService) ObterEventoPorID(ctx context.Context, userID, eventID uuid.UUID) (*domain.Evento, error) {
 	return s.repo.FindByID(ctx, userID, eventID)
 }
+
+// AtualizarTemplate define o template do evento: um template standard do catálogo ou um
+// arquivo bespoke. Se uma paleta for informada, ela é validada e aplicada em seguida;
+// caso contrário, um template standard traz a própria paleta default.
+func (s *EventService) AtualizarTemplate(ctx context.Context, userID, eventID uuid.UUID, ehBespoke bool, idTemplate, arquivoBespoke string, paleta domain.PaletaCores) (*domain.Evento, error) {
+	evento, err := s.repo.FindByID(ctx, userID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if ehBespoke {
+		if err := evento.DefinirTemplateBespoke(arquivoBespoke); err != nil {
+			return nil, err
+		}
+	} else {
+		tmpl, err := pageTemplateDomain.BuscarTemplatePadrao(idTemplate)
+		if err != nil {
+			return nil, err
+		}
+		if err := evento.DefinirTemplate(tmpl.ID, tmpl.PaletaDefault); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(paleta) > 0 {
+		tmpl := pageTemplateDomain.ResolverTemplate(evento.IDTemplate(), evento.IDTemplateArquivo())
+		if err := evento.AtualizarPaleta(paleta, tmpl.PaletaDefault); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repo.Update(ctx, evento); err != nil {
+		return nil, fmt.Errorf("falha ao salvar template do evento: %w", err)
+	}
+	return evento, nil
+}
+
+// DefinirPrazoRSVP define ou remove (prazo nil) o prazo para respostas públicas de RSVP.
+func (s *EventService) DefinirPrazoRSVP(ctx context.Context, userID, eventID uuid.UUID, prazo *time.Time) (*domain.Evento, error) {
+	evento, err := s.repo.FindByID(ctx, userID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if err := evento.DefinirPrazoRSVP(prazo); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, evento); err != nil {
+		return nil, fmt.Errorf("falha ao salvar prazo de RSVP do evento: %w", err)
+	}
+	return evento, nil
+}
+
+// AtualizarPaleta valida e salva a paleta de cores do evento. Cores omitidas vêm do template em uso.
+func (s *EventService) AtualizarPaleta(ctx context.Context, userID, eventID uuid.UUID, paleta domain.PaletaCores) (*domain.Evento, error) {
+	evento, err := s.repo.FindByID(ctx, userID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl := pageTemplateDomain.ResolverTemplate(evento.IDTemplate(), evento.IDTemplateArquivo())
+	if err := evento.AtualizarPaleta(paleta, tmpl.PaletaDefault); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, evento); err != nil {
+		return nil, fmt.Errorf("falha ao salvar paleta do evento: %w", err)
+	}
+	return evento, nil
+}
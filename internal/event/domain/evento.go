@@ -3,6 +3,7 @@ package domain
 
 import (
 	"errors"
+	"path"
 	"strings"
 	"time"
 
@@ -19,10 +20,13 @@ const (
 )
 
 var (
-	ErrEventoNaoEncontrado = errors.New("evento não encontrado")
-	ErrTipoEventoInvalido  = errors.New("tipo de evento inválido")
-	ErrSlugEmUso           = errors.New("a URL amigável (slug) já está em uso")
-	ErrEventoJaExiste      = errors.New("evento já existe")
+	ErrEventoNaoEncontrado     = errors.New("evento não encontrado")
+	ErrTipoEventoInvalido      = errors.New("tipo de evento inválido")
+	ErrSlugEmUso               = errors.New("a URL amigável (slug) já está em uso")
+	ErrEventoJaExiste          = errors.New("evento já existe")
+	ErrTemplateObrigatorio     = errors.New("o ID do template é obrigatório")
+	ErrArquivoTemplateInvalido = errors.New("arquivo de template bespoke inválido")
+	ErrPrazoRSVPAposEvento     = errors.New("o prazo de RSVP não pode ser depois do dia do evento")
 )
 
 func (t TipoEvento) IsValid() bool {
@@ -34,12 +38,16 @@ func (t TipoEvento) IsValid() bool {
 }
 
 type Evento struct {
-	id        uuid.UUID
-	idUsuario uuid.UUID
-	nome      string
-	data      time.Time
-	tipo      TipoEvento
-	urlSlug   string
+	id                uuid.UUID
+	idUsuario         uuid.UUID
+	nome              string
+	data              time.Time
+	tipo              TipoEvento
+	urlSlug           string
+	idTemplate        string
+	idTemplateArquivo *string
+	paletaCores       PaletaCores
+	prazoRSVP         *time.Time
 }
 
 func NewEvento(idUsuario uuid.UUID, nome string, data time.Time, tipo TipoEvento, urlSlug string) (*Evento, error) {
@@ -51,17 +59,20 @@ func NewEvento(idUsuario uuid.UUID, nome string, data time.Time, tipo TipoEvento
 	}
 
 	return &Evento{
-		id:        uuid.New(),
-		idUsuario: idUsuario,
-		nome:      strings.TrimSpace(nome),
-		data:      data,
-		tipo:      tipo,
-		urlSlug:   strings.TrimSpace(urlSlug),
+		id:          uuid.New(),
+		idUsuario:   idUsuario,
+		nome:        strings.TrimSpace(nome),
+		data:        data,
+		tipo:        tipo,
+		urlSlug:     strings.TrimSpace(urlSlug),
+		idTemplate:  IDTemplatePadrao,
+		paletaCores: PaletaCoresPadrao(),
 	}, nil
 }
 
 // HydrateEvento cria uma nova instância de Evento a partir dos dados fornecidos.
-func HydrateEvento(id, idUsuario uuid.UUID, nome string, data time.Time, tipo TipoEvento, urlSlug string) *Evento {
+// Campos de template ausentes no banco são preenchidos com os valores padrão.
+func HydrateEvento(id, idUsuario uuid.UUID, nome string, data time.Time, tipo TipoEvento, urlSlug string, idTemplate string, idTemplateArquivo *string, paletaCores PaletaCores, prazoRSVP *time.Time) *Evento {
 	if nome == "" || urlSlug == "" {
 		return nil
 	}
@@ -69,14 +80,83 @@ func HydrateEvento(id, idUsuario uuid.UUID, nome string, data time.Time, tipo Ti
 		return nil
 	}
 
+	if idTemplate == "" {
+		idTemplate = IDTemplatePadrao
+	}
+	if idTemplateArquivo != nil && strings.TrimSpace(*idTemplateArquivo) == "" {
+		idTemplateArquivo = nil
+	}
+	if len(paletaCores) == 0 {
+		paletaCores = PaletaCoresPadrao()
+	}
+
 	return &Evento{
-		id:        id,
-		idUsuario: idUsuario,
-		nome:      strings.TrimSpace(nome),
-		data:      data,
-		tipo:      tipo,
-		urlSlug:   strings.TrimSpace(urlSlug),
+		id:                id,
+		idUsuario:         idUsuario,
+		nome:              strings.TrimSpace(nome),
+		data:              data,
+		tipo:              tipo,
+		urlSlug:           strings.TrimSpace(urlSlug),
+		idTemplate:        idTemplate,
+		idTemplateArquivo: idTemplateArquivo,
+		paletaCores:       paletaCores,
+		prazoRSVP:         prazoRSVP,
+	}
+}
+
+// tamanhoMaximoArquivoTemplate acompanha o VARCHAR(100) de eventos.id_template_arquivo.
+const tamanhoMaximoArquivoTemplate = 100
+
+// DefinirTemplate passa o evento para um template standard, descartando o bespoke, e aplica
+// a paleta default do template, já que as cores anteriores raramente combinam com o novo layout.
+func (e *Evento) DefinirTemplate(idTemplate string, paletaDoTemplate PaletaCores) error {
+	idTemplate = strings.TrimSpace(idTemplate)
+	if idTemplate == "" {
+		return ErrTemplateObrigatorio
+	}
+	e.idTemplate = idTemplate
+	e.idTemplateArquivo = nil
+	if len(paletaDoTemplate) > 0 {
+		e.paletaCores = paletaDoTemplate.Clone()
+	}
+	return nil
+}
+
+// DefinirTemplateBespoke associa um arquivo de templates/bespoke ao evento. Apenas o nome
+// do arquivo é aceito, sem diretórios. O id_template é mantido como fallback.
+func (e *Evento) DefinirTemplateBespoke(arquivo string) error {
+	arquivo = strings.TrimSpace(arquivo)
+	if arquivo == "" || len(arquivo) > tamanhoMaximoArquivoTemplate ||
+		path.Base(arquivo) != arquivo || path.Ext(arquivo) != ".html" || strings.ContainsAny(arquivo, "\\") {
+		return ErrArquivoTemplateInvalido
+	}
+	e.idTemplateArquivo = &arquivo
+	return nil
+}
+
+// AtualizarPaleta valida e aplica uma paleta customizada. Cores não informadas são
+// preenchidas com a paleta default do template antes da checagem de contraste.
+func (e *Evento) AtualizarPaleta(paleta PaletaCores, paletaDoTemplate PaletaCores) error {
+	nova := paleta.Clone()
+	if err := nova.Validar(); err != nil {
+		return err
+	}
+	completa := nova.ComFallback(paletaDoTemplate)
+	if err := completa.ValidarContraste(); err != nil {
+		return err
 	}
+	e.paletaCores = completa
+	return nil
+}
+
+// DefinirPrazoRSVP define até quando os convidados podem responder pelo link público.
+// nil remove o prazo. O prazo vale até o fim do dia do evento, no máximo.
+func (e *Evento) DefinirPrazoRSVP(prazo *time.Time) error {
+	if prazo != nil && !e.data.IsZero() && !prazo.Before(e.data.AddDate(0, 0, 1)) {
+		return ErrPrazoRSVPAposEvento
+	}
+	e.prazoRSVP = prazo
+	return nil
 }
 
 // Getters
@@ -86,3 +166,13 @@ func (e *Evento) Nome() string         { return e.nome }
 func (e *Evento) Data() time.Time      { return e.data }
 func (e *Evento) Tipo() TipoEvento     { return e.tipo }
 func (e *Evento) UrlSlug() string      { return e.urlSlug }
+func (e *Evento) IDTemplate() string   { return e.idTemplate }
+
+// IDTemplateArquivo retorna o arquivo de template bespoke, ou nil quando o evento usa um template padrão.
+func (e *Evento) IDTemplateArquivo() *string { return e.idTemplateArquivo }
+
+// PaletaCores retorna uma cópia da paleta para que o agregado não seja alterado por fora.
+func (e *Evento) PaletaCores() PaletaCores { return e.paletaCores.Clone() }
+
+// PrazoRSVP retorna o prazo para respostas públicas, ou nil quando o evento não tem prazo.
+func (e *Evento) PrazoRSVP() *time.Time { return e.prazoRSVP }
@@ -0,0 +1,35 @@
+// file: internal/event/domain/evento_test.go
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvento_DefinirPrazoRSVP(t *testing.T) {
+	dataDoEvento := time.Date(2025, 10, 18, 0, 0, 0, 0, time.UTC)
+	evento, err := NewEvento(uuid.New(), "Casamento", dataDoEvento, TipoCasamento, "casamento")
+	assert.NoError(t, err)
+
+	t.Run("deve aceitar um prazo até o fim do dia do evento", func(t *testing.T) {
+		prazo := dataDoEvento.Add(-30 * 24 * time.Hour)
+		assert.NoError(t, evento.DefinirPrazoRSVP(&prazo))
+		assert.Equal(t, &prazo, evento.PrazoRSVP())
+
+		noDia := dataDoEvento.Add(20 * time.Hour)
+		assert.NoError(t, evento.DefinirPrazoRSVP(&noDia))
+	})
+
+	t.Run("deve recusar um prazo depois do dia do evento", func(t *testing.T) {
+		depois := dataDoEvento.AddDate(0, 0, 1)
+		assert.ErrorIs(t, evento.DefinirPrazoRSVP(&depois), ErrPrazoRSVPAposEvento)
+	})
+
+	t.Run("deve remover o prazo com nil", func(t *testing.T) {
+		assert.NoError(t, evento.DefinirPrazoRSVP(nil))
+		assert.Nil(t, evento.PrazoRSVP())
+	})
+}
@@ -0,0 +1,176 @@
+// file: internal/event/domain/paleta.go
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// IDTemplatePadrao é o template usado quando o evento não escolheu nenhum (ver migração 04).
+const IDTemplatePadrao = "template_moderno"
+
+// Chaves reconhecidas na paleta de cores. Elas viram variáveis CSS nos templates.
+const (
+	CorPrimary    = "primary"
+	CorSecondary  = "secondary"
+	CorAccent     = "accent"
+	CorBackground = "background"
+	CorText       = "text"
+)
+
+// PaletaCores mapeia o nome da cor para seu valor hexadecimal, no mesmo formato
+// JSON armazenado em eventos.paleta_cores.
+type PaletaCores map[string]string
+
+// PaletaCoresPadrao retorna a paleta default da coluna eventos.paleta_cores.
+func PaletaCoresPadrao() PaletaCores {
+	return PaletaCores{
+		CorPrimary:    "#2563eb",
+		CorSecondary:  "#f1f5f9",
+		CorAccent:     "#10b981",
+		CorBackground: "#ffffff",
+		CorText:       "#1f2937",
+	}
+}
+
+// Clone retorna uma cópia independente da paleta.
+func (p PaletaCores) Clone() PaletaCores {
+	if p == nil {
+		return nil
+	}
+	copia := make(PaletaCores, len(p))
+	for chave, valor := range p {
+		copia[chave] = valor
+	}
+	return copia
+}
+
+// ComFallback completa as cores ausentes com os valores de outra paleta.
+func (p PaletaCores) ComFallback(fallback PaletaCores) PaletaCores {
+	resultado := fallback.Clone()
+	if resultado == nil {
+		resultado = PaletaCores{}
+	}
+	for chave, valor := range p {
+		if valor != "" {
+			resultado[chave] = valor
+		}
+	}
+	return resultado
+}
+
+// ContrasteMinimoTexto é a razão mínima de contraste entre texto e fundo (WCAG 2.1 nível AA).
+const ContrasteMinimoTexto = 4.5
+
+var (
+	ErrCorDesconhecida       = errors.New("cor desconhecida na paleta")
+	ErrCorHexInvalida        = errors.New("cor deve estar no formato hexadecimal #RGB ou #RRGGBB")
+	ErrContrasteInsuficiente = errors.New("contraste insuficiente entre texto e fundo")
+)
+
+// EhCorConhecida indica se a chave faz parte das cores suportadas pelos templates.
+func EhCorConhecida(chave string) bool {
+	switch chave {
+	case CorPrimary, CorSecondary, CorAccent, CorBackground, CorText:
+		return true
+	}
+	return false
+}
+
+// Validar verifica se todas as chaves são conhecidas e todos os valores são hexadecimais.
+// Os valores são normalizados para minúsculas no formato #rrggbb.
+func (p PaletaCores) Validar() error {
+	for chave, valor := range p {
+		if !EhCorConhecida(chave) {
+			return fmt.Errorf("%w: %s", ErrCorDesconhecida, chave)
+		}
+		normalizada, err := normalizarHex(valor)
+		if err != nil {
+			return fmt.Errorf("%w (%s: %q)", err, chave, valor)
+		}
+		p[chave] = normalizada
+	}
+	return nil
+}
+
+// ValidarContraste checa o contraste entre as cores de texto e de fundo. A paleta deve
+// estar completa (ver ComFallback); cores ausentes não são verificadas.
+func (p PaletaCores) ValidarContraste() error {
+	texto, fundo := p[CorText], p[CorBackground]
+	if texto == "" || fundo == "" {
+		return nil
+	}
+	razao, err := RazaoDeContraste(texto, fundo)
+	if err != nil {
+		return err
+	}
+	if razao < ContrasteMinimoTexto {
+		return fmt.Errorf("%w: %.2f:1 (mínimo %.1f:1)", ErrContrasteInsuficiente, razao, ContrasteMinimoTexto)
+	}
+	return nil
+}
+
+// RazaoDeContraste calcula a razão de contraste WCAG entre duas cores hexadecimais (1 a 21).
+func RazaoDeContraste(corA, corB string) (float64, error) {
+	la, err := luminanciaRelativa(corA)
+	if err != nil {
+		return 0, err
+	}
+	lb, err := luminanciaRelativa(corB)
+	if err != nil {
+		return 0, err
+	}
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05), nil
+}
+
+func normalizarHex(cor string) (string, error) {
+	cor = strings.ToLower(strings.TrimSpace(cor))
+	if !strings.HasPrefix(cor, "#") {
+		return "", ErrCorHexInvalida
+	}
+	digitos := cor[1:]
+	if len(digitos) == 3 {
+		digitos = string([]byte{digitos[0], digitos[0], digitos[1], digitos[1], digitos[2], digitos[2]})
+	}
+	if len(digitos) != 6 {
+		return "", ErrCorHexInvalida
+	}
+	if _, err := strconv.ParseUint(digitos, 16, 32); err != nil {
+		return "", ErrCorHexInvalida
+	}
+	return "#" + digitos, nil
+}
+
+// ComponentesRGB devolve os canais vermelho, verde e azul (0 a 255) de uma cor hexadecimal,
+// para quem desenha com a paleta fora do CSS, como os convites em PDF.
+func ComponentesRGB(cor string) (r, g, b int, err error) {
+	hex, err := normalizarHex(cor)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	valor, _ := strconv.ParseUint(hex[1:], 16, 32)
+	return int((valor >> 16) & 0xff), int((valor >> 8) & 0xff), int(valor & 0xff), nil
+}
+
+func luminanciaRelativa(cor string) (float64, error) {
+	r, g, b, err := ComponentesRGB(cor)
+	if err != nil {
+		return 0, err
+	}
+	canais := [3]float64{float64(r), float64(g), float64(b)}
+	for i, c := range canais {
+		c /= 255
+		if c <= 0.03928 {
+			canais[i] = c / 12.92
+		} else {
+			canais[i] = math.Pow((c+0.055)/1.055, 2.4)
+		}
+	}
+	return 0.2126*canais[0] + 0.7152*canais[1] + 0.0722*canais[2], nil
+}
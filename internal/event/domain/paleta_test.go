@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaletaCoresValidar(t *testing.T) {
+	t.Run("deve aceitar e normalizar cores hexadecimais", func(t *testing.T) {
+		paleta := PaletaCores{CorPrimary: "#ABC", CorText: " #1F2937 "}
+
+		err := paleta.Validar()
+
+		assert.NoError(t, err)
+		assert.Equal(t, "#aabbcc", paleta[CorPrimary])
+		assert.Equal(t, "#1f2937", paleta[CorText])
+	})
+
+	t.Run("deve rejeitar chave desconhecida", func(t *testing.T) {
+		err := PaletaCores{"borda": "#000000"}.Validar()
+		assert.ErrorIs(t, err, ErrCorDesconhecida)
+	})
+
+	t.Run("deve rejeitar valores que não são hexadecimais", func(t *testing.T) {
+		for _, valor := range []string{"red", "#12345", "123456", "#gggggg", "rgb(0,0,0)"} {
+			err := PaletaCores{CorPrimary: valor}.Validar()
+			assert.ErrorIs(t, err, ErrCorHexInvalida, valor)
+		}
+	})
+}
+
+func TestComponentesRGB(t *testing.T) {
+	t.Run("deve separar os canais nas duas notações", func(t *testing.T) {
+		r, g, b, err := ComponentesRGB("#2563eb")
+		assert.NoError(t, err)
+		assert.Equal(t, []int{0x25, 0x63, 0xeb}, []int{r, g, b})
+
+		r, g, b, err = ComponentesRGB("#FA0")
+		assert.NoError(t, err)
+		assert.Equal(t, []int{0xff, 0xaa, 0x00}, []int{r, g, b})
+	})
+
+	t.Run("deve rejeitar cor inválida", func(t *testing.T) {
+		_, _, _, err := ComponentesRGB("azul")
+		assert.ErrorIs(t, err, ErrCorHexInvalida)
+	})
+}
+
+func TestPaletaCoresValidarContraste(t *testing.T) {
+	t.Run("deve calcular contraste máximo entre preto e branco", func(t *testing.T) {
+		razao, err := RazaoDeContraste("#000000", "#ffffff")
+
+		assert.NoError(t, err)
+		assert.InDelta(t, 21.0, razao, 0.01)
+	})
+
+	t.Run("deve aceitar as paletas padrão", func(t *testing.T) {
+		assert.NoError(t, PaletaCoresPadrao().ValidarContraste())
+	})
+
+	t.Run("deve rejeitar texto com pouco contraste", func(t *testing.T) {
+		err := PaletaCores{CorText: "#cccccc", CorBackground: "#ffffff"}.ValidarContraste()
+		assert.ErrorIs(t, err, ErrContrasteInsuficiente)
+	})
+}
+
+func TestEventoTemplate(t *testing.T) {
+	novoEvento := func() *Evento {
+		evento, _ := NewEvento(uuid.New(), "Casamento", time.Now(), TipoCasamento, "casamento")
+		return evento
+	}
+	paletaClassica := PaletaCores{
+		CorPrimary: "#8b5a3c", CorSecondary: "#f5f5dc", CorAccent: "#d4af37",
+		CorBackground: "#fdfdf8", CorText: "#2c1810",
+	}
+
+	t.Run("deve trocar o template, aplicar a paleta dele e descartar o bespoke", func(t *testing.T) {
+		evento := novoEvento()
+		assert.NoError(t, evento.DefinirTemplateBespoke("cliente.html"))
+
+		err := evento.DefinirTemplate("template_classico", paletaClassica)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "template_classico", evento.IDTemplate())
+		assert.Nil(t, evento.IDTemplateArquivo())
+		assert.Equal(t, paletaClassica, evento.PaletaCores())
+	})
+
+	t.Run("deve rejeitar arquivo bespoke com diretórios ou extensão errada", func(t *testing.T) {
+		evento := novoEvento()
+		for _, arquivo := range []string{"", "../outro/cliente.html", "bespoke/cliente.html", "cliente.txt", `..\cliente.html`} {
+			assert.ErrorIs(t, evento.DefinirTemplateBespoke(arquivo), ErrArquivoTemplateInvalido, arquivo)
+		}
+		assert.Nil(t, evento.IDTemplateArquivo())
+	})
+
+	t.Run("deve completar a paleta parcial com a do template", func(t *testing.T) {
+		evento := novoEvento()
+
+		err := evento.AtualizarPaleta(PaletaCores{CorPrimary: "#000"}, paletaClassica)
+
+		assert.NoError(t, err)
+		paleta := evento.PaletaCores()
+		assert.Equal(t, "#000000", paleta[CorPrimary])
+		assert.Equal(t, paletaClassica[CorAccent], paleta[CorAccent])
+	})
+
+	t.Run("não deve alterar a paleta quando o contraste é insuficiente", func(t *testing.T) {
+		evento := novoEvento()
+		antes := evento.PaletaCores()
+
+		err := evento.AtualizarPaleta(PaletaCores{CorText: "#fefefe"}, PaletaCoresPadrao())
+
+		assert.ErrorIs(t, err, ErrContrasteInsuficiente)
+		assert.Equal(t, antes, evento.PaletaCores())
+	})
+}
@@ -23,8 +23,8 @@ func NewPostgresEventoRepository(db *pgxpool.Pool) domain.EventoRepository {
 
 func (r *PostgresEventoRepository) Save(ctx context.Context, evento *domain.Evento) error {
 	sql := `
-        INSERT INTO eventos (id, id_usuario, nome, data, tipo, url_slug)
-        VALUES ($1, $2, $3, $4, $5, $6)
+        INSERT INTO eventos (id, id_usuario, nome, data, tipo, url_slug, id_template, id_template_arquivo, paleta_cores, prazo_rsvp)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
     `
 	_, err := r.db.Exec(ctx, sql,
 		evento.ID(),
@@ -33,6 +33,10 @@ func (r *PostgresEventoRepository) Save(ctx context.Context, evento *domain.Even
 		evento.Data(),
 		evento.Tipo(),
 		evento.UrlSlug(),
+		evento.IDTemplate(),
+		evento.IDTemplateArquivo(),
+		evento.PaletaCores(),
+		evento.PrazoRSVP(),
 	)
 	if err != nil {
 		// Aqui poderíamos verificar erros de constraint, como slug duplicado
@@ -42,14 +46,8 @@ func (r *PostgresEventoRepository) Save(ctx context.Context, evento *domain.Even
 }
 
 func (r *PostgresEventoRepository) FindBySlug(ctx context.Context, slug string) (*domain.Evento, error) {
-	sql := `SELECT id, id_usuario, nome, data, tipo, url_slug FROM eventos WHERE url_slug = $1`
-	row := r.db.QueryRow(ctx, sql, slug)
-
-	var id, idUsuario uuid.UUID
-	var nome, tipo, urlSlug string
-	var data time.Time
-
-	err := row.Scan(&id, &idUsuario, &nome, &data, &tipo, &urlSlug)
+	sql := `SELECT ` + colunasEvento + ` FROM eventos WHERE url_slug = $1`
+	evento, err := scanEvento(r.db.QueryRow(ctx, sql, slug))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, domain.ErrEventoNaoEncontrado
@@ -57,17 +55,11 @@ func (r *PostgresEventoRepository) FindBySlug(ctx context.Context, slug string)
 		return nil, fmt.Errorf("falha ao buscar evento por slug: %w", err)
 	}
 
-	return domain.HydrateEvento(id, idUsuario, nome, data, domain.TipoEvento(tipo), urlSlug), nil
+	return evento, nil
 }
 func (r *PostgresEventoRepository) FindByID(ctx context.Context, userID, eventID uuid.UUID) (*domain.Evento, error) {
-	sql := `SELECT id, id_usuario, nome, data, tipo, url_slug FROM eventos WHERE id = $1 AND id_usuario = $2`
-	row := r.db.QueryRow(ctx, sql, eventID, userID)
-
-	var id, idUsuario uuid.UUID
-	var nome, tipo, urlSlug string
-	var data time.Time
-
-	err := row.Scan(&id, &idUsuario, &nome, &data, &tipo, &urlSlug)
+	sql := `SELECT ` + colunasEvento + ` FROM eventos WHERE id = $1 AND id_usuario = $2`
+	evento, err := scanEvento(r.db.QueryRow(ctx, sql, eventID, userID))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, domain.ErrEventoNaoEncontrado
@@ -75,5 +67,29 @@ func (r *PostgresEventoRepository) FindByID(ctx context.Context, userID, eventID
 		return nil, fmt.Errorf("erro ao buscar evento por id: %w", err)
 	}
 
-	return domain.HydrateEvento(id, idUsuario, nome, data, domain.TipoEvento(tipo), urlSlug), nil
+	return evento, nil
+}
+
+// colunasEvento é a projeção comum a todas as consultas que hidratam um Evento.
+const colunasEvento = `id, id_usuario, nome, data, tipo, url_slug, id_template, id_template_arquivo, paleta_cores, prazo_rsvp`
+
+// scanEvento lê uma linha projetada com colunasEvento.
+func scanEvento(row pgx.Row) (*domain.Evento, error) {
+	var id, idUsuario uuid.UUID
+	var nome, tipo, urlSlug string
+	var data time.Time
+	var pIDTemplate, pIDTemplateArquivo *string
+	var paleta domain.PaletaCores
+	var prazoRSVP *time.Time
+
+	if err := row.Scan(&id, &idUsuario, &nome, &data, &tipo, &urlSlug, &pIDTemplate, &pIDTemplateArquivo, &paleta, &prazoRSVP); err != nil {
+		return nil, err
+	}
+
+	idTemplate := ""
+	if pIDTemplate != nil {
+		idTemplate = *pIDTemplate
+	}
+
+	return domain.HydrateEvento(id, idUsuario, nome, data, domain.TipoEvento(tipo), urlSlug, idTemplate, pIDTemplateArquivo, paleta, prazoRSVP), nil
 }
@@ -3,14 +3,13 @@ package infrastructure
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/google/uuid"
 	"github.com/luiszkm/wedding_backend/internal/event/domain"
 )
 
 func (r *PostgresEventoRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.Evento, error) {
-	sql := `SELECT id, id_usuario, nome, data, tipo, url_slug FROM eventos WHERE id_usuario = $1 ORDER BY data DESC`
+	sql := `SELECT ` + colunasEvento + ` FROM eventos WHERE id_usuario = $1 ORDER BY data DESC`
 	rows, err := r.db.Query(ctx, sql, userID)
 	if err != nil {
 		return nil, fmt.Errorf("erro ao buscar eventos por usuário: %w", err)
@@ -19,16 +18,12 @@ func (r *PostgresEventoRepository) FindByUserID(ctx context.Context, userID uuid
 
 	var eventos []*domain.Evento
 	for rows.Next() {
-		var id, idUsuario uuid.UUID
-		var nome, tipo, urlSlug string
-		var data time.Time
-
-		err := rows.Scan(&id, &idUsuario, &nome, &data, &tipo, &urlSlug)
+		evento, err := scanEvento(rows)
 		if err != nil {
 			return nil, fmt.Errorf("erro ao scanear evento: %w", err)
 		}
 
-		eventos = append(eventos, domain.HydrateEvento(id, idUsuario, nome, data, domain.TipoEvento(tipo), urlSlug))
+		eventos = append(eventos, evento)
 	}
 
 	if err := rows.Err(); err != nil {
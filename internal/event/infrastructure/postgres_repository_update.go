@@ -12,7 +12,8 @@ import (
 func (r *PostgresEventoRepository) Update(ctx context.Context, evento *domain.Evento) error {
 	sql := `
         UPDATE eventos
-        SET nome = $2, data = $3, tipo = $4, url_slug = $5
+        SET nome = $2, data = $3, tipo = $4, url_slug = $5,
+            id_template = $6, id_template_arquivo = $7, paleta_cores = $8, prazo_rsvp = $9
         WHERE id = $1
     `
 	result, err := r.db.Exec(ctx, sql,
@@ -21,6 +22,10 @@ func (r *PostgresEventoRepository) Update(ctx context.Context, evento *domain.Ev
 		evento.Data(),
 		evento.Tipo(),
 		evento.UrlSlug(),
+		evento.IDTemplate(),
+		evento.IDTemplateArquivo(),
+		evento.PaletaCores(),
+		evento.PrazoRSVP(),
 	)
 	if err != nil {
 		return fmt.Errorf("falha ao atualizar evento: %w", err)
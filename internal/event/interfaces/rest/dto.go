@@ -1,7 +1,11 @@
 // file: internal/event/interfaces/rest/dto.go
 package rest
 
-import "time"
+import (
+	"time"
+
+	"github.com/luiszkm/wedding_backend/internal/event/domain"
+)
 
 type CriarEventoRequestDTO struct {
 	Nome    string    `json:"nome"`
@@ -15,9 +19,49 @@ type CriarEventoResponseDTO struct {
 }
 
 type EventoResponseDTO struct {
-	ID      string    `json:"id"`
-	Nome    string    `json:"nome"`
-	Data    time.Time `json:"data"`
-	Tipo    string    `json:"tipo"`
-	UrlSlug string    `json:"urlSlug"`
+	ID                string            `json:"id"`
+	Nome              string            `json:"nome"`
+	Data              time.Time         `json:"data"`
+	Tipo              string            `json:"tipo"`
+	UrlSlug           string            `json:"urlSlug"`
+	IDTemplate        string            `json:"idTemplate"`
+	IDTemplateArquivo *string           `json:"idTemplateArquivo,omitempty"`
+	PaletaCores       map[string]string `json:"paletaCores"`
+	PrazoRSVP         *time.Time        `json:"prazoRSVP"`
+}
+
+// AtualizarTemplateRequestDTO segue o contrato descrito em docs/template-system.md.
+type AtualizarTemplateRequestDTO struct {
+	IsBespoke          bool              `json:"is_bespoke"`
+	StandardTemplateID string            `json:"standard_template_id"`
+	BespokeFileName    string            `json:"bespoke_file_name"`
+	PaletaCores        map[string]string `json:"paleta_cores"`
+}
+
+// DefinirPrazoRSVPRequestDTO define o prazo de RSVP; null remove o prazo.
+type DefinirPrazoRSVPRequestDTO struct {
+	PrazoRSVP *time.Time `json:"prazoRSVP"`
+}
+
+// AtualizarPaletaRequestDTO aceita qualquer subconjunto de cores; as omitidas vêm do template.
+type AtualizarPaletaRequestDTO struct {
+	PaletaCores map[string]string `json:"paleta_cores"`
+}
+
+type MensagemResponseDTO struct {
+	Message string `json:"message"`
+}
+
+func toEventoResponseDTO(evento *domain.Evento) EventoResponseDTO {
+	return EventoResponseDTO{
+		ID:                evento.ID().String(),
+		Nome:              evento.Nome(),
+		Data:              evento.Data(),
+		Tipo:              string(evento.Tipo()),
+		UrlSlug:           evento.UrlSlug(),
+		IDTemplate:        evento.IDTemplate(),
+		IDTemplateArquivo: evento.IDTemplateArquivo(),
+		PaletaCores:       evento.PaletaCores(),
+		PrazoRSVP:         evento.PrazoRSVP(),
+	}
 }
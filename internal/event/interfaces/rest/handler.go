@@ -3,12 +3,15 @@ package rest
 
 import (
 	"encoding/json"
+	"errors"
 	"log"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"github.com/luiszkm/wedding_backend/internal/event/application"
+	"github.com/luiszkm/wedding_backend/internal/event/domain"
+	pageTemplateDomain "github.com/luiszkm/wedding_backend/internal/pagetemplate/domain"
 	"github.com/luiszkm/wedding_backend/internal/platform/auth"
 	"github.com/luiszkm/wedding_backend/internal/platform/web"
 )
@@ -70,13 +73,7 @@ func (h *EventHandler) HandleObterEventoPorSlug(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	respDTO := EventoResponseDTO{
-		ID:       evento.ID().String(),
-		Nome:     evento.Nome(),
-		Data:     evento.Data(),
-		Tipo:     string(evento.Tipo()),
-		UrlSlug:  evento.UrlSlug(),
-	}
+	respDTO := toEventoResponseDTO(evento)
 	web.Respond(w, r, respDTO, http.StatusOK)
 }
 
@@ -96,13 +93,7 @@ func (h *EventHandler) HandleListarEventosPorUsuario(w http.ResponseWriter, r *h
 
 	var eventosDTO []EventoResponseDTO
 	for _, evento := range eventos {
-		eventosDTO = append(eventosDTO, EventoResponseDTO{
-			ID:       evento.ID().String(),
-			Nome:     evento.Nome(),
-			Data:     evento.Data(),
-			Tipo:     string(evento.Tipo()),
-			UrlSlug:  evento.UrlSlug(),
-		})
+		eventosDTO = append(eventosDTO, toEventoResponseDTO(evento))
 	}
 
 	web.Respond(w, r, eventosDTO, http.StatusOK)
@@ -134,12 +125,134 @@ func (h *EventHandler) HandleObterEventoPorID(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	respDTO := EventoResponseDTO{
-		ID:       evento.ID().String(),
-		Nome:     evento.Nome(),
-		Data:     evento.Data(),
-		Tipo:     string(evento.Tipo()),
-		UrlSlug:  evento.UrlSlug(),
-	}
+	respDTO := toEventoResponseDTO(evento)
 	web.Respond(w, r, respDTO, http.StatusOK)
 }
+
+func (h *EventHandler) HandleAtualizarTemplate(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente no token.", http.StatusUnauthorized)
+		return
+	}
+
+	eventID, err := uuid.Parse(chi.URLParam(r, "idEvento"))
+	if err != nil {
+		web.RespondError(w, r, "ID_INVALIDO", "O ID do evento deve ser um UUID válido.", http.StatusBadRequest)
+		return
+	}
+
+	var reqDTO AtualizarTemplateRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&reqDTO); err != nil {
+		web.RespondError(w, r, "CORPO_INVALIDO", "O corpo da requisição está malformado.", http.StatusBadRequest)
+		return
+	}
+
+	_, err = h.service.AtualizarTemplate(
+		r.Context(),
+		userID,
+		eventID,
+		reqDTO.IsBespoke,
+		reqDTO.StandardTemplateID,
+		reqDTO.BespokeFileName,
+		domain.PaletaCores(reqDTO.PaletaCores),
+	)
+	if err != nil {
+		switch {
+		case errors.Is(err, pageTemplateDomain.ErrTemplateNaoEncontrado):
+			web.RespondError(w, r, "TEMPLATE_NAO_ENCONTRADO", "Template não encontrado.", http.StatusBadRequest)
+		case errors.Is(err, domain.ErrArquivoTemplateInvalido):
+			web.RespondError(w, r, "TEMPLATE_INVALIDO", "Nome de arquivo bespoke inválido: use apenas o nome do arquivo .html.", http.StatusBadRequest)
+		case errors.Is(err, domain.ErrCorDesconhecida), errors.Is(err, domain.ErrCorHexInvalida):
+			web.RespondError(w, r, "PALETA_INVALIDA", err.Error(), http.StatusBadRequest)
+		case errors.Is(err, domain.ErrContrasteInsuficiente):
+			web.RespondError(w, r, "CONTRASTE_INSUFICIENTE", err.Error(), http.StatusUnprocessableEntity)
+		case errors.Is(err, domain.ErrEventoNaoEncontrado):
+			web.RespondError(w, r, "EVENTO_NAO_ENCONTRADO", "Evento não encontrado.", http.StatusNotFound)
+		default:
+			log.Printf("ERRO ao atualizar template do evento: %v", err)
+			web.RespondError(w, r, "ERRO_INTERNO", "Erro ao atualizar o template.", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	web.Respond(w, r, MensagemResponseDTO{Message: "Template atualizado com sucesso"}, http.StatusOK)
+}
+
+func (h *EventHandler) HandleAtualizarPaleta(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente no token.", http.StatusUnauthorized)
+		return
+	}
+
+	eventID, err := uuid.Parse(chi.URLParam(r, "idEvento"))
+	if err != nil {
+		web.RespondError(w, r, "ID_INVALIDO", "O ID do evento deve ser um UUID válido.", http.StatusBadRequest)
+		return
+	}
+
+	var reqDTO AtualizarPaletaRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&reqDTO); err != nil {
+		web.RespondError(w, r, "CORPO_INVALIDO", "O corpo da requisição está malformado.", http.StatusBadRequest)
+		return
+	}
+	if len(reqDTO.PaletaCores) == 0 {
+		web.RespondError(w, r, "PALETA_INVALIDA", "Informe ao menos uma cor da paleta.", http.StatusBadRequest)
+		return
+	}
+
+	evento, err := h.service.AtualizarPaleta(r.Context(), userID, eventID, domain.PaletaCores(reqDTO.PaletaCores))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrCorDesconhecida), errors.Is(err, domain.ErrCorHexInvalida):
+			web.RespondError(w, r, "PALETA_INVALIDA", err.Error(), http.StatusBadRequest)
+		case errors.Is(err, domain.ErrContrasteInsuficiente):
+			web.RespondError(w, r, "CONTRASTE_INSUFICIENTE", err.Error(), http.StatusUnprocessableEntity)
+		case errors.Is(err, domain.ErrEventoNaoEncontrado):
+			web.RespondError(w, r, "EVENTO_NAO_ENCONTRADO", "Evento não encontrado.", http.StatusNotFound)
+		default:
+			log.Printf("ERRO ao atualizar paleta do evento: %v", err)
+			web.RespondError(w, r, "ERRO_INTERNO", "Erro ao atualizar a paleta.", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	web.Respond(w, r, toEventoResponseDTO(evento), http.StatusOK)
+}
+
+func (h *EventHandler) HandleDefinirPrazoRSVP(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente no token.", http.StatusUnauthorized)
+		return
+	}
+
+	eventID, err := uuid.Parse(chi.URLParam(r, "idEvento"))
+	if err != nil {
+		web.RespondError(w, r, "ID_INVALIDO", "O ID do evento deve ser um UUID válido.", http.StatusBadRequest)
+		return
+	}
+
+	var reqDTO DefinirPrazoRSVPRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&reqDTO); err != nil {
+		web.RespondError(w, r, "CORPO_INVALIDO", "O corpo da requisição está malformado.", http.StatusBadRequest)
+		return
+	}
+
+	evento, err := h.service.DefinirPrazoRSVP(r.Context(), userID, eventID, reqDTO.PrazoRSVP)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPrazoRSVPAposEvento):
+			web.RespondError(w, r, "PRAZO_INVALIDO", err.Error(), http.StatusBadRequest)
+		case errors.Is(err, domain.ErrEventoNaoEncontrado):
+			web.RespondError(w, r, "EVENTO_NAO_ENCONTRADO", "Evento não encontrado.", http.StatusNotFound)
+		default:
+			log.Printf("ERRO ao definir prazo de RSVP do evento: %v", err)
+			web.RespondError(w, r, "ERRO_INTERNO", "Erro ao definir o prazo de RSVP.", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	web.Respond(w, r, toEventoResponseDTO(evento), http.StatusOK)
+}
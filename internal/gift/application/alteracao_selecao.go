@@ -0,0 +1,65 @@
+// file: internal/gift/application/alteracao_selecao.go
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/luiszkm/wedding_backend/internal/gift/domain"
+)
+
+// EditarSelecao troca os itens de uma seleção confirmada do grupo da chave. Os itens que
+// saem voltam a ficar disponíveis na mesma transação.
+func (s *GiftService) EditarSelecao(ctx context.Context, acesso domain.AcessoConvidado, idSelecao uuid.UUID, itens []ItemSelecao) (*domain.AlteracaoSelecao, error) {
+	return s.alterarSelecao(ctx, idSelecao, itens, domain.OrigemConvidado(acesso))
+}
+
+// CancelarSelecao desfaz a seleção do grupo da chave, liberando todos os itens.
+func (s *GiftService) CancelarSelecao(ctx context.Context, acesso domain.AcessoConvidado, idSelecao uuid.UUID) (*domain.AlteracaoSelecao, error) {
+	return s.alterarSelecao(ctx, idSelecao, nil, domain.OrigemConvidado(acesso))
+}
+
+// EditarSelecaoDoEvento é a edição feita pelo anfitrião em qualquer seleção do evento.
+func (s *GiftService) EditarSelecaoDoEvento(ctx context.Context, userID, idEvento, idSelecao uuid.UUID, itens []ItemSelecao) (*domain.AlteracaoSelecao, error) {
+	if _, err := s.eventRepo.FindByID(ctx, userID, idEvento); err != nil {
+		return nil, fmt.Errorf("permissão negada ou evento não encontrado: %w", err)
+	}
+	return s.alterarSelecao(ctx, idSelecao, itens, domain.OrigemAnfitriao(idEvento, userID))
+}
+
+// CancelarSelecaoDoEvento é o cancelamento feito pelo anfitrião.
+func (s *GiftService) CancelarSelecaoDoEvento(ctx context.Context, userID, idEvento, idSelecao uuid.UUID) (*domain.AlteracaoSelecao, error) {
+	if _, err := s.eventRepo.FindByID(ctx, userID, idEvento); err != nil {
+		return nil, fmt.Errorf("permissão negada ou evento não encontrado: %w", err)
+	}
+	return s.alterarSelecao(ctx, idSelecao, nil, domain.OrigemAnfitriao(idEvento, userID))
+}
+
+func (s *GiftService) ListarAlteracoesDeSelecoes(ctx context.Context, userID, idEvento uuid.UUID) ([]domain.AlteracaoSelecao, error) {
+	if _, err := s.eventRepo.FindByID(ctx, userID, idEvento); err != nil {
+		return nil, fmt.Errorf("permissão negada ou evento não encontrado: %w", err)
+	}
+	alteracoes, err := s.selecaoRepo.ListarAlteracoes(ctx, idEvento)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao buscar histórico de seleções: %w", err)
+	}
+	return alteracoes, nil
+}
+
+// alterarSelecao grava a nova lista de itens; sem itens, a seleção é cancelada.
+func (s *GiftService) alterarSelecao(ctx context.Context, idSelecao uuid.UUID, itens []ItemSelecao, origem domain.OrigemAlteracaoSelecao) (*domain.AlteracaoSelecao, error) {
+	var itensMap map[uuid.UUID]int
+	if itens != nil {
+		var err error
+		if itensMap, err = s.conferirQuantidades(ctx, origem.IDEvento, itens); err != nil {
+			return nil, err
+		}
+	}
+
+	alteracao, err := s.selecaoRepo.AlterarSelecao(ctx, idSelecao, itensMap, origem)
+	if err != nil {
+		return nil, fmt.Errorf("falha no serviço ao alterar seleção: %w", err)
+	}
+	return alteracao, nil
+}
@@ -0,0 +1,161 @@
+// file: internal/gift/application/pagamento.go
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/luiszkm/wedding_backend/internal/gift/domain"
+)
+
+var ErrCheckoutIndisponivel = errors.New("não foi possível abrir o pagamento agora; os presentes foram liberados")
+
+// margemExpiracao dá tempo para a notificação de expiração do próprio gateway chegar
+// antes de a varredura expirar o pagamento por conta própria.
+const (
+	margemExpiracao        = 5 * time.Minute
+	loteVarreduraPagamento = 100
+)
+
+type PagamentoService struct {
+	repo     domain.PagamentoRepository
+	gateway  domain.PaymentGateway
+	validade time.Duration
+}
+
+// NewPagamentoService recebe o gateway das seleções de presentes; com gateway nil o
+// pagamento online fica desabilitado.
+func NewPagamentoService(repo domain.PagamentoRepository, gateway domain.PaymentGateway, validade time.Duration) *PagamentoService {
+	return &PagamentoService{repo: repo, gateway: gateway, validade: validade}
+}
+
+func (s *PagamentoService) Habilitado() bool {
+	return s.gateway != nil
+}
+
+// AbrirCheckout cobra no gateway o valor da seleção já gravada. O pagamento é registrado
+// como pendente antes da chamada ao gateway, para que a varredura libere as cotas mesmo
+// se o processo cair no meio; se o gateway falhar, os presentes são liberados na hora.
+func (s *PagamentoService) AbrirCheckout(ctx context.Context, selecao *domain.Selecao) (*domain.Pagamento, error) {
+	if !s.Habilitado() {
+		return nil, domain.ErrPagamentoOnlineIndisponivel
+	}
+	pagamento, err := domain.NewPagamento(selecao.ID(), selecao.CalcularValorTotal(), time.Now().Add(s.validade))
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.Save(ctx, pagamento); err != nil {
+		return nil, fmt.Errorf("falha ao registrar pagamento pendente: %w", err)
+	}
+
+	checkout, err := s.gateway.CriarCheckoutSelecao(ctx, selecao, pagamento.Valor())
+	if err != nil {
+		if _, errStatus := pagamento.Atualizar(domain.StatusPagamentoFalhou); errStatus == nil {
+			if errLiberar := s.repo.Update(ctx, pagamento); errLiberar != nil {
+				log.Printf("ERRO ao liberar presentes da seleção %s após falha no gateway: %v", selecao.ID(), errLiberar)
+			}
+		}
+		return nil, fmt.Errorf("%w: %v", ErrCheckoutIndisponivel, err)
+	}
+
+	pagamento.VincularCheckout(checkout)
+	if err := s.repo.Save(ctx, pagamento); err != nil {
+		return nil, fmt.Errorf("falha ao salvar checkout da seleção: %w", err)
+	}
+	return pagamento, nil
+}
+
+func (s *PagamentoService) ConsultarPagamento(ctx context.Context, idSelecao uuid.UUID) (*domain.Pagamento, error) {
+	return s.repo.FindByIDSelecao(ctx, idSelecao)
+}
+
+// ProcessarWebhook aplica a notificação do gateway. Notificações repetidas, atrasadas
+// ou de pagamentos desconhecidos são ignoradas com log, para o gateway não reenviá-las.
+func (s *PagamentoService) ProcessarWebhook(ctx context.Context, payload []byte, cabecalhos map[string][]string) error {
+	if !s.Habilitado() {
+		return domain.ErrPagamentoOnlineIndisponivel
+	}
+	evento, err := s.gateway.InterpretarWebhook(payload, cabecalhos)
+	if err != nil {
+		return err
+	}
+	if evento == nil {
+		return nil
+	}
+
+	pagamento, err := s.repo.FindByIDSelecao(ctx, evento.IDSelecao)
+	if errors.Is(err, domain.ErrPagamentoNaoEncontrado) {
+		log.Printf("Aviso: notificação de pagamento para seleção desconhecida %s", evento.IDSelecao)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if pagamento.IDExterno() != "" && pagamento.IDExterno() != evento.IDExterno {
+		log.Printf("Aviso: notificação do checkout %s não corresponde ao da seleção %s", evento.IDExterno, evento.IDSelecao)
+		return nil
+	}
+	return s.atualizarStatus(ctx, pagamento, evento.Status)
+}
+
+// ExpirarPagamentosVencidos expira os pendentes que passaram do prazo e devolve quantos
+// foram expirados.
+func (s *PagamentoService) ExpirarPagamentosVencidos(ctx context.Context) (int, error) {
+	expirados := 0
+	for {
+		vencidos, err := s.repo.ListarVencidos(ctx, time.Now().Add(-margemExpiracao), loteVarreduraPagamento)
+		if err != nil {
+			return expirados, fmt.Errorf("falha ao buscar pagamentos vencidos: %w", err)
+		}
+		for _, pagamento := range vencidos {
+			if err := s.atualizarStatus(ctx, pagamento, domain.StatusPagamentoExpirado); err != nil {
+				return expirados, err
+			}
+			expirados++
+		}
+		if len(vencidos) < loteVarreduraPagamento {
+			return expirados, nil
+		}
+	}
+}
+
+// VarrerPagamentosVencidos roda ExpirarPagamentosVencidos a cada intervalo até o contexto acabar.
+func (s *PagamentoService) VarrerPagamentosVencidos(ctx context.Context, intervalo time.Duration) {
+	ticker := time.NewTicker(intervalo)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := s.ExpirarPagamentosVencidos(ctx)
+			if err != nil {
+				log.Printf("ERRO na varredura de pagamentos de presentes: %v", err)
+			} else if n > 0 {
+				log.Printf("%d pagamento(s) de presentes expirado(s); cotas liberadas.", n)
+			}
+		}
+	}
+}
+
+func (s *PagamentoService) atualizarStatus(ctx context.Context, pagamento *domain.Pagamento, status domain.StatusPagamento) error {
+	mudou, err := pagamento.Atualizar(status)
+	if errors.Is(err, domain.ErrPagamentoJaFinalizado) {
+		log.Printf("Aviso: pagamento da seleção %s já está %s; %s ignorado.", pagamento.IDSelecao(), pagamento.Status(), status)
+		return nil
+	}
+	if err != nil || !mudou {
+		return err
+	}
+	if err := s.repo.Update(ctx, pagamento); err != nil {
+		if errors.Is(err, domain.ErrPagamentoJaFinalizado) {
+			return nil
+		}
+		return fmt.Errorf("falha ao atualizar pagamento da seleção %s: %w", pagamento.IDSelecao(), err)
+	}
+	return nil
+}
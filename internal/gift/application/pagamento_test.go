@@ -0,0 +1,217 @@
+// file: internal/gift/application/pagamento_test.go
+package application
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/luiszkm/wedding_backend/internal/gift/domain"
+	"github.com/luiszkm/wedding_backend/internal/gift/infrastructure"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pagamentoRepoMemoria imita as regras do repositório Postgres: Save só mexe em
+// pagamentos pendentes e Update só finaliza pendentes, anotando as seleções liberadas.
+type pagamentoRepoMemoria struct {
+	mu         sync.Mutex
+	pagamentos map[uuid.UUID]domain.Pagamento
+	liberadas  []uuid.UUID
+}
+
+func novoPagamentoRepoMemoria() *pagamentoRepoMemoria {
+	return &pagamentoRepoMemoria{pagamentos: make(map[uuid.UUID]domain.Pagamento)}
+}
+
+func (r *pagamentoRepoMemoria) Save(ctx context.Context, pagamento *domain.Pagamento) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if atual, ok := r.pagamentos[pagamento.IDSelecao()]; ok && atual.Status() != domain.StatusPagamentoPendente {
+		return domain.ErrPagamentoNaoEncontrado
+	}
+	r.pagamentos[pagamento.IDSelecao()] = *pagamento
+	return nil
+}
+
+func (r *pagamentoRepoMemoria) FindByIDSelecao(ctx context.Context, idSelecao uuid.UUID) (*domain.Pagamento, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	pagamento, ok := r.pagamentos[idSelecao]
+	if !ok {
+		return nil, domain.ErrPagamentoNaoEncontrado
+	}
+	return &pagamento, nil
+}
+
+func (r *pagamentoRepoMemoria) Update(ctx context.Context, pagamento *domain.Pagamento) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	atual, ok := r.pagamentos[pagamento.IDSelecao()]
+	if !ok || atual.Status() != domain.StatusPagamentoPendente {
+		return domain.ErrPagamentoJaFinalizado
+	}
+	r.pagamentos[pagamento.IDSelecao()] = *pagamento
+	if pagamento.LiberaPresentes() {
+		r.liberadas = append(r.liberadas, pagamento.IDSelecao())
+	}
+	return nil
+}
+
+func (r *pagamentoRepoMemoria) ListarVencidos(ctx context.Context, ate time.Time, limite int) ([]*domain.Pagamento, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var vencidos []*domain.Pagamento
+	for _, pagamento := range r.pagamentos {
+		if pagamento.Vencido(ate) {
+			p := pagamento
+			vencidos = append(vencidos, &p)
+		}
+	}
+	sort.Slice(vencidos, func(i, j int) bool { return vencidos[i].ExpiraEm().Before(vencidos[j].ExpiraEm()) })
+	if len(vencidos) > limite {
+		vencidos = vencidos[:limite]
+	}
+	return vencidos, nil
+}
+
+func (r *pagamentoRepoMemoria) foiLiberada(idSelecao uuid.UUID) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, id := range r.liberadas {
+		if id == idSelecao {
+			return true
+		}
+	}
+	return false
+}
+
+func selecaoComCotas(valorCota float64, quantidade int) *domain.Selecao {
+	return domain.NewSelecao(uuid.New(), uuid.New(), []domain.PresenteConfirmado{
+		{ID: uuid.New(), Nome: "Jantar", Quantidade: quantidade, ValorCota: &valorCota},
+	})
+}
+
+func TestPagamentoService(t *testing.T) {
+	ctx := context.Background()
+	const segredo = "segredo-de-teste"
+
+	t.Run("deve abrir o checkout com o valor da seleção", func(t *testing.T) {
+		repo := novoPagamentoRepoMemoria()
+		gateway := infrastructure.NewFakePaymentGateway(segredo, time.Hour)
+		service := NewPagamentoService(repo, gateway, time.Hour)
+		selecao := selecaoComCotas(50, 3)
+
+		pagamento, err := service.AbrirCheckout(ctx, selecao)
+
+		require.NoError(t, err)
+		checkout, valor, ok := gateway.Checkout(selecao.ID())
+		assert.True(t, ok)
+		assert.Equal(t, 150.0, valor)
+		assert.Equal(t, domain.StatusPagamentoPendente, pagamento.Status())
+		assert.Equal(t, checkout.URL, pagamento.CheckoutURL())
+
+		salvo, err := service.ConsultarPagamento(ctx, selecao.ID())
+		require.NoError(t, err)
+		assert.Equal(t, checkout.IDExterno, salvo.IDExterno())
+	})
+
+	t.Run("deve liberar os presentes quando o gateway falha", func(t *testing.T) {
+		repo := novoPagamentoRepoMemoria()
+		gateway := infrastructure.NewFakePaymentGateway(segredo, time.Hour)
+		gateway.FalharCheckouts(errors.New("gateway fora do ar"))
+		service := NewPagamentoService(repo, gateway, time.Hour)
+		selecao := selecaoComCotas(50, 1)
+
+		_, err := service.AbrirCheckout(ctx, selecao)
+
+		assert.ErrorIs(t, err, ErrCheckoutIndisponivel)
+		salvo, _ := service.ConsultarPagamento(ctx, selecao.ID())
+		assert.Equal(t, domain.StatusPagamentoFalhou, salvo.Status())
+		assert.True(t, repo.foiLiberada(selecao.ID()))
+	})
+
+	t.Run("deve recusar sem gateway configurado", func(t *testing.T) {
+		service := NewPagamentoService(novoPagamentoRepoMemoria(), nil, time.Hour)
+
+		_, err := service.AbrirCheckout(ctx, selecaoComCotas(50, 1))
+
+		assert.False(t, service.Habilitado())
+		assert.ErrorIs(t, err, domain.ErrPagamentoOnlineIndisponivel)
+	})
+
+	t.Run("deve confirmar pelo webhook e ignorar reenvios", func(t *testing.T) {
+		repo := novoPagamentoRepoMemoria()
+		gateway := infrastructure.NewFakePaymentGateway(segredo, time.Hour)
+		service := NewPagamentoService(repo, gateway, time.Hour)
+		selecao := selecaoComCotas(50, 2)
+		_, err := service.AbrirCheckout(ctx, selecao)
+		require.NoError(t, err)
+
+		payload, cabecalhos := gateway.Notificacao(selecao.ID(), domain.StatusPagamentoPago)
+		assert.NoError(t, service.ProcessarWebhook(ctx, payload, cabecalhos))
+		assert.NoError(t, service.ProcessarWebhook(ctx, payload, cabecalhos))
+
+		// Uma expiração atrasada não desfaz o pagamento confirmado.
+		payload, cabecalhos = gateway.Notificacao(selecao.ID(), domain.StatusPagamentoExpirado)
+		assert.NoError(t, service.ProcessarWebhook(ctx, payload, cabecalhos))
+
+		salvo, _ := service.ConsultarPagamento(ctx, selecao.ID())
+		assert.Equal(t, domain.StatusPagamentoPago, salvo.Status())
+		assert.False(t, repo.foiLiberada(selecao.ID()))
+	})
+
+	t.Run("deve liberar os presentes quando o pagamento falha", func(t *testing.T) {
+		repo := novoPagamentoRepoMemoria()
+		gateway := infrastructure.NewFakePaymentGateway(segredo, time.Hour)
+		service := NewPagamentoService(repo, gateway, time.Hour)
+		selecao := selecaoComCotas(50, 2)
+		_, err := service.AbrirCheckout(ctx, selecao)
+		require.NoError(t, err)
+
+		payload, cabecalhos := gateway.Notificacao(selecao.ID(), domain.StatusPagamentoFalhou)
+		assert.NoError(t, service.ProcessarWebhook(ctx, payload, cabecalhos))
+
+		assert.True(t, repo.foiLiberada(selecao.ID()))
+	})
+
+	t.Run("deve recusar notificação com assinatura inválida", func(t *testing.T) {
+		repo := novoPagamentoRepoMemoria()
+		gateway := infrastructure.NewFakePaymentGateway(segredo, time.Hour)
+		service := NewPagamentoService(repo, gateway, time.Hour)
+		selecao := selecaoComCotas(50, 1)
+		_, err := service.AbrirCheckout(ctx, selecao)
+		require.NoError(t, err)
+
+		payload, _ := gateway.Notificacao(selecao.ID(), domain.StatusPagamentoPago)
+		err = service.ProcessarWebhook(ctx, payload, map[string][]string{infrastructure.CabecalhoAssinaturaFake: {"forjada"}})
+
+		assert.ErrorIs(t, err, domain.ErrNotificacaoPagamentoInvalida)
+		salvo, _ := service.ConsultarPagamento(ctx, selecao.ID())
+		assert.Equal(t, domain.StatusPagamentoPendente, salvo.Status())
+	})
+
+	t.Run("deve expirar só os pendentes vencidos", func(t *testing.T) {
+		repo := novoPagamentoRepoMemoria()
+		vencido := NewPagamentoService(repo, infrastructure.NewFakePaymentGateway(segredo, -time.Hour), -time.Hour)
+		emDia := NewPagamentoService(repo, infrastructure.NewFakePaymentGateway(segredo, time.Hour), time.Hour)
+		selecaoVencida, selecaoEmDia := selecaoComCotas(50, 1), selecaoComCotas(50, 1)
+		_, err := vencido.AbrirCheckout(ctx, selecaoVencida)
+		require.NoError(t, err)
+		_, err = emDia.AbrirCheckout(ctx, selecaoEmDia)
+		require.NoError(t, err)
+
+		n, err := emDia.ExpirarPagamentosVencidos(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.True(t, repo.foiLiberada(selecaoVencida.ID()))
+		assert.False(t, repo.foiLiberada(selecaoEmDia.ID()))
+		salvo, _ := emDia.ConsultarPagamento(ctx, selecaoEmDia.ID())
+		assert.Equal(t, domain.StatusPagamentoPendente, salvo.Status())
+	})
+}
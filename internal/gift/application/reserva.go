@@ -0,0 +1,129 @@
+// file: internal/gift/application/reserva.go
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/luiszkm/wedding_backend/internal/gift/domain"
+)
+
+const (
+	DuracaoReservaPadrao = 15 * time.Minute
+	loteVarreduraReserva = 100
+)
+
+// ReservarPresentes segura os itens para o grupo durante a duração configurada, antes de
+// o convidado confirmar a seleção ou pagar. A disponibilidade é conferida só na
+// transação, porque os itens da reserva anterior do próprio grupo entram na nova.
+func (s *GiftService) ReservarPresentes(ctx context.Context, acesso domain.AcessoConvidado, itens []ItemSelecao) (*domain.Reserva, error) {
+	itensMap, err := s.conferirQuantidades(ctx, acesso.IDEvento, itens)
+	if err != nil {
+		return nil, err
+	}
+
+	reserva, err := s.selecaoRepo.SalvarReserva(ctx, acesso, itensMap, time.Now().Add(s.duracaoReserva))
+	if err != nil {
+		return nil, fmt.Errorf("falha no serviço ao reservar presentes: %w", err)
+	}
+	return reserva, nil
+}
+
+// conferirQuantidades confere a lista pedida contra o tamanho dos presentes. A
+// disponibilidade fica para a transação, que conta os itens que o grupo já segura.
+func (s *GiftService) conferirQuantidades(ctx context.Context, idEvento uuid.UUID, itens []ItemSelecao) (map[uuid.UUID]int, error) {
+	itensMap, presentes, err := s.buscarItensSelecao(ctx, idEvento, itens)
+	if err != nil {
+		return nil, err
+	}
+	for _, presente := range presentes {
+		quantidade := itensMap[presente.ID()]
+		if presente.EhIntegral() && quantidade != 1 {
+			return nil, fmt.Errorf("presente integral %s deve ter quantidade 1", presente.Nome())
+		}
+		if presente.EhFracionado() && quantidade > len(presente.Cotas()) {
+			return nil, fmt.Errorf("presente %s tem apenas %d cotas, solicitado %d", presente.Nome(), len(presente.Cotas()), quantidade)
+		}
+	}
+	return itensMap, nil
+}
+
+// FinalizarReserva confirma a reserva do grupo como seleção e, com pagamentoOnline,
+// abre o checkout das cotas. A seleção confirmada mantém o ID da reserva.
+func (s *GiftService) FinalizarReserva(ctx context.Context, acesso domain.AcessoConvidado, idReserva uuid.UUID, pagamentoOnline bool) (*domain.Selecao, *domain.Pagamento, error) {
+	if pagamentoOnline {
+		if s.pagamentos == nil || !s.pagamentos.Habilitado() {
+			return nil, nil, domain.ErrPagamentoOnlineIndisponivel
+		}
+		reserva, err := s.selecaoRepo.FindReserva(ctx, acesso, idReserva)
+		if err != nil {
+			return nil, nil, err
+		}
+		if reserva.Selecao().CalcularValorTotal() <= 0 {
+			return nil, nil, domain.ErrSemValorAPagar
+		}
+	}
+
+	selecao, err := s.selecaoRepo.ConfirmarReserva(ctx, acesso, idReserva)
+	if err != nil {
+		return nil, nil, fmt.Errorf("falha no serviço ao confirmar reserva: %w", err)
+	}
+	if !pagamentoOnline {
+		return selecao, nil, nil
+	}
+
+	pagamento, err := s.pagamentos.AbrirCheckout(ctx, selecao)
+	if err != nil {
+		return nil, nil, fmt.Errorf("falha ao abrir pagamento da seleção: %w", err)
+	}
+	return selecao, pagamento, nil
+}
+
+// LiberarReservasVencidas devolve os itens das reservas que passaram do prazo e
+// devolve quantas foram liberadas.
+func (s *GiftService) LiberarReservasVencidas(ctx context.Context) (int, error) {
+	liberadas := 0
+	for {
+		agora := time.Now()
+		vencidas, err := s.selecaoRepo.ListarReservasVencidas(ctx, agora, loteVarreduraReserva)
+		if err != nil {
+			return liberadas, fmt.Errorf("falha ao buscar reservas vencidas: %w", err)
+		}
+		for _, idReserva := range vencidas {
+			err := s.selecaoRepo.LiberarReserva(ctx, idReserva, agora)
+			if errors.Is(err, domain.ErrReservaNaoEncontrada) {
+				continue // confirmada ou liberada por outra instância no meio do caminho
+			}
+			if err != nil {
+				return liberadas, fmt.Errorf("falha ao liberar reserva %s: %w", idReserva, err)
+			}
+			liberadas++
+		}
+		if len(vencidas) < loteVarreduraReserva {
+			return liberadas, nil
+		}
+	}
+}
+
+// VarrerReservasVencidas roda LiberarReservasVencidas a cada intervalo até o contexto acabar.
+func (s *GiftService) VarrerReservasVencidas(ctx context.Context, intervalo time.Duration) {
+	ticker := time.NewTicker(intervalo)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := s.LiberarReservasVencidas(ctx)
+			if err != nil {
+				log.Printf("ERRO na varredura de reservas de presentes: %v", err)
+			} else if n > 0 {
+				log.Printf("%d reserva(s) de presentes expirada(s); itens liberados.", n)
+			}
+		}
+	}
+}
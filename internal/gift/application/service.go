@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	eventDomain "github.com/luiszkm/wedding_backend/internal/event/domain"
@@ -19,10 +20,13 @@ type GiftService struct {
 	repo        domain.PresenteRepository
 	selecaoRepo domain.SelecaoRepository
 	eventRepo   eventDomain.EventoRepository
+	pagamentos  *PagamentoService
+	// duracaoReserva é quanto tempo uma reserva segura os itens para o grupo.
+	duracaoReserva time.Duration
 }
 
-func NewGiftService(presenteRepo domain.PresenteRepository, selecaoRepo domain.SelecaoRepository, eventRepo eventDomain.EventoRepository) *GiftService {
-	return &GiftService{repo: presenteRepo, selecaoRepo: selecaoRepo, eventRepo: eventRepo}
+func NewGiftService(presenteRepo domain.PresenteRepository, selecaoRepo domain.SelecaoRepository, eventRepo eventDomain.EventoRepository, pagamentos *PagamentoService, duracaoReserva time.Duration) *GiftService {
+	return &GiftService{repo: presenteRepo, selecaoRepo: selecaoRepo, eventRepo: eventRepo, pagamentos: pagamentos, duracaoReserva: duracaoReserva}
 }
 
 func (s *GiftService) CriarPresenteIntegral(ctx context.Context, userID, idEvento uuid.UUID, nome, desc, fotoURL, categoria string, favorito bool, detalhes domain.DetalhesPresente) (*domain.Presente, error) {
@@ -78,6 +82,24 @@ func (s *GiftService) ListarPresentesDisponiveis(ctx context.Context, casamentoI
 	return presentes, nil
 }
 
+// GerarCobrancaPix monta o PIX copia-e-cola de um presente do evento para a tela de
+// pagamento do convidado.
+func (s *GiftService) GerarCobrancaPix(ctx context.Context, idEvento, idPresente uuid.UUID, quantidade int) (*domain.Presente, *domain.CobrancaPix, error) {
+	presentes, err := s.repo.FindByIDs(ctx, []uuid.UUID{idPresente})
+	if err != nil {
+		return nil, nil, fmt.Errorf("falha ao buscar presente: %w", err)
+	}
+	if len(presentes) == 0 || presentes[0].IDCasamento() != idEvento {
+		return nil, nil, domain.ErrPresenteNaoEncontrado
+	}
+
+	cobranca, err := presentes[0].CobrancaPix(quantidade)
+	if err != nil {
+		return nil, nil, err
+	}
+	return presentes[0], cobranca, nil
+}
+
 func (s *GiftService) ListarTodosPresentesPorEvento(ctx context.Context, userID, eventoID uuid.UUID) ([]*domain.PresenteComSelecao, error) {
 	// Verificar permissão: evento deve pertencer ao usuário
 	_, err := s.eventRepo.FindByID(ctx, userID, eventoID)
@@ -93,31 +115,65 @@ func (s *GiftService) ListarTodosPresentesPorEvento(ctx context.Context, userID,
 	return presentesComSelecao, nil
 }
 
-func (s *GiftService) FinalizarSelecaoDePresentes(ctx context.Context, chaveDeAcesso string, itens []ItemSelecao) (*domain.Selecao, error) {
-	if len(itens) == 0 {
-		return nil, errors.New("a lista de presentes não pode estar vazia")
+// EventoPorSlug devolve o ID do evento da URL amigável, para as rotas públicas que
+// aceitam o evento pelo slug.
+func (s *GiftService) EventoPorSlug(ctx context.Context, urlSlug string) (uuid.UUID, error) {
+	evento, err := s.eventRepo.FindBySlug(ctx, urlSlug)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("falha ao buscar evento pelo slug: %w", err)
 	}
+	return evento.ID(), nil
+}
 
-	// Extrair IDs únicos dos presentes
-	presenteIDs := make([]uuid.UUID, 0, len(itens))
-	itensMap := make(map[uuid.UUID]int)
+func (s *GiftService) FinalizarSelecaoDePresentes(ctx context.Context, acesso domain.AcessoConvidado, itens []ItemSelecao) (*domain.Selecao, error) {
+	itensMap, _, err := s.validarItensSelecao(ctx, acesso.IDEvento, itens)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, item := range itens {
-		if item.Quantidade <= 0 {
-			return nil, fmt.Errorf("quantidade deve ser positiva para presente %s", item.IDPresente.String())
-		}
-		presenteIDs = append(presenteIDs, item.IDPresente)
-		itensMap[item.IDPresente] = item.Quantidade
+	// Finalizar seleção passando o mapa de quantidades
+	selecao, err := s.selecaoRepo.SalvarSelecao(ctx, acesso, itensMap)
+	if err != nil {
+		return nil, fmt.Errorf("falha no serviço ao finalizar seleção: %w", err)
 	}
 
-	// Buscar presentes
-	presentes, err := s.repo.FindByIDs(ctx, presenteIDs)
+	return selecao, nil
+}
+
+// FinalizarSelecaoComPagamento reserva os presentes como FinalizarSelecaoDePresentes e
+// abre o checkout do valor das cotas no gateway. As cotas ficam com a seleção enquanto o
+// pagamento está pendente e voltam a ficar disponíveis se ele falhar ou expirar.
+func (s *GiftService) FinalizarSelecaoComPagamento(ctx context.Context, acesso domain.AcessoConvidado, itens []ItemSelecao) (*domain.Selecao, *domain.Pagamento, error) {
+	if s.pagamentos == nil || !s.pagamentos.Habilitado() {
+		return nil, nil, domain.ErrPagamentoOnlineIndisponivel
+	}
+	itensMap, presentesConfirmados, err := s.validarItensSelecao(ctx, acesso.IDEvento, itens)
 	if err != nil {
-		return nil, fmt.Errorf("falha ao buscar presentes: %w", err)
+		return nil, nil, err
+	}
+	// Integrais não têm preço; sem cotas não há o que cobrar.
+	if domain.NewSelecao(uuid.Nil, uuid.Nil, presentesConfirmados).CalcularValorTotal() <= 0 {
+		return nil, nil, domain.ErrSemValorAPagar
 	}
 
-	if len(presentes) != len(presenteIDs) {
-		return nil, errors.New("um ou mais presentes não foram encontrados")
+	selecao, err := s.selecaoRepo.SalvarSelecao(ctx, acesso, itensMap)
+	if err != nil {
+		return nil, nil, fmt.Errorf("falha no serviço ao finalizar seleção: %w", err)
+	}
+
+	pagamento, err := s.pagamentos.AbrirCheckout(ctx, selecao)
+	if err != nil {
+		return nil, nil, fmt.Errorf("falha ao abrir pagamento da seleção: %w", err)
+	}
+	return selecao, pagamento, nil
+}
+
+// validarItensSelecao confere quantidades e disponibilidade antes de gravar a seleção e
+// devolve o mapa de quantidades com os presentes como ficariam confirmados.
+func (s *GiftService) validarItensSelecao(ctx context.Context, idEvento uuid.UUID, itens []ItemSelecao) (map[uuid.UUID]int, []domain.PresenteConfirmado, error) {
+	itensMap, presentes, err := s.buscarItensSelecao(ctx, idEvento, itens)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	// Validar disponibilidade e preparar seleção
@@ -130,7 +186,7 @@ func (s *GiftService) FinalizarSelecaoDePresentes(ctx context.Context, chaveDeAc
 		if presente.EhIntegral() {
 			// Presente integral: quantidade deve ser 1 e deve estar disponível
 			if quantidade != 1 {
-				return nil, fmt.Errorf("presente integral %s deve ter quantidade 1", presente.Nome())
+				return nil, nil, fmt.Errorf("presente integral %s deve ter quantidade 1", presente.Nome())
 			}
 			if presente.Status() != domain.StatusDisponivel {
 				conflitantes = append(conflitantes, presente.ID())
@@ -147,7 +203,7 @@ func (s *GiftService) FinalizarSelecaoDePresentes(ctx context.Context, chaveDeAc
 			// Presente fracionado: verificar cotas disponíveis
 			cotasDisponiveis := presente.ContarCotasDisponiveis()
 			if quantidade > cotasDisponiveis {
-				return nil, fmt.Errorf("presente %s tem apenas %d cotas disponíveis, solicitado %d", presente.Nome(), cotasDisponiveis, quantidade)
+				return nil, nil, fmt.Errorf("presente %s tem apenas %d cotas disponíveis, solicitado %d", presente.Nome(), cotasDisponiveis, quantidade)
 			}
 
 			valorCota := presente.ObterValorCota()
@@ -161,20 +217,56 @@ func (s *GiftService) FinalizarSelecaoDePresentes(ctx context.Context, chaveDeAc
 	}
 
 	if len(conflitantes) > 0 {
-		return nil, &domain.ErrPresentesConflitantes{PresentesIDs: conflitantes}
+		return nil, nil, &domain.ErrPresentesConflitantes{PresentesIDs: conflitantes}
 	}
 
-	// Finalizar seleção passando o mapa de quantidades
-	selecao, err := s.selecaoRepo.SalvarSelecao(ctx, chaveDeAcesso, itensMap)
+	return itensMap, presentesConfirmados, nil
+}
+
+// buscarItensSelecao confere a lista pedida e busca os presentes, que precisam ser do
+// evento, sem olhar a disponibilidade.
+func (s *GiftService) buscarItensSelecao(ctx context.Context, idEvento uuid.UUID, itens []ItemSelecao) (map[uuid.UUID]int, []*domain.Presente, error) {
+	if len(itens) == 0 {
+		return nil, nil, errors.New("a lista de presentes não pode estar vazia")
+	}
+
+	// Extrair IDs únicos dos presentes
+	presenteIDs := make([]uuid.UUID, 0, len(itens))
+	itensMap := make(map[uuid.UUID]int)
+
+	for _, item := range itens {
+		if item.Quantidade <= 0 {
+			return nil, nil, fmt.Errorf("quantidade deve ser positiva para presente %s", item.IDPresente.String())
+		}
+		presenteIDs = append(presenteIDs, item.IDPresente)
+		itensMap[item.IDPresente] = item.Quantidade
+	}
+
+	// Buscar presentes
+	presentes, err := s.repo.FindByIDs(ctx, presenteIDs)
 	if err != nil {
-		return nil, fmt.Errorf("falha no serviço ao finalizar seleção: %w", err)
+		return nil, nil, fmt.Errorf("falha ao buscar presentes: %w", err)
 	}
 
-	return selecao, nil
+	if len(presentes) != len(presenteIDs) {
+		return nil, nil, errors.New("um ou mais presentes não foram encontrados")
+	}
+
+	deOutroEvento := make([]uuid.UUID, 0)
+	for _, presente := range presentes {
+		if presente.IDCasamento() != idEvento {
+			deOutroEvento = append(deOutroEvento, presente.ID())
+		}
+	}
+	if len(deOutroEvento) > 0 {
+		return nil, nil, &domain.ErrPresentesDeOutroEvento{IDEvento: idEvento, PresentesIDs: deOutroEvento}
+	}
+
+	return itensMap, presentes, nil
 }
 
 // Método legacy mantido para compatibilidade
-func (s *GiftService) FinalizarSelecaoDepresentes(ctx context.Context, chaveDeAcesso string, idsDosPresentes []uuid.UUID) (*domain.Selecao, error) {
+func (s *GiftService) FinalizarSelecaoDepresentes(ctx context.Context, acesso domain.AcessoConvidado, idsDosPresentes []uuid.UUID) (*domain.Selecao, error) {
 	// Converter para novo formato (todos com quantidade 1)
 	itens := make([]ItemSelecao, len(idsDosPresentes))
 	for i, id := range idsDosPresentes {
@@ -184,7 +276,7 @@ func (s *GiftService) FinalizarSelecaoDepresentes(ctx context.Context, chaveDeAc
 		}
 	}
 
-	return s.FinalizarSelecaoDePresentes(ctx, chaveDeAcesso, itens)
+	return s.FinalizarSelecaoDePresentes(ctx, acesso, itens)
 }
 
 func (s *GiftService) DeletarPresente(ctx context.Context, userID, presenteID uuid.UUID) error {
@@ -0,0 +1,79 @@
+// file: internal/gift/domain/alteracao_selecao.go
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tipos de alteração de uma seleção confirmada.
+const (
+	AlteracaoSelecaoEditada   = "EDITADA"
+	AlteracaoSelecaoCancelada = "CANCELADA"
+)
+
+// Canais pelos quais uma seleção é alterada.
+const (
+	CanalSelecaoChaveDeAcesso = "CHAVE_DE_ACESSO"
+	CanalSelecaoAnfitriao     = "ANFITRIAO"
+)
+
+var (
+	ErrSelecaoNaoEncontrada = errors.New("seleção não encontrada")
+	ErrSelecaoComPagamento  = errors.New("seleções com pagamento online não podem ser alteradas")
+)
+
+// OrigemAlteracaoSelecao diz quem altera a seleção: o grupo, que só alcança as próprias
+// seleções pela chave de acesso, ou o anfitrião, que alcança as do evento.
+type OrigemAlteracaoSelecao struct {
+	Canal         string
+	IDEvento      uuid.UUID
+	ChaveDeAcesso string // só no canal CHAVE_DE_ACESSO
+	IDUsuario     *uuid.UUID
+}
+
+func OrigemConvidado(acesso AcessoConvidado) OrigemAlteracaoSelecao {
+	return OrigemAlteracaoSelecao{Canal: CanalSelecaoChaveDeAcesso, IDEvento: acesso.IDEvento, ChaveDeAcesso: acesso.ChaveDeAcesso}
+}
+
+func OrigemAnfitriao(idEvento, idUsuario uuid.UUID) OrigemAlteracaoSelecao {
+	return OrigemAlteracaoSelecao{Canal: CanalSelecaoAnfitriao, IDEvento: idEvento, IDUsuario: &idUsuario}
+}
+
+// AlteracaoSelecao é uma entrada do histórico de uma seleção. Guarda os itens de antes
+// e de depois, porque a seleção cancelada deixa de existir.
+type AlteracaoSelecao struct {
+	ID              uuid.UUID
+	IDSelecao       uuid.UUID
+	IDEvento        uuid.UUID
+	IDGrupo         uuid.UUID
+	Tipo            string
+	ItensAnteriores []PresenteConfirmado
+	ItensNovos      []PresenteConfirmado
+	Canal           string
+	IDUsuario       *uuid.UUID
+	RegistradoEm    time.Time
+}
+
+// NewAlteracaoSelecao registra a troca dos itens da seleção; sem itens novos, a
+// seleção foi cancelada.
+func NewAlteracaoSelecao(selecao *Selecao, itensNovos []PresenteConfirmado, origem OrigemAlteracaoSelecao) *AlteracaoSelecao {
+	tipo := AlteracaoSelecaoEditada
+	if len(itensNovos) == 0 {
+		tipo = AlteracaoSelecaoCancelada
+	}
+	return &AlteracaoSelecao{
+		ID:              uuid.New(),
+		IDSelecao:       selecao.ID(),
+		IDEvento:        selecao.IDCasamento(),
+		IDGrupo:         selecao.IDGrupoDeConvidados(),
+		Tipo:            tipo,
+		ItensAnteriores: selecao.PresentesConfirmados(),
+		ItensNovos:      itensNovos,
+		Canal:           origem.Canal,
+		IDUsuario:       origem.IDUsuario,
+		RegistradoEm:    time.Now(),
+	}
+}
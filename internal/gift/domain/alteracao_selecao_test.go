@@ -0,0 +1,41 @@
+// file: internal/gift/domain/alteracao_selecao_test.go
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAlteracaoSelecao(t *testing.T) {
+	valorCota := 50.0
+	anteriores := []PresenteConfirmado{{ID: uuid.New(), Nome: "Jantar", Quantidade: 2, ValorCota: &valorCota}}
+	selecao := HydrateSelecao(uuid.New(), uuid.New(), uuid.New(), anteriores, time.Now())
+
+	t.Run("deve registrar a edição com os itens de antes e de depois", func(t *testing.T) {
+		novos := []PresenteConfirmado{{ID: anteriores[0].ID, Nome: "Jantar", Quantidade: 1, ValorCota: &valorCota}}
+
+		alteracao := NewAlteracaoSelecao(selecao, novos, OrigemConvidado(AcessoConvidado{IDEvento: selecao.IDCasamento(), ChaveDeAcesso: "FAMILIA-SILVA"}))
+
+		assert.Equal(t, AlteracaoSelecaoEditada, alteracao.Tipo)
+		assert.Equal(t, selecao.ID(), alteracao.IDSelecao)
+		assert.Equal(t, selecao.IDGrupoDeConvidados(), alteracao.IDGrupo)
+		assert.Equal(t, anteriores, alteracao.ItensAnteriores)
+		assert.Equal(t, novos, alteracao.ItensNovos)
+		assert.Equal(t, CanalSelecaoChaveDeAcesso, alteracao.Canal)
+		assert.Nil(t, alteracao.IDUsuario)
+	})
+
+	t.Run("deve registrar o cancelamento quando não sobram itens", func(t *testing.T) {
+		idUsuario := uuid.New()
+
+		alteracao := NewAlteracaoSelecao(selecao, nil, OrigemAnfitriao(selecao.IDCasamento(), idUsuario))
+
+		assert.Equal(t, AlteracaoSelecaoCancelada, alteracao.Tipo)
+		assert.Empty(t, alteracao.ItensNovos)
+		assert.Equal(t, CanalSelecaoAnfitriao, alteracao.Canal)
+		assert.Equal(t, &idUsuario, alteracao.IDUsuario)
+	})
+}
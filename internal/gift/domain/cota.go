@@ -9,11 +9,14 @@ import (
 const (
 	StatusCotaDisponivel  = "DISPONIVEL"
 	StatusCotaSelecionada = "SELECIONADO"
+	StatusCotaReservada   = "RESERVADO"
 )
 
 var (
 	ErrCotaJaSelecionada  = errors.New("cota já foi selecionada")
 	ErrCotaJaDisponivel   = errors.New("cota já está disponível")
+	ErrCotaReservada      = errors.New("cota está reservada por outro convidado")
+	ErrCotaNaoReservada   = errors.New("cota não está reservada para esta reserva")
 	ErrValorCotaInvalido  = errors.New("valor da cota deve ser positivo")
 	ErrNumeroCotaInvalido = errors.New("número da cota deve ser positivo")
 )
@@ -60,12 +63,39 @@ func (c *Cota) Selecionar(idSelecao uuid.UUID) error {
 	if c.status == StatusCotaSelecionada {
 		return ErrCotaJaSelecionada
 	}
+	if c.status == StatusCotaReservada {
+		return ErrCotaReservada
+	}
 
 	c.status = StatusCotaSelecionada
 	c.idSelecao = &idSelecao
 	return nil
 }
 
+// Reservar segura a cota para a reserva até ela ser confirmada ou expirar.
+func (c *Cota) Reservar(idReserva uuid.UUID) error {
+	switch c.status {
+	case StatusCotaSelecionada:
+		return ErrCotaJaSelecionada
+	case StatusCotaReservada:
+		return ErrCotaReservada
+	}
+
+	c.status = StatusCotaReservada
+	c.idSelecao = &idReserva
+	return nil
+}
+
+// ConfirmarReserva transforma a reserva da cota em seleção; a seleção fica com o ID da reserva.
+func (c *Cota) ConfirmarReserva(idReserva uuid.UUID) error {
+	if c.status != StatusCotaReservada || c.idSelecao == nil || *c.idSelecao != idReserva {
+		return ErrCotaNaoReservada
+	}
+
+	c.status = StatusCotaSelecionada
+	return nil
+}
+
 func (c *Cota) LiberarSelecao() error {
 	if c.status == StatusCotaDisponivel {
 		return ErrCotaJaDisponivel
@@ -84,6 +114,10 @@ func (c *Cota) EstaSelecionada() bool {
 	return c.status == StatusCotaSelecionada
 }
 
+func (c *Cota) EstaReservada() bool {
+	return c.status == StatusCotaReservada
+}
+
 func (c *Cota) ID() uuid.UUID         { return c.id }
 func (c *Cota) IDPresente() uuid.UUID { return c.idPresente }
 func (c *Cota) NumeroCota() int       { return c.numeroCota }
@@ -77,6 +77,50 @@ func TestCota_Selecionar(t *testing.T) {
 	})
 }
 
+func TestCota_Reservar(t *testing.T) {
+	idPresente := uuid.New()
+	idReserva := uuid.New()
+
+	t.Run("deve reservar cota disponível", func(t *testing.T) {
+		cota, _ := NewCota(idPresente, 1, 100)
+
+		err := cota.Reservar(idReserva)
+
+		assert.NoError(t, err)
+		assert.True(t, cota.EstaReservada())
+		assert.False(t, cota.EstaDisponivel())
+		assert.Equal(t, idReserva, *cota.IDSelecao())
+	})
+
+	t.Run("não deve reservar nem selecionar cota reservada", func(t *testing.T) {
+		cota, _ := NewCota(idPresente, 1, 100)
+		_ = cota.Reservar(idReserva)
+
+		assert.Equal(t, ErrCotaReservada, cota.Reservar(uuid.New()))
+		assert.Equal(t, ErrCotaReservada, cota.Selecionar(uuid.New()))
+		assert.Equal(t, idReserva, *cota.IDSelecao())
+	})
+
+	t.Run("deve confirmar só a própria reserva", func(t *testing.T) {
+		cota, _ := NewCota(idPresente, 1, 100)
+		_ = cota.Reservar(idReserva)
+
+		assert.Equal(t, ErrCotaNaoReservada, cota.ConfirmarReserva(uuid.New()))
+		assert.NoError(t, cota.ConfirmarReserva(idReserva))
+		assert.True(t, cota.EstaSelecionada())
+		assert.Equal(t, idReserva, *cota.IDSelecao())
+	})
+
+	t.Run("deve liberar cota reservada", func(t *testing.T) {
+		cota, _ := NewCota(idPresente, 1, 100)
+		_ = cota.Reservar(idReserva)
+
+		assert.NoError(t, cota.LiberarSelecao())
+		assert.True(t, cota.EstaDisponivel())
+		assert.Nil(t, cota.IDSelecao())
+	})
+}
+
 func TestCota_LiberarSelecao(t *testing.T) {
 	idPresente := uuid.New()
 	cota, _ := NewCota(idPresente, 1, 100.50)
@@ -0,0 +1,151 @@
+// file: internal/gift/domain/pagamento.go
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type StatusPagamento string
+
+const (
+	StatusPagamentoPendente StatusPagamento = "PENDENTE"
+	StatusPagamentoPago     StatusPagamento = "PAGO"
+	StatusPagamentoFalhou   StatusPagamento = "FALHOU"
+	StatusPagamentoExpirado StatusPagamento = "EXPIRADO"
+)
+
+var (
+	ErrPagamentoNaoEncontrado      = errors.New("pagamento da seleção não encontrado")
+	ErrPagamentoJaFinalizado       = errors.New("o pagamento da seleção já foi finalizado")
+	ErrStatusPagamentoInvalido     = errors.New("status de pagamento inválido")
+	ErrSemValorAPagar              = errors.New("a seleção não tem valor a pagar: só presentes em cotas são pagos pela plataforma")
+	ErrPagamentoOnlineIndisponivel = errors.New("o pagamento online de presentes não está habilitado")
+	// ErrNotificacaoPagamentoInvalida marca webhooks com assinatura inválida ou corpo ilegível.
+	ErrNotificacaoPagamentoInvalida = errors.New("notificação de pagamento inválida")
+)
+
+// Pagamento é a cobrança online de uma seleção de presentes. Enquanto está pendente,
+// as cotas e os presentes da seleção ficam reservados; se o pagamento falha ou expira,
+// eles voltam a ficar disponíveis.
+type Pagamento struct {
+	idSelecao    uuid.UUID
+	status       StatusPagamento
+	valor        float64
+	idExterno    string
+	checkoutURL  string
+	expiraEm     time.Time
+	atualizadoEm time.Time
+}
+
+// NewPagamento abre o pagamento pendente de uma seleção. expiraEm é provisório até o
+// gateway devolver o checkout.
+func NewPagamento(idSelecao uuid.UUID, valor float64, expiraEm time.Time) (*Pagamento, error) {
+	if valor <= 0 {
+		return nil, ErrSemValorAPagar
+	}
+	return &Pagamento{
+		idSelecao:    idSelecao,
+		status:       StatusPagamentoPendente,
+		valor:        valor,
+		expiraEm:     expiraEm,
+		atualizadoEm: time.Now(),
+	}, nil
+}
+
+func HydratePagamento(idSelecao uuid.UUID, status StatusPagamento, valor float64, idExterno, checkoutURL string, expiraEm, atualizadoEm time.Time) *Pagamento {
+	return &Pagamento{
+		idSelecao:    idSelecao,
+		status:       status,
+		valor:        valor,
+		idExterno:    idExterno,
+		checkoutURL:  checkoutURL,
+		expiraEm:     expiraEm,
+		atualizadoEm: atualizadoEm,
+	}
+}
+
+// VincularCheckout guarda a cobrança aberta no gateway.
+func (p *Pagamento) VincularCheckout(checkout *CheckoutPagamento) {
+	p.idExterno = checkout.IDExterno
+	p.checkoutURL = checkout.URL
+	p.expiraEm = checkout.ExpiraEm
+	p.atualizadoEm = time.Now()
+}
+
+// Atualizar leva o pagamento pendente ao status final informado. Repetir o status
+// atual não muda nada, porque os gateways reenviam as notificações; sair de um status
+// final para outro é recusado. Devolve se houve mudança.
+func (p *Pagamento) Atualizar(status StatusPagamento) (bool, error) {
+	switch status {
+	case StatusPagamentoPago, StatusPagamentoFalhou, StatusPagamentoExpirado:
+	default:
+		return false, ErrStatusPagamentoInvalido
+	}
+	if p.status == status {
+		return false, nil
+	}
+	if p.status != StatusPagamentoPendente {
+		return false, ErrPagamentoJaFinalizado
+	}
+	p.status = status
+	p.atualizadoEm = time.Now()
+	return true, nil
+}
+
+// Vencido diz se o pagamento continua pendente depois do prazo do checkout.
+func (p *Pagamento) Vencido(agora time.Time) bool {
+	return p.status == StatusPagamentoPendente && agora.After(p.expiraEm)
+}
+
+// LiberaPresentes diz se as cotas e os presentes da seleção devem voltar a ficar disponíveis.
+func (p *Pagamento) LiberaPresentes() bool {
+	return p.status == StatusPagamentoFalhou || p.status == StatusPagamentoExpirado
+}
+
+// Getters
+func (p *Pagamento) IDSelecao() uuid.UUID    { return p.idSelecao }
+func (p *Pagamento) Status() StatusPagamento { return p.status }
+func (p *Pagamento) Valor() float64          { return p.valor }
+func (p *Pagamento) IDExterno() string       { return p.idExterno }
+func (p *Pagamento) CheckoutURL() string     { return p.checkoutURL }
+func (p *Pagamento) ExpiraEm() time.Time     { return p.expiraEm }
+func (p *Pagamento) AtualizadoEm() time.Time { return p.atualizadoEm }
+
+// CheckoutPagamento é a cobrança avulsa aberta no gateway para uma seleção.
+type CheckoutPagamento struct {
+	IDExterno string
+	URL       string
+	ExpiraEm  time.Time
+}
+
+// EventoPagamento é uma notificação do gateway já validada e traduzida para o domínio.
+type EventoPagamento struct {
+	IDSelecao uuid.UUID
+	IDExterno string
+	Status    StatusPagamento
+}
+
+// PaymentGateway é o serviço externo que cobra as seleções de presentes. Como o de
+// assinaturas em billing, usa só objetos do domínio.
+type PaymentGateway interface {
+	CriarCheckoutSelecao(ctx context.Context, selecao *Selecao, valor float64) (*CheckoutPagamento, error)
+	// InterpretarWebhook valida a assinatura da notificação e a traduz. Eventos que não
+	// mudam o pagamento devolvem nil sem erro.
+	InterpretarWebhook(payload []byte, cabecalhos map[string][]string) (*EventoPagamento, error)
+}
+
+type PagamentoRepository interface {
+	// Save grava o pagamento pendente na seleção.
+	Save(ctx context.Context, pagamento *Pagamento) error
+	FindByIDSelecao(ctx context.Context, idSelecao uuid.UUID) (*Pagamento, error)
+	// Update grava o novo status de um pagamento que ainda estava pendente no banco,
+	// devolvendo ErrPagamentoJaFinalizado se outro processo chegou antes. Quando o
+	// pagamento libera os presentes, as cotas e os presentes integrais da seleção voltam
+	// a DISPONIVEL na mesma transação.
+	Update(ctx context.Context, pagamento *Pagamento) error
+	ListarVencidos(ctx context.Context, ate time.Time, limite int) ([]*Pagamento, error)
+}
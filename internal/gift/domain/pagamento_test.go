@@ -0,0 +1,95 @@
+// file: internal/gift/domain/pagamento_test.go
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPagamento(t *testing.T) {
+	idSelecao := uuid.New()
+	expiraEm := time.Now().Add(time.Hour)
+
+	t.Run("deve abrir pendente com o valor da seleção", func(t *testing.T) {
+		pagamento, err := NewPagamento(idSelecao, 150, expiraEm)
+
+		assert.NoError(t, err)
+		assert.Equal(t, StatusPagamentoPendente, pagamento.Status())
+		assert.Equal(t, 150.0, pagamento.Valor())
+		assert.False(t, pagamento.LiberaPresentes())
+	})
+
+	t.Run("deve recusar seleção sem valor a pagar", func(t *testing.T) {
+		_, err := NewPagamento(idSelecao, 0, expiraEm)
+
+		assert.ErrorIs(t, err, ErrSemValorAPagar)
+	})
+
+	t.Run("deve guardar o checkout do gateway", func(t *testing.T) {
+		pagamento, _ := NewPagamento(idSelecao, 150, expiraEm)
+		checkout := &CheckoutPagamento{IDExterno: "cs_123", URL: "https://checkout/cs_123", ExpiraEm: expiraEm.Add(time.Minute)}
+
+		pagamento.VincularCheckout(checkout)
+
+		assert.Equal(t, "cs_123", pagamento.IDExterno())
+		assert.Equal(t, "https://checkout/cs_123", pagamento.CheckoutURL())
+		assert.Equal(t, checkout.ExpiraEm, pagamento.ExpiraEm())
+	})
+
+	t.Run("deve ir de pendente para um status final", func(t *testing.T) {
+		for _, status := range []StatusPagamento{StatusPagamentoPago, StatusPagamentoFalhou, StatusPagamentoExpirado} {
+			pagamento, _ := NewPagamento(idSelecao, 150, expiraEm)
+
+			mudou, err := pagamento.Atualizar(status)
+
+			assert.NoError(t, err)
+			assert.True(t, mudou)
+			assert.Equal(t, status, pagamento.Status())
+			assert.Equal(t, status != StatusPagamentoPago, pagamento.LiberaPresentes())
+		}
+	})
+
+	t.Run("deve ignorar a repetição do mesmo status", func(t *testing.T) {
+		pagamento, _ := NewPagamento(idSelecao, 150, expiraEm)
+		_, _ = pagamento.Atualizar(StatusPagamentoPago)
+
+		mudou, err := pagamento.Atualizar(StatusPagamentoPago)
+
+		assert.NoError(t, err)
+		assert.False(t, mudou)
+	})
+
+	t.Run("não deve sair de um status final", func(t *testing.T) {
+		pagamento, _ := NewPagamento(idSelecao, 150, expiraEm)
+		_, _ = pagamento.Atualizar(StatusPagamentoExpirado)
+
+		mudou, err := pagamento.Atualizar(StatusPagamentoPago)
+
+		assert.ErrorIs(t, err, ErrPagamentoJaFinalizado)
+		assert.False(t, mudou)
+		assert.Equal(t, StatusPagamentoExpirado, pagamento.Status())
+	})
+
+	t.Run("deve recusar voltar para pendente ou status desconhecido", func(t *testing.T) {
+		pagamento, _ := NewPagamento(idSelecao, 150, expiraEm)
+
+		_, errPendente := pagamento.Atualizar(StatusPagamentoPendente)
+		_, errDesconhecido := pagamento.Atualizar("ESTORNADO")
+
+		assert.ErrorIs(t, errPendente, ErrStatusPagamentoInvalido)
+		assert.ErrorIs(t, errDesconhecido, ErrStatusPagamentoInvalido)
+	})
+
+	t.Run("deve vencer só pendente e depois do prazo", func(t *testing.T) {
+		pagamento, _ := NewPagamento(idSelecao, 150, expiraEm)
+
+		assert.False(t, pagamento.Vencido(expiraEm.Add(-time.Second)))
+		assert.True(t, pagamento.Vencido(expiraEm.Add(time.Second)))
+
+		_, _ = pagamento.Atualizar(StatusPagamentoPago)
+		assert.False(t, pagamento.Vencido(expiraEm.Add(time.Second)))
+	})
+}
@@ -0,0 +1,252 @@
+// file: internal/gift/domain/pix.go
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/mail"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	TipoDetalhePix = "PIX"
+
+	TipoChavePixCPF       = "CPF"
+	TipoChavePixCNPJ      = "CNPJ"
+	TipoChavePixEmail     = "EMAIL"
+	TipoChavePixTelefone  = "TELEFONE"
+	TipoChavePixAleatoria = "ALEATORIA" // EVP, a chave aleatória gerada pelo banco
+)
+
+// Limites do BR Code para os dados do recebedor e da chave.
+const (
+	tamanhoMaximoNomeRecebedor   = 25
+	tamanhoMaximoCidadeRecebedor = 15
+	tamanhoMaximoChaveEmail      = 77
+)
+
+var (
+	ErrTipoChavePixInvalido  = errors.New("tipo de chave PIX inválido: use CPF, CNPJ, EMAIL, TELEFONE ou ALEATORIA")
+	ErrChavePixInvalida      = errors.New("a chave PIX não é válida para o tipo informado")
+	ErrRecebedorPixInvalido  = errors.New("informe o nome (até 25 caracteres) e a cidade (até 15 caracteres) de quem recebe o PIX")
+	ErrPresenteSemPix        = errors.New("o presente não é pago por PIX")
+	ErrQuantidadePixInvalida = errors.New("quantidade de cotas inválida para o PIX")
+)
+
+// normalizar valida os detalhes do presente e devolve a chave PIX no formato
+// registrado no DICT: só dígitos para CPF e CNPJ, +55 com DDD para telefone e
+// minúsculas para e-mail e chave aleatória.
+func (d DetalhesPresente) normalizar() (DetalhesPresente, error) {
+	switch d.Tipo {
+	case TipoDetalheProdutoExterno:
+		if d.LinkDaLoja == "" {
+			return d, ErrDetalhesInvalidos
+		}
+		return d, nil
+	case TipoDetalhePix:
+		chave, err := normalizarChavePix(d.TipoChavePix, d.ChavePix)
+		if err != nil {
+			return d, err
+		}
+		d.ChavePix = chave
+		d.NomeRecebedor = strings.TrimSpace(d.NomeRecebedor)
+		d.CidadeRecebedor = strings.TrimSpace(d.CidadeRecebedor)
+		// Os limites valem para o texto que vai no BR Code, já sem o que não é ASCII.
+		if !textoComTamanho(paraASCII(d.NomeRecebedor), tamanhoMaximoNomeRecebedor) || !textoComTamanho(paraASCII(d.CidadeRecebedor), tamanhoMaximoCidadeRecebedor) {
+			return d, ErrRecebedorPixInvalido
+		}
+		return d, nil
+	default:
+		return d, ErrDetalhesInvalidos
+	}
+}
+
+func textoComTamanho(texto string, maximo int) bool {
+	return len(texto) > 0 && len(texto) <= maximo
+}
+
+func normalizarChavePix(tipo, chave string) (string, error) {
+	chave = strings.TrimSpace(chave)
+	switch tipo {
+	case TipoChavePixCPF:
+		digitos := somenteDigitos(chave)
+		if !cpfValido(digitos) {
+			return "", ErrChavePixInvalida
+		}
+		return digitos, nil
+	case TipoChavePixCNPJ:
+		digitos := somenteDigitos(chave)
+		if !cnpjValido(digitos) {
+			return "", ErrChavePixInvalida
+		}
+		return digitos, nil
+	case TipoChavePixEmail:
+		chave = strings.ToLower(chave)
+		endereco, err := mail.ParseAddress(chave)
+		if err != nil || endereco.Address != chave || len(chave) > tamanhoMaximoChaveEmail {
+			return "", ErrChavePixInvalida
+		}
+		return chave, nil
+	case TipoChavePixTelefone:
+		digitos := somenteDigitos(chave)
+		// Sem o +55, aceita o número nacional com DDD.
+		if !strings.HasPrefix(chave, "+") && (len(digitos) == 10 || len(digitos) == 11) {
+			digitos = "55" + digitos
+		}
+		if !strings.HasPrefix(digitos, "55") || len(digitos) < 12 || len(digitos) > 13 || digitos[2] == '0' {
+			return "", ErrChavePixInvalida
+		}
+		return "+" + digitos, nil
+	case TipoChavePixAleatoria:
+		id, err := uuid.Parse(chave)
+		if err != nil || len(chave) != 36 {
+			return "", ErrChavePixInvalida
+		}
+		return id.String(), nil
+	default:
+		return "", ErrTipoChavePixInvalido
+	}
+}
+
+func somenteDigitos(texto string) string {
+	var b strings.Builder
+	for _, r := range texto {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// cpfValido confere os dois dígitos verificadores e recusa sequências repetidas.
+func cpfValido(cpf string) bool {
+	if len(cpf) != 11 || strings.Count(cpf, cpf[:1]) == 11 {
+		return false
+	}
+	return digitoVerificador(cpf[:9], []int{10, 9, 8, 7, 6, 5, 4, 3, 2}) == cpf[9] &&
+		digitoVerificador(cpf[:10], []int{11, 10, 9, 8, 7, 6, 5, 4, 3, 2}) == cpf[10]
+}
+
+// cnpjValido confere os dois dígitos verificadores e recusa sequências repetidas.
+func cnpjValido(cnpj string) bool {
+	if len(cnpj) != 14 || strings.Count(cnpj, cnpj[:1]) == 14 {
+		return false
+	}
+	return digitoVerificador(cnpj[:12], []int{5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}) == cnpj[12] &&
+		digitoVerificador(cnpj[:13], []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}) == cnpj[13]
+}
+
+// digitoVerificador é o módulo 11 usado em CPF e CNPJ.
+func digitoVerificador(digitos string, pesos []int) byte {
+	soma := 0
+	for i, peso := range pesos {
+		soma += int(digitos[i]-'0') * peso
+	}
+	resto := soma % 11
+	if resto < 2 {
+		return '0'
+	}
+	return byte('0' + 11 - resto)
+}
+
+// CobrancaPix é o PIX de um presente: o valor e o payload copia-e-cola, que também
+// vai no QR code.
+type CobrancaPix struct {
+	Valor  float64 // Zero quando o pagador escolhe o valor
+	BRCode string
+}
+
+// CobrancaPix monta o BR Code estático do presente. Nos fracionados o valor é o da
+// cota vezes a quantidade, de 1 até o total de cotas; nos integrais, que não têm
+// preço, o valor fica em aberto e a quantidade deve ser 1.
+func (p *Presente) CobrancaPix(quantidade int) (*CobrancaPix, error) {
+	if p.detalhes.Tipo != TipoDetalhePix {
+		return nil, ErrPresenteSemPix
+	}
+	valor := 0.0
+	if p.EhFracionado() {
+		if quantidade < 1 || quantidade > len(p.cotas) {
+			return nil, ErrQuantidadePixInvalida
+		}
+		valor = math.Round(p.ObterValorCota()*float64(quantidade)*100) / 100
+	} else if quantidade != 1 {
+		return nil, ErrQuantidadePixInvalida
+	}
+	return &CobrancaPix{Valor: valor, BRCode: brCodePix(p.detalhes, valor, p.nome)}, nil
+}
+
+// brCodePix segue o Manual do BR Code do Banco Central: campos EMV no formato
+// ID + tamanho (2 dígitos) + valor, terminando no CRC16 do payload inteiro.
+func brCodePix(d DetalhesPresente, valor float64, descricao string) string {
+	contaPix := campoEMV("00", "br.gov.bcb.pix") + campoEMV("01", d.ChavePix)
+	// A descrição aparece para o pagador em alguns bancos; entra se couber nos 99 caracteres do campo 26.
+	if espaco := 99 - len(contaPix) - 4; espaco > 0 {
+		if texto := limitar(paraASCII(descricao), min(espaco, 72)); texto != "" {
+			contaPix += campoEMV("02", texto)
+		}
+	}
+
+	var payload strings.Builder
+	payload.WriteString(campoEMV("00", "01"))
+	payload.WriteString(campoEMV("26", contaPix))
+	payload.WriteString(campoEMV("52", "0000"))
+	payload.WriteString(campoEMV("53", "986"))
+	if valor > 0 {
+		payload.WriteString(campoEMV("54", fmt.Sprintf("%.2f", valor)))
+	}
+	payload.WriteString(campoEMV("58", "BR"))
+	payload.WriteString(campoEMV("59", limitar(paraASCII(d.NomeRecebedor), tamanhoMaximoNomeRecebedor)))
+	payload.WriteString(campoEMV("60", limitar(paraASCII(d.CidadeRecebedor), tamanhoMaximoCidadeRecebedor)))
+	payload.WriteString(campoEMV("62", campoEMV("05", "***")))
+	payload.WriteString("6304")
+	return payload.String() + fmt.Sprintf("%04X", crc16CCITT(payload.String()))
+}
+
+func campoEMV(id, valor string) string {
+	return fmt.Sprintf("%s%02d%s", id, len(valor), valor)
+}
+
+// crc16CCITT é o CRC-16/CCITT-FALSE (polinômio 0x1021, valor inicial 0xFFFF) exigido pelo BR Code.
+func crc16CCITT(dados string) uint16 {
+	crc := uint16(0xFFFF)
+	for i := 0; i < len(dados); i++ {
+		crc ^= uint16(dados[i]) << 8
+		for bit := 0; bit < 8; bit++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+var semAcentos = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a", "é", "e", "ê", "e", "è", "e", "í", "i", "ï", "i",
+	"ó", "o", "ô", "o", "õ", "o", "ö", "o", "ú", "u", "ü", "u", "ç", "c", "ñ", "n",
+	"Á", "A", "À", "A", "Â", "A", "Ã", "A", "Ä", "A", "É", "E", "Ê", "E", "È", "E", "Í", "I", "Ï", "I",
+	"Ó", "O", "Ô", "O", "Õ", "O", "Ö", "O", "Ú", "U", "Ü", "U", "Ç", "C", "Ñ", "N",
+)
+
+// paraASCII tira os acentos e descarta o que sobrar fora do ASCII imprimível, que
+// muitos leitores de BR Code não aceitam.
+func paraASCII(texto string) string {
+	var b strings.Builder
+	for _, r := range semAcentos.Replace(texto) {
+		if r >= ' ' && r <= '~' {
+			b.WriteRune(r)
+		}
+	}
+	return strings.TrimSpace(b.String())
+}
+
+func limitar(texto string, maximo int) string {
+	if len(texto) > maximo {
+		return strings.TrimSpace(texto[:maximo])
+	}
+	return texto
+}
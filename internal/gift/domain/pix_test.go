@@ -0,0 +1,163 @@
+// file: internal/gift/domain/pix_test.go
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func detalhesPix() DetalhesPresente {
+	return DetalhesPresente{
+		Tipo:            TipoDetalhePix,
+		TipoChavePix:    TipoChavePixEmail,
+		ChavePix:        " Noivos@Exemplo.com ",
+		NomeRecebedor:   "Ana e João",
+		CidadeRecebedor: "São Paulo",
+	}
+}
+
+func TestDetalhesPresente_Pix(t *testing.T) {
+	idCasamento := uuid.New()
+
+	t.Run("deve normalizar as chaves de cada tipo", func(t *testing.T) {
+		casos := []struct{ tipo, chave, esperada string }{
+			{TipoChavePixCPF, "529.982.247-25", "52998224725"},
+			{TipoChavePixCNPJ, "11.222.333/0001-81", "11222333000181"},
+			{TipoChavePixEmail, " Noivos@Exemplo.com ", "noivos@exemplo.com"},
+			{TipoChavePixTelefone, "(11) 98765-4321", "+5511987654321"},
+			{TipoChavePixTelefone, "+55 21 3456-7890", "+552134567890"},
+			{TipoChavePixAleatoria, "123E4567-E12B-12D1-A456-426655440000", "123e4567-e12b-12d1-a456-426655440000"},
+		}
+		for _, c := range casos {
+			detalhes := detalhesPix()
+			detalhes.TipoChavePix, detalhes.ChavePix = c.tipo, c.chave
+
+			presente, err := NewPresenteIntegral(idCasamento, "Lua de mel", "", "", false, "VIAGEM", detalhes)
+
+			assert.NoError(t, err, c.chave)
+			assert.Equal(t, c.esperada, presente.Detalhes().ChavePix)
+		}
+	})
+
+	t.Run("deve recusar chaves inválidas para o tipo", func(t *testing.T) {
+		casos := []struct{ tipo, chave string }{
+			{TipoChavePixCPF, "529.982.247-26"},
+			{TipoChavePixCPF, "111.111.111-11"},
+			{TipoChavePixCNPJ, "11.222.333/0001-80"},
+			{TipoChavePixEmail, "noivos.exemplo.com"},
+			{TipoChavePixTelefone, "98765-4321"},
+			{TipoChavePixTelefone, "+1 415 555 0100"},
+			{TipoChavePixAleatoria, "não-é-uuid"},
+		}
+		for _, c := range casos {
+			detalhes := detalhesPix()
+			detalhes.TipoChavePix, detalhes.ChavePix = c.tipo, c.chave
+
+			_, err := NewPresenteIntegral(idCasamento, "Lua de mel", "", "", false, "VIAGEM", detalhes)
+
+			assert.Equal(t, ErrChavePixInvalida, err, c.chave)
+		}
+	})
+
+	t.Run("deve exigir tipo de chave e dados do recebedor", func(t *testing.T) {
+		semTipo := detalhesPix()
+		semTipo.TipoChavePix = "BOLETO"
+		semCidade := detalhesPix()
+		semCidade.CidadeRecebedor = " "
+		nomeLongo := detalhesPix()
+		nomeLongo.NomeRecebedor = strings.Repeat("a", 26)
+
+		_, errTipo := NewPresenteIntegral(idCasamento, "Lua de mel", "", "", false, "VIAGEM", semTipo)
+		_, errCidade := NewPresenteIntegral(idCasamento, "Lua de mel", "", "", false, "VIAGEM", semCidade)
+		_, errNome := NewPresenteIntegral(idCasamento, "Lua de mel", "", "", false, "VIAGEM", nomeLongo)
+
+		assert.Equal(t, ErrTipoChavePixInvalido, errTipo)
+		assert.Equal(t, ErrRecebedorPixInvalido, errCidade)
+		assert.Equal(t, ErrRecebedorPixInvalido, errNome)
+	})
+
+	t.Run("deve recusar nome e cidade que ficam vazios no BR Code", func(t *testing.T) {
+		nomeSemASCII := detalhesPix()
+		nomeSemASCII.NomeRecebedor = "张伟 💍"
+		cidadeSemASCII := detalhesPix()
+		cidadeSemASCII.CidadeRecebedor = "Москва"
+
+		_, errNome := NewPresenteIntegral(idCasamento, "Lua de mel", "", "", false, "VIAGEM", nomeSemASCII)
+		_, errCidade := NewPresenteIntegral(idCasamento, "Lua de mel", "", "", false, "VIAGEM", cidadeSemASCII)
+
+		assert.Equal(t, ErrRecebedorPixInvalido, errNome)
+		assert.Equal(t, ErrRecebedorPixInvalido, errCidade)
+	})
+}
+
+func TestBRCodePix(t *testing.T) {
+	t.Run("deve calcular o CRC16 CCITT-FALSE", func(t *testing.T) {
+		assert.Equal(t, uint16(0x29B1), crc16CCITT("123456789"))
+	})
+
+	t.Run("deve reproduzir o exemplo do manual do BR Code", func(t *testing.T) {
+		detalhes := DetalhesPresente{
+			Tipo:            TipoDetalhePix,
+			ChavePix:        "123e4567-e12b-12d1-a456-426655440000",
+			NomeRecebedor:   "Fulano de Tal",
+			CidadeRecebedor: "BRASILIA",
+		}
+
+		brCode := brCodePix(detalhes, 0, "")
+
+		assert.Equal(t, "00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***63041D3D", brCode)
+	})
+}
+
+func TestPresente_CobrancaPix(t *testing.T) {
+	idCasamento := uuid.New()
+
+	t.Run("deve cobrar o valor da cota vezes a quantidade nos fracionados", func(t *testing.T) {
+		presente, _ := NewPresenteFracionado(idCasamento, "Jantar em Paris", "", "", false, "VIAGEM", detalhesPix(), 1000, 8)
+
+		cobranca, err := presente.CobrancaPix(3)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 375.0, cobranca.Valor)
+		assert.Contains(t, cobranca.BRCode, "0014br.gov.bcb.pix0118noivos@exemplo.com")
+		assert.Contains(t, cobranca.BRCode, "5406375.00")
+		assert.Contains(t, cobranca.BRCode, "5910Ana e Joao6009Sao Paulo")
+		assert.Equal(t, fmt.Sprintf("%04X", crc16CCITT(cobranca.BRCode[:len(cobranca.BRCode)-4])), cobranca.BRCode[len(cobranca.BRCode)-4:])
+	})
+
+	t.Run("deve deixar o valor em aberto nos integrais", func(t *testing.T) {
+		presente, _ := NewPresenteIntegral(idCasamento, "Lua de mel", "", "", false, "VIAGEM", detalhesPix())
+
+		cobranca, err := presente.CobrancaPix(1)
+
+		assert.NoError(t, err)
+		assert.Zero(t, cobranca.Valor)
+		assert.NotContains(t, cobranca.BRCode, "5802BR54")
+		assert.Contains(t, cobranca.BRCode, "0210Lua de mel")
+	})
+
+	t.Run("deve recusar quantidade fora do total de cotas", func(t *testing.T) {
+		fracionado, _ := NewPresenteFracionado(idCasamento, "Jantar em Paris", "", "", false, "VIAGEM", detalhesPix(), 1000, 8)
+		integral, _ := NewPresenteIntegral(idCasamento, "Lua de mel", "", "", false, "VIAGEM", detalhesPix())
+
+		_, semCotas := fracionado.CobrancaPix(0)
+		_, acimaDoTotal := fracionado.CobrancaPix(9)
+		_, integralComCotas := integral.CobrancaPix(2)
+
+		assert.Equal(t, ErrQuantidadePixInvalida, semCotas)
+		assert.Equal(t, ErrQuantidadePixInvalida, acimaDoTotal)
+		assert.Equal(t, ErrQuantidadePixInvalida, integralComCotas)
+	})
+
+	t.Run("deve recusar presente que não é pago por PIX", func(t *testing.T) {
+		presente, _ := NewPresenteIntegral(idCasamento, "Cafeteira", "", "", false, "COZINHA", DetalhesPresente{Tipo: TipoDetalheProdutoExterno, LinkDaLoja: "https://loja.com"})
+
+		_, err := presente.CobrancaPix(1)
+
+		assert.Equal(t, ErrPresenteSemPix, err)
+	})
+}
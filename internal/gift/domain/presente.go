@@ -15,6 +15,9 @@ const (
 	StatusDisponivel              = "DISPONIVEL"
 	StatusSelecionado             = "SELECIONADO"
 	StatusParcialmenteSelecionado = "PARCIALMENTE_SELECIONADO"
+	// StatusReservado marca o integral reservado e o fracionado cujas cotas restantes
+	// estão todas reservadas: pode voltar a ficar disponível quando a reserva expirar.
+	StatusReservado = "RESERVADO"
 )
 
 var (
@@ -27,11 +30,19 @@ var (
 	ErrCotasIndisponiveis      = errors.New("não há cotas suficientes disponíveis")
 	ErrPresenteJaSelecionado   = errors.New("presente já foi completamente selecionado")
 	ErrPresenteNaoEncontrado   = errors.New("presente não encontrado")
+	ErrPresenteReservado       = errors.New("presente está reservado por outro convidado")
+	ErrPresenteNaoReservado    = errors.New("presente não está reservado para esta reserva")
 )
 
+// DetalhesPresente diz como o convidado dá o presente: comprando na loja do link
+// (PRODUTO_EXTERNO) ou pagando por PIX, com a chave e os dados do recebedor.
 type DetalhesPresente struct {
-	Tipo       string
-	LinkDaLoja string
+	Tipo            string
+	LinkDaLoja      string
+	TipoChavePix    string
+	ChavePix        string
+	NomeRecebedor   string
+	CidadeRecebedor string
 }
 
 type Presente struct {
@@ -57,14 +68,25 @@ func (e *ErrPresentesConflitantes) Error() string {
 	return fmt.Sprintf("um ou mais presentes já foram selecionados: %v", e.PresentesIDs)
 }
 
+// ErrPresentesDeOutroEvento lista os presentes pedidos que não são do evento da seleção.
+type ErrPresentesDeOutroEvento struct {
+	IDEvento     uuid.UUID
+	PresentesIDs []uuid.UUID
+}
+
+func (e *ErrPresentesDeOutroEvento) Error() string {
+	return fmt.Sprintf("um ou mais presentes não pertencem ao evento %s: %v", e.IDEvento, e.PresentesIDs)
+}
+
 func NewPresenteIntegral(idCasamento uuid.UUID, nome, descricao, fotoURL string,
 	ehFavorito bool, categoria string, detalhes DetalhesPresente) (*Presente, error) {
 
 	if nome == "" {
 		return nil, ErrNomePresenteObrigatorio
 	}
-	if detalhes.Tipo == TipoDetalheProdutoExterno && detalhes.LinkDaLoja == "" {
-		return nil, ErrDetalhesInvalidos
+	detalhes, err := detalhes.normalizar()
+	if err != nil {
+		return nil, err
 	}
 
 	return &Presente{
@@ -90,8 +112,9 @@ func NewPresenteFracionado(idCasamento uuid.UUID, nome, descricao, fotoURL strin
 	if nome == "" {
 		return nil, ErrNomePresenteObrigatorio
 	}
-	if detalhes.Tipo == TipoDetalheProdutoExterno && detalhes.LinkDaLoja == "" {
-		return nil, ErrDetalhesInvalidos
+	detalhes, err := detalhes.normalizar()
+	if err != nil {
+		return nil, err
 	}
 	if valorTotal <= 0 {
 		return nil, ErrValorTotalInvalido
@@ -186,11 +209,85 @@ func (p *Presente) SelecionarIntegral(idSelecao uuid.UUID) error {
 	if p.status == StatusSelecionado {
 		return ErrPresenteJaSelecionado
 	}
+	if p.status == StatusReservado {
+		return ErrPresenteReservado
+	}
 
 	p.status = StatusSelecionado
 	return nil
 }
 
+// ReservarIntegral segura o presente integral enquanto o convidado conclui a seleção.
+func (p *Presente) ReservarIntegral() error {
+	if p.tipo != TipoPresenteIntegral {
+		return errors.New("operação válida apenas para presentes integrais")
+	}
+
+	switch p.status {
+	case StatusSelecionado:
+		return ErrPresenteJaSelecionado
+	case StatusReservado:
+		return ErrPresenteReservado
+	}
+
+	p.status = StatusReservado
+	return nil
+}
+
+// ReservarCotas segura a quantidade de cotas disponíveis para a reserva.
+func (p *Presente) ReservarCotas(quantidade int, idReserva uuid.UUID) error {
+	if p.tipo != TipoPresenteFracionado {
+		return ErrPresenteNaoFracionado
+	}
+
+	if quantidade > p.ContarCotasDisponiveis() {
+		return ErrCotasIndisponiveis
+	}
+
+	reservadas := 0
+	for _, cota := range p.cotas {
+		if reservadas >= quantidade {
+			break
+		}
+		if cota.EstaDisponivel() {
+			if err := cota.Reservar(idReserva); err != nil {
+				return fmt.Errorf("erro ao reservar cota %d: %w", cota.numeroCota, err)
+			}
+			reservadas++
+		}
+	}
+
+	p.atualizarStatus()
+	return nil
+}
+
+// ConfirmarReserva transforma em seleção o que a reserva segurava neste presente.
+func (p *Presente) ConfirmarReserva(idReserva uuid.UUID) error {
+	if p.tipo == TipoPresenteIntegral {
+		if p.status != StatusReservado {
+			return ErrPresenteNaoReservado
+		}
+		p.status = StatusSelecionado
+		return nil
+	}
+
+	confirmadas := 0
+	for _, cota := range p.cotas {
+		if cota.EstaReservada() && *cota.idSelecao == idReserva {
+			if err := cota.ConfirmarReserva(idReserva); err != nil {
+				return fmt.Errorf("erro ao confirmar cota %d: %w", cota.numeroCota, err)
+			}
+			confirmadas++
+		}
+	}
+	if confirmadas == 0 {
+		return ErrPresenteNaoReservado
+	}
+
+	p.atualizarStatus()
+	return nil
+}
+
 func (p *Presente) LiberarSelecao(idSelecao uuid.UUID) error {
 	if p.tipo == TipoPresenteIntegral {
 		p.status = StatusDisponivel
@@ -217,7 +314,9 @@ func (p *Presente) atualizarStatus() {
 	cotasDisponiveis := p.ContarCotasDisponiveis()
 	totalCotas := len(p.cotas)
 
-	if cotasDisponiveis == 0 {
+	if cotasDisponiveis == 0 && p.ContarCotasReservadas() > 0 {
+		p.status = StatusReservado
+	} else if cotasDisponiveis == 0 {
 		p.status = StatusSelecionado
 	} else if cotasDisponiveis == totalCotas {
 		p.status = StatusDisponivel
@@ -257,6 +356,16 @@ func (p *Presente) ContarCotasSelecionadas() int {
 	return contador
 }
 
+func (p *Presente) ContarCotasReservadas() int {
+	contador := 0
+	for _, cota := range p.cotas {
+		if cota.EstaReservada() {
+			contador++
+		}
+	}
+	return contador
+}
+
 func (p *Presente) ObterValorCota() float64 {
 	if p.tipo == TipoPresenteIntegral || len(p.cotas) == 0 {
 		return 0
@@ -277,8 +386,9 @@ func (p *Presente) AtualizarDados(nome, descricao, categoria string, ehFavorito
 	if nome == "" {
 		return ErrNomePresenteObrigatorio
 	}
-	if detalhes.Tipo == TipoDetalheProdutoExterno && detalhes.LinkDaLoja == "" {
-		return ErrDetalhesInvalidos
+	detalhes, err := detalhes.normalizar()
+	if err != nil {
+		return err
 	}
 
 	p.nome = nome
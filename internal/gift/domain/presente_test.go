@@ -221,6 +221,77 @@ func TestPresente_LiberarSelecao(t *testing.T) {
 	})
 }
 
+func TestPresente_Reservas(t *testing.T) {
+	idCasamento := uuid.New()
+	detalhes := DetalhesPresente{Tipo: TipoDetalheProdutoExterno, LinkDaLoja: "https://test.com"}
+
+	t.Run("deve reservar e confirmar presente integral", func(t *testing.T) {
+		presente, _ := NewPresenteIntegral(idCasamento, "Presente", "Desc", "", false, "CAT", detalhes)
+		idReserva := uuid.New()
+
+		assert.NoError(t, presente.ReservarIntegral())
+		assert.Equal(t, StatusReservado, presente.Status())
+		assert.Equal(t, ErrPresenteReservado, presente.ReservarIntegral())
+		assert.Equal(t, ErrPresenteReservado, presente.SelecionarIntegral(uuid.New()))
+
+		assert.NoError(t, presente.ConfirmarReserva(idReserva))
+		assert.Equal(t, StatusSelecionado, presente.Status())
+	})
+
+	t.Run("deve liberar presente integral com reserva vencida", func(t *testing.T) {
+		presente, _ := NewPresenteIntegral(idCasamento, "Presente", "Desc", "", false, "CAT", detalhes)
+		idReserva := uuid.New()
+		_ = presente.ReservarIntegral()
+
+		assert.NoError(t, presente.LiberarSelecao(idReserva))
+		assert.Equal(t, StatusDisponivel, presente.Status())
+		assert.Equal(t, ErrPresenteNaoReservado, presente.ConfirmarReserva(idReserva))
+	})
+
+	t.Run("deve reservar cotas sem contá-las como selecionadas", func(t *testing.T) {
+		presente, _ := NewPresenteFracionado(idCasamento, "Geladeira", "Desc", "", false, "CAT", detalhes, 1000, 5)
+
+		err := presente.ReservarCotas(2, uuid.New())
+
+		assert.NoError(t, err)
+		assert.Equal(t, StatusParcialmenteSelecionado, presente.Status())
+		assert.Equal(t, 3, presente.ContarCotasDisponiveis())
+		assert.Equal(t, 2, presente.ContarCotasReservadas())
+		assert.Equal(t, 0, presente.ContarCotasSelecionadas())
+		assert.Equal(t, ErrCotasIndisponiveis, presente.ReservarCotas(4, uuid.New()))
+	})
+
+	t.Run("deve ficar reservado quando as cotas restantes estão reservadas", func(t *testing.T) {
+		presente, _ := NewPresenteFracionado(idCasamento, "Geladeira", "Desc", "", false, "CAT", detalhes, 1000, 5)
+		idReserva := uuid.New()
+		_ = presente.SelecionarCotas(3, uuid.New())
+
+		assert.NoError(t, presente.ReservarCotas(2, idReserva))
+		assert.Equal(t, StatusReservado, presente.Status())
+
+		assert.NoError(t, presente.ConfirmarReserva(idReserva))
+		assert.Equal(t, StatusSelecionado, presente.Status())
+		assert.Equal(t, 5, presente.ContarCotasSelecionadas())
+	})
+
+	t.Run("deve liberar só as cotas da reserva vencida", func(t *testing.T) {
+		presente, _ := NewPresenteFracionado(idCasamento, "Geladeira", "Desc", "", false, "CAT", detalhes, 1000, 5)
+		idReserva, outraReserva := uuid.New(), uuid.New()
+		_ = presente.SelecionarCotas(3, uuid.New())
+		_ = presente.ReservarCotas(1, idReserva)
+		_ = presente.ReservarCotas(1, outraReserva)
+		assert.Equal(t, StatusReservado, presente.Status())
+
+		err := presente.LiberarSelecao(idReserva)
+
+		assert.NoError(t, err)
+		assert.Equal(t, StatusParcialmenteSelecionado, presente.Status())
+		assert.Equal(t, 1, presente.ContarCotasDisponiveis())
+		assert.Equal(t, 1, presente.ContarCotasReservadas())
+		assert.Equal(t, ErrPresenteNaoReservado, presente.ConfirmarReserva(idReserva))
+	})
+}
+
 func TestPresente_ContadorCotas(t *testing.T) {
 	idCasamento := uuid.New()
 	detalhes := DetalhesPresente{Tipo: TipoDetalheProdutoExterno, LinkDaLoja: "https://test.com"}
@@ -15,6 +15,9 @@ type PresenteComSelecao struct {
 	ChaveDeAcesso   *string    // null se não confirmado
 	QuantidadeCotas int        // quantas cotas essa palavra mágica pegou (1 para integrais)
 	DataSelecao     *time.Time // null se não confirmado
+	StatusPagamento *string    // null se a seleção não foi paga pela plataforma
+	ReservadaAte    *time.Time // prazo da reserva; null se a seleção já foi confirmada
+	IDSelecao       *uuid.UUID // null se não confirmado
 }
 
 type PresenteRepository interface {
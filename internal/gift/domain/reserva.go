@@ -0,0 +1,30 @@
+// file: internal/gift/domain/reserva.go
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrReservaNaoEncontrada = errors.New("reserva não encontrada ou expirada")
+
+// Reserva segura cotas e presentes integrais para um grupo enquanto o convidado conclui
+// a seleção ou o pagamento. É uma seleção ainda não confirmada: ao confirmar, a seleção
+// mantém o ID da reserva; ao expirar, os itens voltam a ficar disponíveis.
+type Reserva struct {
+	selecao  *Selecao
+	expiraEm time.Time
+}
+
+func NewReserva(selecao *Selecao, expiraEm time.Time) *Reserva {
+	return &Reserva{selecao: selecao, expiraEm: expiraEm}
+}
+
+// Vencida diz se o prazo da reserva já passou.
+func (r *Reserva) Vencida(agora time.Time) bool {
+	return !agora.Before(r.expiraEm)
+}
+
+// Getters
+func (r *Reserva) Selecao() *Selecao   { return r.selecao }
+func (r *Reserva) ExpiraEm() time.Time { return r.expiraEm }
@@ -0,0 +1,24 @@
+// file: internal/gift/domain/reserva_test.go
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReserva_Vencida(t *testing.T) {
+	expiraEm := time.Now().Add(15 * time.Minute)
+	reserva := NewReserva(NewSelecao(uuid.New(), uuid.New(), nil), expiraEm)
+
+	t.Run("não deve vencer antes do prazo", func(t *testing.T) {
+		assert.False(t, reserva.Vencida(expiraEm.Add(-time.Second)))
+	})
+
+	t.Run("deve vencer no prazo", func(t *testing.T) {
+		assert.True(t, reserva.Vencida(expiraEm))
+		assert.True(t, reserva.Vencida(expiraEm.Add(time.Second)))
+	})
+}
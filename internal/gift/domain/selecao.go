@@ -16,6 +16,13 @@ type Selecao struct {
 	presentesConfirmados []PresenteConfirmado
 }
 
+// AcessoConvidado identifica o grupo que seleciona: a chave de acesso só é única dentro
+// do evento.
+type AcessoConvidado struct {
+	IDEvento      uuid.UUID
+	ChaveDeAcesso string
+}
+
 // PresenteConfirmado é uma entidade de valor dentro da seleção.
 type PresenteConfirmado struct {
 	ID         uuid.UUID
@@ -3,12 +3,32 @@ package domain
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type SelecaoRepository interface {
 	// SalvarSelecao deve ser uma operação transacional.
-	// O mapa quantidades mapeia ID do presente -> quantidade desejada
-	SalvarSelecao(ctx context.Context, chaveDeAcesso string, quantidades map[uuid.UUID]int) (*Selecao, error)
+	// O mapa quantidades mapeia ID do presente -> quantidade desejada. Presentes de outro
+	// evento dão ErrPresentesDeOutroEvento.
+	SalvarSelecao(ctx context.Context, acesso AcessoConvidado, quantidades map[uuid.UUID]int) (*Selecao, error)
+
+	// SalvarReserva reserva os itens até expiraEm, desfazendo a reserva ativa anterior
+	// do grupo na mesma transação.
+	SalvarReserva(ctx context.Context, acesso AcessoConvidado, quantidades map[uuid.UUID]int, expiraEm time.Time) (*Reserva, error)
+	// FindReserva devolve a reserva ativa do grupo; vencida ou de outro grupo, ErrReservaNaoEncontrada.
+	FindReserva(ctx context.Context, acesso AcessoConvidado, idReserva uuid.UUID) (*Reserva, error)
+	// ConfirmarReserva transforma a reserva ativa do grupo em seleção.
+	ConfirmarReserva(ctx context.Context, acesso AcessoConvidado, idReserva uuid.UUID) (*Selecao, error)
+	ListarReservasVencidas(ctx context.Context, ate time.Time, limite int) ([]uuid.UUID, error)
+	// LiberarReserva devolve os itens de uma reserva vencida com Presente.LiberarSelecao e
+	// apaga a reserva. Reservas confirmadas ou já liberadas dão ErrReservaNaoEncontrada.
+	LiberarReserva(ctx context.Context, idReserva uuid.UUID, ate time.Time) error
+
+	// AlterarSelecao troca os itens de uma seleção confirmada, ao alcance da origem, e
+	// registra a alteração na mesma transação. Sem quantidades, a seleção é cancelada.
+	// Reservas e seleções pagas pela plataforma não são alteradas.
+	AlterarSelecao(ctx context.Context, idSelecao uuid.UUID, quantidades map[uuid.UUID]int, origem OrigemAlteracaoSelecao) (*AlteracaoSelecao, error)
+	ListarAlteracoes(ctx context.Context, idEvento uuid.UUID) ([]AlteracaoSelecao, error)
 }
@@ -0,0 +1,110 @@
+// file: internal/gift/infrastructure/fake_gateway.go
+package infrastructure
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/luiszkm/wedding_backend/internal/gift/domain"
+)
+
+// CabecalhoAssinaturaFake leva o HMAC-SHA256 do corpo das notificações do FakePaymentGateway.
+const CabecalhoAssinaturaFake = "X-Fake-Signature"
+
+// FakePaymentGateway simula o gateway sem sair do processo: guarda os checkouts abertos
+// e aceita notificações em JSON assinadas com o segredo configurado. Serve para os testes
+// e para exercitar o fluxo de pagamento em desenvolvimento sem uma conta na Stripe.
+type FakePaymentGateway struct {
+	mu        sync.Mutex
+	segredo   string
+	validade  time.Duration
+	checkouts map[uuid.UUID]domain.CheckoutPagamento
+	valores   map[uuid.UUID]float64
+	falha     error
+}
+
+func NewFakePaymentGateway(segredo string, validade time.Duration) *FakePaymentGateway {
+	return &FakePaymentGateway{
+		segredo:   segredo,
+		validade:  validade,
+		checkouts: make(map[uuid.UUID]domain.CheckoutPagamento),
+		valores:   make(map[uuid.UUID]float64),
+	}
+}
+
+// FalharCheckouts faz os próximos checkouts devolverem err; nil volta ao normal.
+func (g *FakePaymentGateway) FalharCheckouts(err error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.falha = err
+}
+
+func (g *FakePaymentGateway) CriarCheckoutSelecao(ctx context.Context, selecao *domain.Selecao, valor float64) (*domain.CheckoutPagamento, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.falha != nil {
+		return nil, g.falha
+	}
+	id := "fake_cs_" + uuid.NewString()
+	checkout := domain.CheckoutPagamento{
+		IDExterno: id,
+		URL:       "https://pagamentos.fake/checkout/" + id,
+		ExpiraEm:  time.Now().Add(g.validade),
+	}
+	g.checkouts[selecao.ID()] = checkout
+	g.valores[selecao.ID()] = valor
+	return &checkout, nil
+}
+
+// Checkout devolve o checkout aberto para a seleção e o valor cobrado.
+func (g *FakePaymentGateway) Checkout(idSelecao uuid.UUID) (domain.CheckoutPagamento, float64, bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	checkout, ok := g.checkouts[idSelecao]
+	return checkout, g.valores[idSelecao], ok
+}
+
+type notificacaoFake struct {
+	IDSelecao string `json:"idSelecao"`
+	IDExterno string `json:"idExterno"`
+	Status    string `json:"status"`
+}
+
+// Notificacao monta o corpo e os cabeçalhos do webhook que o gateway enviaria ao
+// mudar o pagamento da seleção.
+func (g *FakePaymentGateway) Notificacao(idSelecao uuid.UUID, status domain.StatusPagamento) ([]byte, http.Header) {
+	checkout, _, _ := g.Checkout(idSelecao)
+	payload, _ := json.Marshal(notificacaoFake{IDSelecao: idSelecao.String(), IDExterno: checkout.IDExterno, Status: string(status)})
+	cabecalhos := http.Header{}
+	cabecalhos.Set(CabecalhoAssinaturaFake, g.assinar(payload))
+	return payload, cabecalhos
+}
+
+func (g *FakePaymentGateway) InterpretarWebhook(payload []byte, cabecalhos map[string][]string) (*domain.EventoPagamento, error) {
+	if !hmac.Equal([]byte(http.Header(cabecalhos).Get(CabecalhoAssinaturaFake)), []byte(g.assinar(payload))) {
+		return nil, fmt.Errorf("%w: assinatura do gateway fake não confere", domain.ErrNotificacaoPagamentoInvalida)
+	}
+	var n notificacaoFake
+	if err := json.Unmarshal(payload, &n); err != nil {
+		return nil, fmt.Errorf("%w: corpo malformado: %v", domain.ErrNotificacaoPagamentoInvalida, err)
+	}
+	idSelecao, err := uuid.Parse(n.IDSelecao)
+	if err != nil {
+		return nil, fmt.Errorf("%w: seleção inválida: %v", domain.ErrNotificacaoPagamentoInvalida, err)
+	}
+	return &domain.EventoPagamento{IDSelecao: idSelecao, IDExterno: n.IDExterno, Status: domain.StatusPagamento(n.Status)}, nil
+}
+
+func (g *FakePaymentGateway) assinar(payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(g.segredo))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,183 @@
+// file: internal/gift/infrastructure/postgres_alteracao_selecao.go
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/luiszkm/wedding_backend/internal/gift/domain"
+)
+
+// itemHistoricoJSON é o formato dos itens nas colunas JSONB do histórico de seleções.
+type itemHistoricoJSON struct {
+	ID         uuid.UUID `json:"id"`
+	Nome       string    `json:"nome"`
+	Quantidade int       `json:"quantidade"`
+	ValorCota  *float64  `json:"valorCota,omitempty"`
+}
+
+func (r *PostgresSelecaoRepository) AlterarSelecao(ctx context.Context, idSelecao uuid.UUID, quantidades map[uuid.UUID]int, origem domain.OrigemAlteracaoSelecao) (*domain.AlteracaoSelecao, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao iniciar transação: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// O convidado só alcança as seleções do próprio grupo; o anfitrião, as do evento.
+	var chaveDeAcesso *string
+	if origem.Canal == domain.CanalSelecaoChaveDeAcesso {
+		chaveDeAcesso = &origem.ChaveDeAcesso
+	}
+
+	// A linha da seleção é bloqueada antes dos presentes, como na confirmação da reserva.
+	var idEvento, grupoID uuid.UUID
+	var dataDaSelecao time.Time
+	var statusPagamento *string
+	err = tx.QueryRow(ctx, `
+		SELECT s.id_evento, s.id_grupo_de_convidados, s.data_da_selecao, s.status_pagamento
+		FROM presentes_selecoes s
+		JOIN convidados_grupos g ON g.id = s.id_grupo_de_convidados
+		WHERE s.id = $1 AND s.id_evento = $2 AND s.reservada_ate IS NULL
+		  AND ($3::text IS NULL OR g.chave_de_acesso = $3)
+		FOR UPDATE OF s;
+	`, idSelecao, origem.IDEvento, chaveDeAcesso).Scan(&idEvento, &grupoID, &dataDaSelecao, &statusPagamento)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrSelecaoNaoEncontrada
+		}
+		return nil, fmt.Errorf("falha ao buscar seleção: %w", err)
+	}
+	// O valor cobrado no gateway não muda junto com os itens.
+	if statusPagamento != nil {
+		return nil, domain.ErrSelecaoComPagamento
+	}
+
+	itensAnteriores, err := presentesConfirmadosDaSelecao(ctx, tx, idSelecao)
+	if err != nil {
+		return nil, err
+	}
+	selecao := domain.HydrateSelecao(idSelecao, idEvento, grupoID, itensAnteriores, dataDaSelecao)
+
+	// Os presentes de antes e os pedidos agora são bloqueados juntos, em ordem de ID.
+	pedidos := make([]uuid.UUID, 0, len(quantidades))
+	for id := range quantidades {
+		pedidos = append(pedidos, id)
+	}
+	if _, err := bloquearPresentesDaSelecao(ctx, tx, idSelecao, pedidos...); err != nil {
+		return nil, err
+	}
+
+	// Tudo volta a ficar disponível antes de marcar os itens novos, para que a seleção
+	// possa manter ou reduzir os que já tinha.
+	if err := devolverItensDaSelecao(ctx, tx, idSelecao); err != nil {
+		return nil, err
+	}
+
+	var itensNovos []domain.PresenteConfirmado
+	if len(quantidades) == 0 {
+		if _, err := tx.Exec(ctx, "DELETE FROM presentes_selecoes WHERE id = $1", idSelecao); err != nil {
+			return nil, fmt.Errorf("falha ao apagar seleção cancelada: %w", err)
+		}
+	} else {
+		presentes, err := buscarPresentesParaSelecao(ctx, tx, idEvento, quantidades)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := marcarItensDaSelecao(ctx, tx, idSelecao, quantidades, presentes, domain.StatusSelecionado); err != nil {
+			return nil, err
+		}
+		if itensNovos, err = presentesConfirmadosDaSelecao(ctx, tx, idSelecao); err != nil {
+			return nil, err
+		}
+	}
+
+	alteracao := domain.NewAlteracaoSelecao(selecao, itensNovos, origem)
+	if err := registrarAlteracao(ctx, tx, alteracao); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("falha ao commitar transação: %w", err)
+	}
+	return alteracao, nil
+}
+
+func (r *PostgresSelecaoRepository) ListarAlteracoes(ctx context.Context, idEvento uuid.UUID) ([]domain.AlteracaoSelecao, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT id, id_selecao, id_evento, id_grupo, tipo, itens_anteriores, itens_novos, canal, id_usuario, registrado_em
+		FROM presentes_selecoes_historico
+		WHERE id_evento = $1
+		ORDER BY registrado_em DESC, id;
+	`, idEvento)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao consultar histórico de seleções: %w", err)
+	}
+	defer rows.Close()
+
+	alteracoes := []domain.AlteracaoSelecao{}
+	for rows.Next() {
+		var a domain.AlteracaoSelecao
+		var anteriores, novos []byte
+		if err := rows.Scan(&a.ID, &a.IDSelecao, &a.IDEvento, &a.IDGrupo, &a.Tipo, &anteriores, &novos, &a.Canal, &a.IDUsuario, &a.RegistradoEm); err != nil {
+			return nil, fmt.Errorf("falha ao escanear alteração de seleção: %w", err)
+		}
+		if a.ItensAnteriores, err = itensDoHistorico(anteriores); err != nil {
+			return nil, err
+		}
+		if a.ItensNovos, err = itensDoHistorico(novos); err != nil {
+			return nil, err
+		}
+		alteracoes = append(alteracoes, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("erro durante iteração do histórico de seleções: %w", err)
+	}
+	return alteracoes, nil
+}
+
+// registrarAlteracao acrescenta a alteração ao histórico dentro da transação.
+func registrarAlteracao(ctx context.Context, tx pgx.Tx, a *domain.AlteracaoSelecao) error {
+	anteriores, err := json.Marshal(itensParaHistorico(a.ItensAnteriores))
+	if err != nil {
+		return fmt.Errorf("falha ao serializar itens anteriores: %w", err)
+	}
+	novos, err := json.Marshal(itensParaHistorico(a.ItensNovos))
+	if err != nil {
+		return fmt.Errorf("falha ao serializar itens novos: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO presentes_selecoes_historico
+			(id, id_selecao, id_evento, id_grupo, tipo, itens_anteriores, itens_novos, canal, id_usuario, registrado_em)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+	`, a.ID, a.IDSelecao, a.IDEvento, a.IDGrupo, a.Tipo, anteriores, novos, a.Canal, a.IDUsuario, a.RegistradoEm)
+	if err != nil {
+		return fmt.Errorf("falha ao registrar alteração da seleção: %w", err)
+	}
+	return nil
+}
+
+func itensParaHistorico(itens []domain.PresenteConfirmado) []itemHistoricoJSON {
+	out := make([]itemHistoricoJSON, len(itens))
+	for i, item := range itens {
+		out[i] = itemHistoricoJSON{ID: item.ID, Nome: item.Nome, Quantidade: item.Quantidade, ValorCota: item.ValorCota}
+	}
+	return out
+}
+
+func itensDoHistorico(dados []byte) ([]domain.PresenteConfirmado, error) {
+	var itens []itemHistoricoJSON
+	if err := json.Unmarshal(dados, &itens); err != nil {
+		return nil, fmt.Errorf("falha ao ler itens do histórico de seleções: %w", err)
+	}
+	out := make([]domain.PresenteConfirmado, len(itens))
+	for i, item := range itens {
+		out[i] = domain.PresenteConfirmado{ID: item.ID, Nome: item.Nome, Quantidade: item.Quantidade, ValorCota: item.ValorCota}
+	}
+	return out, nil
+}
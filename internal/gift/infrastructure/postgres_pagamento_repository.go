@@ -0,0 +1,143 @@
+// file: internal/gift/infrastructure/postgres_pagamento_repository.go
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/luiszkm/wedding_backend/internal/gift/domain"
+)
+
+// O pagamento vive nas colunas de presentes_selecoes: uma seleção tem no máximo um.
+type PostgresPagamentoRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPostgresPagamentoRepository(db *pgxpool.Pool) domain.PagamentoRepository {
+	return &PostgresPagamentoRepository{db: db}
+}
+
+func (r *PostgresPagamentoRepository) Save(ctx context.Context, pagamento *domain.Pagamento) error {
+	sql := `
+		UPDATE presentes_selecoes
+		SET status_pagamento = $2, valor_pagamento = $3, id_pagamento_externo = $4, url_checkout = $5,
+		    pagamento_expira_em = $6, pagamento_atualizado_em = $7
+		WHERE id = $1 AND (status_pagamento IS NULL OR status_pagamento = 'PENDENTE');
+	`
+	cmd, err := r.db.Exec(ctx, sql,
+		pagamento.IDSelecao(),
+		string(pagamento.Status()),
+		pagamento.Valor(),
+		textoOuNulo(pagamento.IDExterno()),
+		textoOuNulo(pagamento.CheckoutURL()),
+		pagamento.ExpiraEm(),
+		pagamento.AtualizadoEm(),
+	)
+	if err != nil {
+		return fmt.Errorf("falha ao salvar pagamento da seleção: %w", err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return domain.ErrPagamentoNaoEncontrado
+	}
+	return nil
+}
+
+const colunasPagamento = `
+	id, status_pagamento, valor_pagamento, id_pagamento_externo, url_checkout,
+	pagamento_expira_em, pagamento_atualizado_em
+`
+
+func (r *PostgresPagamentoRepository) FindByIDSelecao(ctx context.Context, idSelecao uuid.UUID) (*domain.Pagamento, error) {
+	sql := `SELECT ` + colunasPagamento + ` FROM presentes_selecoes WHERE id = $1 AND status_pagamento IS NOT NULL;`
+	pagamento, err := scanPagamento(r.db.QueryRow(ctx, sql, idSelecao))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrPagamentoNaoEncontrado
+		}
+		return nil, fmt.Errorf("falha ao buscar pagamento da seleção: %w", err)
+	}
+	return pagamento, nil
+}
+
+func (r *PostgresPagamentoRepository) Update(ctx context.Context, pagamento *domain.Pagamento) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("falha ao iniciar transação: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	cmd, err := tx.Exec(ctx, `
+		UPDATE presentes_selecoes
+		SET status_pagamento = $2, pagamento_atualizado_em = $3
+		WHERE id = $1 AND status_pagamento = 'PENDENTE';
+	`, pagamento.IDSelecao(), string(pagamento.Status()), pagamento.AtualizadoEm())
+	if err != nil {
+		return fmt.Errorf("falha ao atualizar pagamento da seleção: %w", err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return domain.ErrPagamentoJaFinalizado
+	}
+
+	// Pagamento recusado ou vencido devolve os itens pela mesma regra do domínio que as
+	// reservas vencidas e as seleções alteradas.
+	if pagamento.LiberaPresentes() {
+		if err := devolverItensDaSelecao(ctx, tx, pagamento.IDSelecao()); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("falha ao commitar transação: %w", err)
+	}
+	return nil
+}
+
+func (r *PostgresPagamentoRepository) ListarVencidos(ctx context.Context, ate time.Time, limite int) ([]*domain.Pagamento, error) {
+	sql := `
+		SELECT ` + colunasPagamento + `
+		FROM presentes_selecoes
+		WHERE status_pagamento = 'PENDENTE' AND pagamento_expira_em < $1
+		ORDER BY pagamento_expira_em
+		LIMIT $2;
+	`
+	rows, err := r.db.Query(ctx, sql, ate, limite)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao listar pagamentos vencidos: %w", err)
+	}
+	defer rows.Close()
+
+	var pagamentos []*domain.Pagamento
+	for rows.Next() {
+		pagamento, err := scanPagamento(rows)
+		if err != nil {
+			return nil, fmt.Errorf("falha ao escanear pagamento: %w", err)
+		}
+		pagamentos = append(pagamentos, pagamento)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("erro durante iteração dos pagamentos: %w", err)
+	}
+	return pagamentos, nil
+}
+
+func scanPagamento(row pgx.Row) (*domain.Pagamento, error) {
+	var idSelecao uuid.UUID
+	var status string
+	var valor float64
+	var pIDExterno, pCheckoutURL *string
+	var expiraEm time.Time
+	var pAtualizadoEm *time.Time
+	if err := row.Scan(&idSelecao, &status, &valor, &pIDExterno, &pCheckoutURL, &expiraEm, &pAtualizadoEm); err != nil {
+		return nil, err
+	}
+	atualizadoEm := expiraEm
+	if pAtualizadoEm != nil {
+		atualizadoEm = *pAtualizadoEm
+	}
+	return domain.HydratePagamento(idSelecao, domain.StatusPagamento(status), valor, textoOuVazio(pIDExterno), textoOuVazio(pCheckoutURL), expiraEm, atualizadoEm), nil
+}
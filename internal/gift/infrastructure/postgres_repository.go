@@ -28,8 +28,9 @@ func (r *PostgresPresenteRepository) Save(ctx context.Context, presente *domain.
 		INSERT INTO presentes (
 			id, id_evento, nome, descricao, eh_favorito, status, 
 			detalhes_tipo, detalhes_link_loja, foto_url, categoria,
-			tipo, valor_total_presente
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12);
+			tipo, valor_total_presente, detalhes_tipo_chave_pix, detalhes_chave_pix,
+			detalhes_nome_recebedor_pix, detalhes_cidade_recebedor_pix
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16);
 	`
 	detalhes := presente.Detalhes()
 	_, err := r.db.Exec(ctx, sql,
@@ -45,6 +46,10 @@ func (r *PostgresPresenteRepository) Save(ctx context.Context, presente *domain.
 		presente.Categoria(),
 		presente.Tipo(),
 		presente.ValorTotal(),
+		textoOuNulo(detalhes.TipoChavePix),
+		textoOuNulo(detalhes.ChavePix),
+		textoOuNulo(detalhes.NomeRecebedor),
+		textoOuNulo(detalhes.CidadeRecebedor),
 	)
 
 	if err != nil {
@@ -65,8 +70,9 @@ func (r *PostgresPresenteRepository) SaveWithCotas(ctx context.Context, presente
 		INSERT INTO presentes (
 			id, id_evento, nome, descricao, eh_favorito, status, 
 			detalhes_tipo, detalhes_link_loja, foto_url, categoria,
-			tipo, valor_total_presente
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12);
+			tipo, valor_total_presente, detalhes_tipo_chave_pix, detalhes_chave_pix,
+			detalhes_nome_recebedor_pix, detalhes_cidade_recebedor_pix
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16);
 	`
 	detalhes := presente.Detalhes()
 	_, err = tx.Exec(ctx, sqlPresente,
@@ -82,6 +88,10 @@ func (r *PostgresPresenteRepository) SaveWithCotas(ctx context.Context, presente
 		presente.Categoria(),
 		presente.Tipo(),
 		presente.ValorTotal(),
+		textoOuNulo(detalhes.TipoChavePix),
+		textoOuNulo(detalhes.ChavePix),
+		textoOuNulo(detalhes.NomeRecebedor),
+		textoOuNulo(detalhes.CidadeRecebedor),
 	)
 
 	if err != nil {
@@ -120,8 +130,10 @@ func (r *PostgresPresenteRepository) Update(ctx context.Context, presente *domai
 	sql := `
 		UPDATE presentes
 		SET nome = $1, descricao = $2, eh_favorito = $3, foto_url = $4,
-		    categoria = $5, detalhes_tipo = $6, detalhes_link_loja = $7
-		WHERE id = $8;
+		    categoria = $5, detalhes_tipo = $6, detalhes_link_loja = $7,
+		    detalhes_tipo_chave_pix = $8, detalhes_chave_pix = $9,
+		    detalhes_nome_recebedor_pix = $10, detalhes_cidade_recebedor_pix = $11
+		WHERE id = $12;
 	`
 	detalhes := presente.Detalhes()
 	_, err := r.db.Exec(ctx, sql,
@@ -132,6 +144,10 @@ func (r *PostgresPresenteRepository) Update(ctx context.Context, presente *domai
 		presente.Categoria(),
 		detalhes.Tipo,
 		detalhes.LinkDaLoja,
+		textoOuNulo(detalhes.TipoChavePix),
+		textoOuNulo(detalhes.ChavePix),
+		textoOuNulo(detalhes.NomeRecebedor),
+		textoOuNulo(detalhes.CidadeRecebedor),
 		presente.ID(),
 	)
 	if err != nil {
@@ -165,7 +181,8 @@ func (r *PostgresPresenteRepository) FindByIDs(ctx context.Context, presenteIDs
 	sql := `
 		SELECT
 			p.id, p.id_evento, p.nome, p.descricao, p.foto_url, p.status, p.categoria, p.eh_favorito,
-			p.detalhes_tipo, p.detalhes_link_loja, p.tipo, p.valor_total_presente
+			p.detalhes_tipo, p.detalhes_link_loja, p.detalhes_tipo_chave_pix, p.detalhes_chave_pix,
+			p.detalhes_nome_recebedor_pix, p.detalhes_cidade_recebedor_pix, p.tipo, p.valor_total_presente
 		FROM presentes p
 		WHERE p.id = ANY($1)
 		ORDER BY p.nome ASC;
@@ -210,9 +227,10 @@ func (r *PostgresPresenteRepository) ListarDisponiveisPorCasamento(ctx context.C
 	sql := `
 		SELECT
 			p.id, p.id_evento, p.nome, p.descricao, p.foto_url, p.status, p.categoria, p.eh_favorito,
-			p.detalhes_tipo, p.detalhes_link_loja, p.tipo, p.valor_total_presente
+			p.detalhes_tipo, p.detalhes_link_loja, p.detalhes_tipo_chave_pix, p.detalhes_chave_pix,
+			p.detalhes_nome_recebedor_pix, p.detalhes_cidade_recebedor_pix, p.tipo, p.valor_total_presente
 		FROM presentes p
-		WHERE p.id_evento = $1 AND p.status IN ('DISPONIVEL', 'PARCIALMENTE_SELECIONADO')
+		WHERE p.id_evento = $1 AND p.status IN ('DISPONIVEL', 'PARCIALMENTE_SELECIONADO', 'RESERVADO')
 		ORDER BY p.eh_favorito DESC, p.nome ASC;
 	`
 
@@ -260,9 +278,13 @@ func (r *PostgresPresenteRepository) ListarTodosPorEvento(ctx context.Context, e
 			SELECT
 				p.id, p.id_evento, p.nome, p.descricao, p.foto_url,
 				p.status, p.categoria, p.eh_favorito,
-				p.detalhes_tipo, p.detalhes_link_loja, p.tipo, p.valor_total_presente,
+				p.detalhes_tipo, p.detalhes_link_loja, p.detalhes_tipo_chave_pix, p.detalhes_chave_pix,
+				p.detalhes_nome_recebedor_pix, p.detalhes_cidade_recebedor_pix, p.tipo, p.valor_total_presente,
 				cg.chave_de_acesso,
 				ps.data_da_selecao,
+				ps.status_pagamento,
+				ps.reservada_ate,
+				ps.id as id_selecao,
 				CASE WHEN ps.id IS NOT NULL THEN 1 ELSE 0 END as quantidade_cotas
 			FROM presentes p
 			LEFT JOIN presentes_selecoes ps ON ps.id = p.id_selecao
@@ -275,9 +297,13 @@ func (r *PostgresPresenteRepository) ListarTodosPorEvento(ctx context.Context, e
 			SELECT
 				p.id, p.id_evento, p.nome, p.descricao, p.foto_url,
 				p.status, p.categoria, p.eh_favorito,
-				p.detalhes_tipo, p.detalhes_link_loja, p.tipo, p.valor_total_presente,
+				p.detalhes_tipo, p.detalhes_link_loja, p.detalhes_tipo_chave_pix, p.detalhes_chave_pix,
+				p.detalhes_nome_recebedor_pix, p.detalhes_cidade_recebedor_pix, p.tipo, p.valor_total_presente,
 				cg.chave_de_acesso,
 				ps.data_da_selecao,
+				ps.status_pagamento,
+				ps.reservada_ate,
+				ps.id as id_selecao,
 				COUNT(cp.id)::int as quantidade_cotas
 			FROM presentes p
 			INNER JOIN cotas_de_presentes cp ON cp.id_presente = p.id AND cp.status != 'DISPONIVEL'
@@ -286,17 +312,22 @@ func (r *PostgresPresenteRepository) ListarTodosPorEvento(ctx context.Context, e
 			WHERE p.id_evento = $1 AND p.tipo = 'FRACIONADO'
 			GROUP BY p.id, p.id_evento, p.nome, p.descricao, p.foto_url,
 					p.status, p.categoria, p.eh_favorito,
-					p.detalhes_tipo, p.detalhes_link_loja, p.tipo, p.valor_total_presente,
-					cg.chave_de_acesso, ps.data_da_selecao
+					p.detalhes_tipo, p.detalhes_link_loja, p.detalhes_tipo_chave_pix, p.detalhes_chave_pix,
+					p.detalhes_nome_recebedor_pix, p.detalhes_cidade_recebedor_pix, p.tipo, p.valor_total_presente,
+					cg.chave_de_acesso, ps.data_da_selecao, ps.status_pagamento, ps.reservada_ate, ps.id
 		),
 		presentes_fracionados_disponiveis AS (
 			-- Presentes fracionados que ainda têm cotas disponíveis (aparecem 1x com selecao=null)
 			SELECT
 				p.id, p.id_evento, p.nome, p.descricao, p.foto_url,
 				p.status, p.categoria, p.eh_favorito,
-				p.detalhes_tipo, p.detalhes_link_loja, p.tipo, p.valor_total_presente,
+				p.detalhes_tipo, p.detalhes_link_loja, p.detalhes_tipo_chave_pix, p.detalhes_chave_pix,
+				p.detalhes_nome_recebedor_pix, p.detalhes_cidade_recebedor_pix, p.tipo, p.valor_total_presente,
 				NULL::VARCHAR as chave_de_acesso,
 				NULL::TIMESTAMP WITH TIME ZONE as data_da_selecao,
+				NULL::VARCHAR as status_pagamento,
+				NULL::TIMESTAMP WITH TIME ZONE as reservada_ate,
+				NULL::UUID as id_selecao,
 				0 as quantidade_cotas
 			FROM presentes p
 			WHERE p.id_evento = $1
@@ -326,14 +357,18 @@ func (r *PostgresPresenteRepository) ListarTodosPorEvento(ctx context.Context, e
 		var nome, status, detalhesTipo, tipo string
 		var ehFavorito bool
 		var pDesc, pFotoURL, pLinkLoja, pCategoria, pChaveDeAcesso *string
+		var pTipoChavePix, pChavePix, pNomeRecebedor, pCidadeRecebedor *string
 		var pValorTotal *float64
 		var pDataSelecao *time.Time
+		var pStatusPagamento *string
+		var pReservadaAte *time.Time
+		var pIDSelecao *uuid.UUID
 		var quantidadeCotas int
 
 		if err := rows.Scan(
 			&id, &idCasamento, &nome, &pDesc, &pFotoURL, &status, &pCategoria, &ehFavorito,
-			&detalhesTipo, &pLinkLoja, &tipo, &pValorTotal,
-			&pChaveDeAcesso, &pDataSelecao, &quantidadeCotas,
+			&detalhesTipo, &pLinkLoja, &pTipoChavePix, &pChavePix, &pNomeRecebedor, &pCidadeRecebedor, &tipo, &pValorTotal,
+			&pChaveDeAcesso, &pDataSelecao, &pStatusPagamento, &pReservadaAte, &pIDSelecao, &quantidadeCotas,
 		); err != nil {
 			return nil, fmt.Errorf("falha ao escanear linha de presente com seleção: %w", err)
 		}
@@ -361,6 +396,10 @@ func (r *PostgresPresenteRepository) ListarTodosPorEvento(ctx context.Context, e
 
 		d.Tipo = detalhesTipo
 		d.LinkDaLoja = detalhesLinkLoja
+		d.TipoChavePix = textoOuVazio(pTipoChavePix)
+		d.ChavePix = textoOuVazio(pChavePix)
+		d.NomeRecebedor = textoOuVazio(pNomeRecebedor)
+		d.CidadeRecebedor = textoOuVazio(pCidadeRecebedor)
 
 		// Verificar se já temos este presente no mapa
 		presente, exists := presentesMap[id]
@@ -375,6 +414,9 @@ func (r *PostgresPresenteRepository) ListarTodosPorEvento(ctx context.Context, e
 			ChaveDeAcesso:   pChaveDeAcesso,
 			QuantidadeCotas: quantidadeCotas,
 			DataSelecao:     pDataSelecao,
+			StatusPagamento: pStatusPagamento,
+			ReservadaAte:    pReservadaAte,
+			IDSelecao:       pIDSelecao,
 		}
 
 		resultado = append(resultado, pcs)
@@ -401,11 +443,12 @@ func (r *PostgresPresenteRepository) scanPresente(rows pgx.Rows) (*domain.Presen
 	var nome, status, detalhesTipo, tipo string
 	var ehFavorito bool
 	var pDesc, pFotoURL, pLinkLoja, pCategoria *string
+	var pTipoChavePix, pChavePix, pNomeRecebedor, pCidadeRecebedor *string
 	var pValorTotal *float64
 
 	if err := rows.Scan(
 		&id, &idCasamento, &nome, &pDesc, &pFotoURL, &status, &pCategoria, &ehFavorito,
-		&detalhesTipo, &pLinkLoja, &tipo, &pValorTotal,
+		&detalhesTipo, &pLinkLoja, &pTipoChavePix, &pChavePix, &pNomeRecebedor, &pCidadeRecebedor, &tipo, &pValorTotal,
 	); err != nil {
 		return nil, fmt.Errorf("falha ao escanear linha de presente: %w", err)
 	}
@@ -433,6 +476,10 @@ func (r *PostgresPresenteRepository) scanPresente(rows pgx.Rows) (*domain.Presen
 
 	d.Tipo = detalhesTipo
 	d.LinkDaLoja = detalhesLinkLoja
+	d.TipoChavePix = textoOuVazio(pTipoChavePix)
+	d.ChavePix = textoOuVazio(pChavePix)
+	d.NomeRecebedor = textoOuVazio(pNomeRecebedor)
+	d.CidadeRecebedor = textoOuVazio(pCidadeRecebedor)
 
 	return domain.HydratePresente(id, idCasamento, nome, descricao, fotoURL, status, categoria, tipo, ehFavorito, d, pValorTotal, nil), nil
 }
@@ -513,7 +560,8 @@ func (r *PostgresPresenteRepository) FindByID(ctx context.Context, userID, prese
 	sql := `
 		SELECT
 			p.id, p.id_evento, p.nome, p.descricao, p.foto_url, p.status, p.categoria, p.eh_favorito,
-			p.detalhes_tipo, p.detalhes_link_loja, p.tipo, p.valor_total_presente
+			p.detalhes_tipo, p.detalhes_link_loja, p.detalhes_tipo_chave_pix, p.detalhes_chave_pix,
+			p.detalhes_nome_recebedor_pix, p.detalhes_cidade_recebedor_pix, p.tipo, p.valor_total_presente
 		FROM presentes p
 		JOIN eventos e ON p.id_evento = e.id
 		WHERE p.id = $1 AND e.id_usuario = $2;
@@ -592,3 +640,17 @@ func (r *PostgresPresenteRepository) Delete(ctx context.Context, userID, present
 
 	return tx.Commit(ctx)
 }
+
+func textoOuNulo(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func textoOuVazio(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -5,8 +5,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/luiszkm/wedding_backend/internal/gift/domain"
 	guestDomain "github.com/luiszkm/wedding_backend/internal/guest/domain"
@@ -20,32 +22,326 @@ func NewPostgresSelecaoRepository(db *pgxpool.Pool) domain.SelecaoRepository {
 	return &PostgresSelecaoRepository{db: db}
 }
 
-func (r *PostgresSelecaoRepository) SalvarSelecao(ctx context.Context, chaveDeAcesso string, quantidades map[uuid.UUID]int) (*domain.Selecao, error) {
+func (r *PostgresSelecaoRepository) SalvarSelecao(ctx context.Context, acesso domain.AcessoConvidado, quantidades map[uuid.UUID]int) (*domain.Selecao, error) {
 	tx, err := r.db.Begin(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("falha ao iniciar transação: %w", err)
 	}
 	defer tx.Rollback(ctx)
 
-	// 1. Obter o ID do grupo de convidados a partir da chave de acesso.
+	// 1. Obter o ID do grupo de convidados a partir da chave de acesso no evento.
+	grupoID, err := grupoDaChave(ctx, tx, acesso)
+	if err != nil {
+		return nil, err
+	}
+
+	selecao, err := gravarSelecao(ctx, tx, acesso.IDEvento, grupoID, quantidades, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// 5. Se tudo deu certo, confirma a transação.
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("falha ao commitar transação: %w", err)
+	}
+
+	return selecao, nil
+}
+
+func (r *PostgresSelecaoRepository) SalvarReserva(ctx context.Context, acesso domain.AcessoConvidado, quantidades map[uuid.UUID]int, expiraEm time.Time) (*domain.Reserva, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao iniciar transação: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	grupoID, err := grupoDaChave(ctx, tx, acesso)
+	if err != nil {
+		return nil, err
+	}
+
+	// Um grupo tem uma reserva ativa por vez: a anterior é desfeita antes, para que os
+	// itens que o convidado já segurava possam entrar na nova.
+	rows, err := tx.Query(ctx, `
+		SELECT id FROM presentes_selecoes
+		WHERE id_grupo_de_convidados = $1 AND reservada_ate IS NOT NULL
+		FOR UPDATE;
+	`, grupoID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao buscar reserva anterior do grupo: %w", err)
+	}
+	anteriores, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		return nil, fmt.Errorf("falha ao ler reserva anterior do grupo: %w", err)
+	}
+	for _, idAnterior := range anteriores {
+		if err := devolverItensDaSelecao(ctx, tx, idAnterior); err != nil {
+			return nil, err
+		}
+		if _, err := tx.Exec(ctx, "DELETE FROM presentes_selecoes WHERE id = $1", idAnterior); err != nil {
+			return nil, fmt.Errorf("falha ao apagar reserva anterior do grupo: %w", err)
+		}
+	}
+
+	selecao, err := gravarSelecao(ctx, tx, acesso.IDEvento, grupoID, quantidades, &expiraEm)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("falha ao commitar transação: %w", err)
+	}
+
+	return domain.NewReserva(selecao, expiraEm), nil
+}
+
+func (r *PostgresSelecaoRepository) FindReserva(ctx context.Context, acesso domain.AcessoConvidado, idReserva uuid.UUID) (*domain.Reserva, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao iniciar transação: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	grupoID, err := grupoDaChave(ctx, tx, acesso)
+	if err != nil {
+		return nil, err
+	}
+
+	var idEvento uuid.UUID
+	var dataDaSelecao, expiraEm time.Time
+	err = tx.QueryRow(ctx, `
+		SELECT id_evento, data_da_selecao, reservada_ate
+		FROM presentes_selecoes
+		WHERE id = $1 AND id_grupo_de_convidados = $2 AND reservada_ate > NOW();
+	`, idReserva, grupoID).Scan(&idEvento, &dataDaSelecao, &expiraEm)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrReservaNaoEncontrada
+		}
+		return nil, fmt.Errorf("falha ao buscar reserva: %w", err)
+	}
+
+	presentes, err := presentesConfirmadosDaSelecao(ctx, tx, idReserva)
+	if err != nil {
+		return nil, err
+	}
+	selecao := domain.HydrateSelecao(idReserva, idEvento, grupoID, presentes, dataDaSelecao)
+	return domain.NewReserva(selecao, expiraEm), nil
+}
+
+func (r *PostgresSelecaoRepository) ConfirmarReserva(ctx context.Context, acesso domain.AcessoConvidado, idReserva uuid.UUID) (*domain.Selecao, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao iniciar transação: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	grupoID, err := grupoDaChave(ctx, tx, acesso)
+	if err != nil {
+		return nil, err
+	}
+
+	// A linha da reserva é bloqueada antes dos presentes, na mesma ordem da varredura.
+	var idEvento uuid.UUID
+	err = tx.QueryRow(ctx, `
+		SELECT id_evento FROM presentes_selecoes
+		WHERE id = $1 AND id_grupo_de_convidados = $2 AND reservada_ate > NOW()
+		FOR UPDATE;
+	`, idReserva, grupoID).Scan(&idEvento)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrReservaNaoEncontrada
+		}
+		return nil, fmt.Errorf("falha ao buscar reserva: %w", err)
+	}
+
+	presenteIDs, err := bloquearPresentesDaSelecao(ctx, tx, idReserva)
+	if err != nil {
+		return nil, err
+	}
+
+	var dataDaSelecao time.Time
+	batch := &pgx.Batch{}
+	batch.Queue("UPDATE presentes SET status = 'SELECIONADO' WHERE id_selecao = $1 AND tipo = 'INTEGRAL'", idReserva)
+	batch.Queue("UPDATE cotas_de_presentes SET status = 'SELECIONADO' WHERE id_selecao = $1", idReserva)
+	batch.Queue(sqlRecalcularStatusFracionados, presenteIDs)
+	batch.Queue("UPDATE presentes_selecoes SET reservada_ate = NULL, data_da_selecao = DEFAULT WHERE id = $1 RETURNING data_da_selecao", idReserva).
+		QueryRow(func(row pgx.Row) error { return row.Scan(&dataDaSelecao) })
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return nil, fmt.Errorf("falha ao confirmar reserva: %w", err)
+	}
+
+	presentes, err := presentesConfirmadosDaSelecao(ctx, tx, idReserva)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("falha ao commitar transação: %w", err)
+	}
+
+	return domain.HydrateSelecao(idReserva, idEvento, grupoID, presentes, dataDaSelecao), nil
+}
+
+func (r *PostgresSelecaoRepository) ListarReservasVencidas(ctx context.Context, ate time.Time, limite int) ([]uuid.UUID, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT id FROM presentes_selecoes
+		WHERE reservada_ate IS NOT NULL AND reservada_ate <= $1
+		ORDER BY reservada_ate
+		LIMIT $2;
+	`, ate, limite)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao listar reservas vencidas: %w", err)
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		return nil, fmt.Errorf("falha ao ler reservas vencidas: %w", err)
+	}
+	return ids, nil
+}
+
+func (r *PostgresSelecaoRepository) LiberarReserva(ctx context.Context, idReserva uuid.UUID, ate time.Time) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("falha ao iniciar transação: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Confirmada entre a listagem e aqui, a reserva deixou de ser vencida.
+	var id uuid.UUID
+	err = tx.QueryRow(ctx, `
+		SELECT id FROM presentes_selecoes
+		WHERE id = $1 AND reservada_ate IS NOT NULL AND reservada_ate <= $2
+		FOR UPDATE;
+	`, idReserva, ate).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrReservaNaoEncontrada
+		}
+		return fmt.Errorf("falha ao buscar reserva vencida: %w", err)
+	}
+
+	if err := devolverItensDaSelecao(ctx, tx, idReserva); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, "DELETE FROM presentes_selecoes WHERE id = $1", idReserva); err != nil {
+		return fmt.Errorf("falha ao apagar reserva: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("falha ao commitar transação: %w", err)
+	}
+	return nil
+}
+
+// devolverItensDaSelecao libera os itens da seleção com Presente.LiberarSelecao e grava
+// o resultado, sem apagar a seleção.
+func devolverItensDaSelecao(ctx context.Context, tx pgx.Tx, idSelecao uuid.UUID) error {
+	presentes, err := carregarPresentesDaSelecao(ctx, tx, idSelecao)
+	if err != nil {
+		return err
+	}
+	if len(presentes) == 0 {
+		return nil
+	}
+
+	batch := &pgx.Batch{}
+	for _, presente := range presentes {
+		cotasDaSelecao := make([]uuid.UUID, 0)
+		for _, cota := range presente.Cotas() {
+			if cota.IDSelecao() != nil && *cota.IDSelecao() == idSelecao {
+				cotasDaSelecao = append(cotasDaSelecao, cota.ID())
+			}
+		}
+
+		if err := presente.LiberarSelecao(idSelecao); err != nil {
+			return fmt.Errorf("falha ao liberar presente %s da seleção: %w", presente.ID(), err)
+		}
+
+		if presente.EhIntegral() {
+			batch.Queue("UPDATE presentes SET status = $2, id_selecao = NULL WHERE id = $1", presente.ID(), presente.Status())
+			continue
+		}
+		batch.Queue("UPDATE cotas_de_presentes SET status = $2, id_selecao = NULL WHERE id = ANY($1)", cotasDaSelecao, domain.StatusCotaDisponivel)
+		batch.Queue("UPDATE presentes SET status = $2 WHERE id = $1", presente.ID(), presente.Status())
+	}
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("falha ao liberar itens da seleção: %w", err)
+	}
+	return nil
+}
+
+// grupoDaChave acha o grupo pela chave dentro do evento: a mesma chave pode existir em
+// outros eventos.
+func grupoDaChave(ctx context.Context, tx pgx.Tx, acesso domain.AcessoConvidado) (uuid.UUID, error) {
 	var grupoID uuid.UUID
-	err = tx.QueryRow(ctx, "SELECT id FROM convidados_grupos WHERE chave_de_acesso = $1", chaveDeAcesso).Scan(&grupoID)
+	err := tx.QueryRow(ctx, "SELECT id FROM convidados_grupos WHERE id_evento = $1 AND chave_de_acesso = $2", acesso.IDEvento, acesso.ChaveDeAcesso).Scan(&grupoID)
 	if err != nil {
 		// Se a chave não existe, retorna o erro de grupo não encontrado.
-		return nil, guestDomain.ErrGrupoNaoEncontrado
+		return uuid.Nil, guestDomain.ErrGrupoNaoEncontrado
+	}
+	return grupoID, nil
+}
+
+// gravarSelecao grava a seleção do grupo e marca os itens dentro da transação. Com
+// reservadaAte, a seleção é uma reserva e os itens ficam RESERVADO em vez de SELECIONADO.
+func gravarSelecao(ctx context.Context, tx pgx.Tx, idEvento, grupoID uuid.UUID, quantidades map[uuid.UUID]int, reservadaAte *time.Time) (*domain.Selecao, error) {
+	statusItem := domain.StatusSelecionado
+	if reservadaAte != nil {
+		statusItem = domain.StatusReservado
 	}
 
+	// 2. Verificar os presentes e seus tipos com bloqueio de linha (FOR UPDATE).
+	presentes, err := buscarPresentesParaSelecao(ctx, tx, idEvento, quantidades)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Criar o registro da seleção.
+	selecaoID := uuid.New()
+	var dataDaSelecao time.Time
+	sqlInsertSelecao := `
+		INSERT INTO presentes_selecoes (id, id_evento, id_grupo_de_convidados, reservada_ate)
+		VALUES ($1, $2, $3, $4)
+		RETURNING data_da_selecao
+	`
+	if err := tx.QueryRow(ctx, sqlInsertSelecao, selecaoID, idEvento, grupoID, reservadaAte).Scan(&dataDaSelecao); err != nil {
+		return nil, fmt.Errorf("falha ao criar registro de seleção: %w", err)
+	}
+
+	// 4. Processar cada presente baseado no tipo
+	presentesConfirmados, err := marcarItensDaSelecao(ctx, tx, selecaoID, quantidades, presentes, statusItem)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.HydrateSelecao(selecaoID, idEvento, grupoID, presentesConfirmados, dataDaSelecao), nil
+}
+
+// presenteParaSelecao é o presente como a transação o lê antes de marcar os itens.
+type presenteParaSelecao struct {
+	id          uuid.UUID
+	nome        string
+	status      string
+	tipo        string
+	valorTotal  *float64
+	idCasamento uuid.UUID
+}
+
+// buscarPresentesParaSelecao bloqueia os presentes pedidos, em ordem de ID, e confere que
+// todos são do evento.
+func buscarPresentesParaSelecao(ctx context.Context, tx pgx.Tx, idEvento uuid.UUID, quantidades map[uuid.UUID]int) (map[uuid.UUID]presenteParaSelecao, error) {
 	// Extrair IDs dos presentes
 	presenteIDs := make([]uuid.UUID, 0, len(quantidades))
 	for id := range quantidades {
 		presenteIDs = append(presenteIDs, id)
 	}
 
-	// 2. Verificar os presentes e seus tipos com bloqueio de linha (FOR UPDATE).
 	sqlBuscarPresentes := `
 		SELECT id, nome, status, tipo, valor_total_presente, id_evento
 		FROM presentes
 		WHERE id = ANY($1)
+		ORDER BY id
 		FOR UPDATE
 	`
 	rows, err := tx.Query(ctx, sqlBuscarPresentes, presenteIDs)
@@ -54,62 +350,54 @@ func (r *PostgresSelecaoRepository) SalvarSelecao(ctx context.Context, chaveDeAc
 	}
 	defer rows.Close()
 
-	type presenteInfo struct {
-		id          uuid.UUID
-		nome        string
-		status      string
-		tipo        string
-		valorTotal  *float64
-		idCasamento uuid.UUID
-	}
-
-	presentes := make(map[uuid.UUID]presenteInfo)
-	var idCasamento uuid.UUID
+	presentes := make(map[uuid.UUID]presenteParaSelecao)
+	deOutroEvento := make([]uuid.UUID, 0)
 
 	for rows.Next() {
-		var p presenteInfo
+		var p presenteParaSelecao
 		if err := rows.Scan(&p.id, &p.nome, &p.status, &p.tipo, &p.valorTotal, &p.idCasamento); err != nil {
 			return nil, fmt.Errorf("falha ao escanear presente: %w", err)
 		}
 		presentes[p.id] = p
-		if idCasamento == uuid.Nil {
-			idCasamento = p.idCasamento
+		if p.idCasamento != idEvento {
+			deOutroEvento = append(deOutroEvento, p.id)
 		}
 	}
+	rows.Close()
 
 	// Verificar se todos os presentes foram encontrados
 	if len(presentes) != len(presenteIDs) {
 		return nil, errors.New("um ou mais IDs de presentes são inválidos")
 	}
-
-	// 3. Criar o registro da seleção.
-	selecaoID := uuid.New()
-	sqlInsertSelecao := "INSERT INTO presentes_selecoes (id, id_evento, id_grupo_de_convidados) VALUES ($1, $2, $3)"
-	_, err = tx.Exec(ctx, sqlInsertSelecao, selecaoID, idCasamento, grupoID)
-	if err != nil {
-		return nil, fmt.Errorf("falha ao criar registro de seleção: %w", err)
+	if len(deOutroEvento) > 0 {
+		return nil, &domain.ErrPresentesDeOutroEvento{IDEvento: idEvento, PresentesIDs: deOutroEvento}
 	}
+	return presentes, nil
+}
 
-	// 4. Processar cada presente baseado no tipo
+// marcarItensDaSelecao prende à seleção os presentes integrais e as cotas pedidas, com
+// statusItem, e recalcula o status dos fracionados.
+func marcarItensDaSelecao(ctx context.Context, tx pgx.Tx, selecaoID uuid.UUID, quantidades map[uuid.UUID]int, presentes map[uuid.UUID]presenteParaSelecao, statusItem string) ([]domain.PresenteConfirmado, error) {
 	var presentesConflitantes []uuid.UUID
 	presentesConfirmados := make([]domain.PresenteConfirmado, 0, len(presentes))
+	fracionados := make([]uuid.UUID, 0, len(presentes))
 
 	for presenteID, quantidade := range quantidades {
 		presente := presentes[presenteID]
 
-		if presente.tipo == "INTEGRAL" {
+		if presente.tipo == domain.TipoPresenteIntegral {
 			// Presente integral: deve estar disponível e quantidade = 1
 			if quantidade != 1 {
 				return nil, fmt.Errorf("presente integral %s deve ter quantidade 1", presente.nome)
 			}
-			if presente.status != "DISPONIVEL" {
+			if presente.status != domain.StatusDisponivel {
 				presentesConflitantes = append(presentesConflitantes, presenteID)
 				continue
 			}
 
 			// Atualizar presente integral
-			sqlUpdateIntegral := "UPDATE presentes SET status = 'SELECIONADO', id_selecao = $1 WHERE id = $2"
-			if _, err := tx.Exec(ctx, sqlUpdateIntegral, selecaoID, presenteID); err != nil {
+			sqlUpdateIntegral := "UPDATE presentes SET status = $1, id_selecao = $2 WHERE id = $3"
+			if _, err := tx.Exec(ctx, sqlUpdateIntegral, statusItem, selecaoID, presenteID); err != nil {
 				return nil, fmt.Errorf("falha ao atualizar presente integral: %w", err)
 			}
 
@@ -120,7 +408,7 @@ func (r *PostgresSelecaoRepository) SalvarSelecao(ctx context.Context, chaveDeAc
 				ValorCota:  nil,
 			})
 
-		} else if presente.tipo == "FRACIONADO" {
+		} else if presente.tipo == domain.TipoPresenteFracionado {
 			// Presente fracionado: selecionar cotas disponíveis
 			sqlBuscarCotas := `
 				SELECT id, valor_cota
@@ -148,36 +436,17 @@ func (r *PostgresSelecaoRepository) SalvarSelecao(ctx context.Context, chaveDeAc
 			}
 			cotasRows.Close()
 
-			// Verificar se há cotas suficientes
+			// Sem cotas suficientes, outro convidado chegou antes.
 			if len(cotasIDs) < quantidade {
-				return nil, fmt.Errorf("presente %s tem apenas %d cotas disponíveis, solicitado %d", presente.nome, len(cotasIDs), quantidade)
+				presentesConflitantes = append(presentesConflitantes, presenteID)
+				continue
 			}
 
-			// Atualizar cotas para SELECIONADO
-			sqlUpdateCotas := "UPDATE cotas_de_presentes SET status = 'SELECIONADO', id_selecao = $1 WHERE id = ANY($2)"
-			if _, err := tx.Exec(ctx, sqlUpdateCotas, selecaoID, cotasIDs); err != nil {
+			sqlUpdateCotas := "UPDATE cotas_de_presentes SET status = $1, id_selecao = $2 WHERE id = ANY($3)"
+			if _, err := tx.Exec(ctx, sqlUpdateCotas, statusItem, selecaoID, cotasIDs); err != nil {
 				return nil, fmt.Errorf("falha ao atualizar cotas: %w", err)
 			}
-
-			// Verificar quantas cotas ainda estão disponíveis
-			var cotasRestantes int
-			sqlContarCotas := "SELECT COUNT(*) FROM cotas_de_presentes WHERE id_presente = $1 AND status = 'DISPONIVEL'"
-			if err := tx.QueryRow(ctx, sqlContarCotas, presenteID).Scan(&cotasRestantes); err != nil {
-				return nil, fmt.Errorf("falha ao contar cotas restantes: %w", err)
-			}
-
-			// Atualizar status do presente
-			var novoStatus string
-			if cotasRestantes == 0 {
-				novoStatus = "SELECIONADO"
-			} else {
-				novoStatus = "PARCIALMENTE_SELECIONADO"
-			}
-
-			sqlUpdatePresenteFracionado := "UPDATE presentes SET status = $1 WHERE id = $2"
-			if _, err := tx.Exec(ctx, sqlUpdatePresenteFracionado, novoStatus, presenteID); err != nil {
-				return nil, fmt.Errorf("falha ao atualizar status do presente fracionado: %w", err)
-			}
+			fracionados = append(fracionados, presenteID)
 
 			presentesConfirmados = append(presentesConfirmados, domain.PresenteConfirmado{
 				ID:         presenteID,
@@ -193,14 +462,154 @@ func (r *PostgresSelecaoRepository) SalvarSelecao(ctx context.Context, chaveDeAc
 		return nil, &domain.ErrPresentesConflitantes{PresentesIDs: presentesConflitantes}
 	}
 
-	// 5. Se tudo deu certo, confirma a transação.
-	if err := tx.Commit(ctx); err != nil {
-		return nil, fmt.Errorf("falha ao commitar transação: %w", err)
+	// Atualizar status dos presentes fracionados
+	if len(fracionados) > 0 {
+		if _, err := tx.Exec(ctx, sqlRecalcularStatusFracionados, fracionados); err != nil {
+			return nil, fmt.Errorf("falha ao atualizar status do presente fracionado: %w", err)
+		}
 	}
 
-	selecao := domain.NewSelecao(idCasamento, grupoID, presentesConfirmados)
-	// Sobrescreve o ID gerado com o do banco de dados para consistência.
-	selecao = domain.HydrateSelecao(selecaoID, idCasamento, grupoID, presentesConfirmados, selecao.DataDaSelecao())
+	return presentesConfirmados, nil
+}
+
+// sqlRecalcularStatusFracionados aplica aos fracionados de $1 a regra de
+// Presente.atualizarStatus a partir das cotas gravadas.
+const sqlRecalcularStatusFracionados = `
+	UPDATE presentes p
+	SET status = CASE
+		WHEN c.disponiveis = c.total THEN 'DISPONIVEL'::status_presente
+		WHEN c.disponiveis > 0 THEN 'PARCIALMENTE_SELECIONADO'::status_presente
+		WHEN c.reservadas > 0 THEN 'RESERVADO'::status_presente
+		ELSE 'SELECIONADO'::status_presente
+	END
+	FROM (
+		SELECT id_presente, COUNT(*) AS total,
+		       COUNT(*) FILTER (WHERE status = 'DISPONIVEL') AS disponiveis,
+		       COUNT(*) FILTER (WHERE status = 'RESERVADO') AS reservadas
+		FROM cotas_de_presentes
+		WHERE id_presente = ANY($1)
+		GROUP BY id_presente
+	) c
+	WHERE p.id = c.id_presente AND p.tipo = 'FRACIONADO';
+`
 
-	return selecao, nil
+// bloquearPresentesDaSelecao bloqueia, em ordem de ID, os presentes com itens da seleção
+// e os outros informados.
+func bloquearPresentesDaSelecao(ctx context.Context, tx pgx.Tx, idSelecao uuid.UUID, outros ...uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT id FROM presentes
+		WHERE id_selecao = $1 OR id IN (SELECT id_presente FROM cotas_de_presentes WHERE id_selecao = $1)
+		   OR id = ANY($2)
+		ORDER BY id
+		FOR UPDATE;
+	`, idSelecao, outros)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao bloquear presentes da seleção: %w", err)
+	}
+	presenteIDs, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		return nil, fmt.Errorf("falha ao ler presentes da seleção: %w", err)
+	}
+	return presenteIDs, nil
+}
+
+// carregarPresentesDaSelecao bloqueia e hidrata os presentes da seleção com todas as
+// cotas, para as regras do domínio valerem sobre o estado atual.
+func carregarPresentesDaSelecao(ctx context.Context, tx pgx.Tx, idSelecao uuid.UUID) ([]*domain.Presente, error) {
+	presenteIDs, err := bloquearPresentesDaSelecao(ctx, tx, idSelecao)
+	if err != nil {
+		return nil, err
+	}
+	if len(presenteIDs) == 0 {
+		return nil, nil
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT id, id_presente, numero_cota, valor_cota, status, id_selecao
+		FROM cotas_de_presentes
+		WHERE id_presente = ANY($1)
+		ORDER BY id_presente, numero_cota;
+	`, presenteIDs)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao buscar cotas da seleção: %w", err)
+	}
+	cotas := make(map[uuid.UUID][]*domain.Cota)
+	for rows.Next() {
+		var id, idPresente uuid.UUID
+		var numero int
+		var valor float64
+		var status string
+		var idSelecaoCota *uuid.UUID
+		if err := rows.Scan(&id, &idPresente, &numero, &valor, &status, &idSelecaoCota); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("falha ao escanear cota: %w", err)
+		}
+		cotas[idPresente] = append(cotas[idPresente], domain.HydrateCota(id, idPresente, numero, valor, status, idSelecaoCota))
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("erro durante iteração das cotas: %w", err)
+	}
+
+	rows, err = tx.Query(ctx, `
+		SELECT id, id_evento, nome, status, tipo, valor_total_presente
+		FROM presentes
+		WHERE id = ANY($1)
+		ORDER BY id;
+	`, presenteIDs)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao buscar presentes da seleção: %w", err)
+	}
+	defer rows.Close()
+
+	var presentes []*domain.Presente
+	for rows.Next() {
+		var id, idEvento uuid.UUID
+		var nome, status, tipo string
+		var valorTotal *float64
+		if err := rows.Scan(&id, &idEvento, &nome, &status, &tipo, &valorTotal); err != nil {
+			return nil, fmt.Errorf("falha ao escanear presente: %w", err)
+		}
+		presentes = append(presentes, domain.HydratePresente(id, idEvento, nome, "", "", status, "", tipo, false, domain.DetalhesPresente{}, valorTotal, cotas[id]))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("erro durante iteração dos presentes: %w", err)
+	}
+	return presentes, nil
+}
+
+// presentesConfirmadosDaSelecao monta os itens da seleção a partir do banco.
+func presentesConfirmadosDaSelecao(ctx context.Context, tx pgx.Tx, idSelecao uuid.UUID) ([]domain.PresenteConfirmado, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT p.id, p.nome, p.tipo, COUNT(c.id)::int, MAX(c.valor_cota)
+		FROM presentes p
+		LEFT JOIN cotas_de_presentes c ON c.id_presente = p.id AND c.id_selecao = $1
+		WHERE p.id_selecao = $1 OR c.id IS NOT NULL
+		GROUP BY p.id, p.nome, p.tipo
+		ORDER BY p.nome;
+	`, idSelecao)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao buscar presentes da seleção: %w", err)
+	}
+	defer rows.Close()
+
+	var presentes []domain.PresenteConfirmado
+	for rows.Next() {
+		var p domain.PresenteConfirmado
+		var tipo string
+		var valorCota *float64
+		if err := rows.Scan(&p.ID, &p.Nome, &tipo, &p.Quantidade, &valorCota); err != nil {
+			return nil, fmt.Errorf("falha ao escanear presente da seleção: %w", err)
+		}
+		if tipo == domain.TipoPresenteIntegral {
+			p.Quantidade = 1
+		} else {
+			p.ValorCota = valorCota
+		}
+		presentes = append(presentes, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("erro durante iteração dos presentes da seleção: %w", err)
+	}
+	return presentes, nil
 }
@@ -0,0 +1,173 @@
+//go:build integration
+
+// file: internal/gift/infrastructure/postgres_selecao_repository_test.go
+package infrastructure
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/luiszkm/wedding_backend/internal/gift/domain"
+	guestDomain "github.com/luiszkm/wedding_backend/internal/guest/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// A mesma chave existe nos dois eventos, como acontece com chaves fáceis de lembrar.
+const chaveCompartilhada = "FAMILIA-SILVA"
+
+func setupTestDB(t *testing.T) *pgxpool.Pool {
+	dbURL := os.Getenv("DATABASE_URL")
+	if dbURL == "" {
+		t.Skip("DATABASE_URL não definida; testes de integração ignorados")
+	}
+
+	pool, err := pgxpool.New(context.Background(), dbURL)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	return pool
+}
+
+// createTestEvento cria um evento com dono próprio e um grupo com a chave compartilhada.
+func createTestEvento(t *testing.T, pool *pgxpool.Pool) uuid.UUID {
+	ctx := context.Background()
+	userID, eventoID := uuid.New(), uuid.New()
+
+	_, err := pool.Exec(ctx,
+		"INSERT INTO usuarios (id, nome, email, password_hash) VALUES ($1, $2, $3, $4)",
+		userID, "Anfitrião Teste", userID.String()+"@teste.com", "hash")
+	require.NoError(t, err)
+	_, err = pool.Exec(ctx,
+		"INSERT INTO eventos (id, id_usuario, nome, tipo, url_slug) VALUES ($1, $2, $3, $4, $5)",
+		eventoID, userID, "Casamento Teste", "CASAMENTO", "casamento-"+eventoID.String())
+	require.NoError(t, err)
+	_, err = pool.Exec(ctx,
+		"INSERT INTO convidados_grupos (id, id_evento, chave_de_acesso) VALUES ($1, $2, $3)",
+		uuid.New(), eventoID, chaveCompartilhada)
+	require.NoError(t, err)
+
+	// Os presentes apontam para as seleções e o histórico das seleções não deixa apagar
+	// os grupos, por isso saem antes do evento.
+	t.Cleanup(func() {
+		pool.Exec(context.Background(), "DELETE FROM presentes_selecoes_historico WHERE id_evento = $1", eventoID)
+		pool.Exec(context.Background(), "DELETE FROM presentes WHERE id_evento = $1", eventoID)
+		pool.Exec(context.Background(), "DELETE FROM presentes_selecoes WHERE id_evento = $1", eventoID)
+		pool.Exec(context.Background(), "DELETE FROM eventos WHERE id = $1", eventoID)
+		pool.Exec(context.Background(), "DELETE FROM usuarios WHERE id = $1", userID)
+	})
+	return eventoID
+}
+
+func createTestPresente(t *testing.T, pool *pgxpool.Pool, eventoID uuid.UUID, nome string) uuid.UUID {
+	presente, err := domain.NewPresenteIntegral(eventoID, nome, "", "", false, "CASAMENTO",
+		domain.DetalhesPresente{Tipo: "PRODUTO_EXTERNO", LinkDaLoja: "https://loja.exemplo.com/" + nome})
+	require.NoError(t, err)
+	require.NoError(t, NewPostgresPresenteRepository(pool).Save(context.Background(), presente))
+	return presente.ID()
+}
+
+func statusDoPresente(t *testing.T, pool *pgxpool.Pool, id uuid.UUID) string {
+	presentes, err := NewPostgresPresenteRepository(pool).FindByIDs(context.Background(), []uuid.UUID{id})
+	require.NoError(t, err)
+	require.Len(t, presentes, 1)
+	return presentes[0].Status()
+}
+
+func TestPostgresSelecaoRepository_SalvarSelecao(t *testing.T) {
+	pool := setupTestDB(t)
+	repo := NewPostgresSelecaoRepository(pool)
+	ctx := context.Background()
+
+	eventoA := createTestEvento(t, pool)
+	eventoB := createTestEvento(t, pool)
+
+	t.Run("deve selecionar presente do evento da chave", func(t *testing.T) {
+		presenteA := createTestPresente(t, pool, eventoA, "Jogo de Panelas")
+
+		selecao, err := repo.SalvarSelecao(ctx, domain.AcessoConvidado{IDEvento: eventoA, ChaveDeAcesso: chaveCompartilhada}, map[uuid.UUID]int{presenteA: 1})
+
+		require.NoError(t, err)
+		assert.Equal(t, eventoA, selecao.IDCasamento())
+		assert.Equal(t, domain.StatusSelecionado, statusDoPresente(t, pool, presenteA))
+
+		var idGrupoEvento uuid.UUID
+		err = pool.QueryRow(ctx, "SELECT g.id_evento FROM presentes_selecoes s JOIN convidados_grupos g ON g.id = s.id_grupo_de_convidados WHERE s.id = $1", selecao.ID()).Scan(&idGrupoEvento)
+		require.NoError(t, err)
+		assert.Equal(t, eventoA, idGrupoEvento)
+	})
+
+	t.Run("deve recusar presente de outro evento sem alterar nada", func(t *testing.T) {
+		presenteA := createTestPresente(t, pool, eventoA, "Cafeteira")
+		presenteB := createTestPresente(t, pool, eventoB, "Liquidificador")
+
+		_, err := repo.SalvarSelecao(ctx, domain.AcessoConvidado{IDEvento: eventoA, ChaveDeAcesso: chaveCompartilhada}, map[uuid.UUID]int{presenteA: 1, presenteB: 1})
+
+		var outroEventoErr *domain.ErrPresentesDeOutroEvento
+		require.ErrorAs(t, err, &outroEventoErr)
+		assert.Equal(t, eventoA, outroEventoErr.IDEvento)
+		assert.Equal(t, []uuid.UUID{presenteB}, outroEventoErr.PresentesIDs)
+		assert.Equal(t, domain.StatusDisponivel, statusDoPresente(t, pool, presenteA))
+		assert.Equal(t, domain.StatusDisponivel, statusDoPresente(t, pool, presenteB))
+	})
+
+	t.Run("deve recusar chave de outro evento", func(t *testing.T) {
+		presenteA := createTestPresente(t, pool, eventoA, "Torradeira")
+
+		_, err := repo.SalvarSelecao(ctx, domain.AcessoConvidado{IDEvento: uuid.New(), ChaveDeAcesso: chaveCompartilhada}, map[uuid.UUID]int{presenteA: 1})
+
+		assert.ErrorIs(t, err, guestDomain.ErrGrupoNaoEncontrado)
+		assert.Equal(t, domain.StatusDisponivel, statusDoPresente(t, pool, presenteA))
+	})
+}
+
+func TestPostgresSelecaoRepository_AlterarSelecao(t *testing.T) {
+	pool := setupTestDB(t)
+	repo := NewPostgresSelecaoRepository(pool)
+	ctx := context.Background()
+
+	eventoA := createTestEvento(t, pool)
+	eventoB := createTestEvento(t, pool)
+	acessoA := domain.AcessoConvidado{IDEvento: eventoA, ChaveDeAcesso: chaveCompartilhada}
+
+	t.Run("deve recusar a troca por presente de outro evento", func(t *testing.T) {
+		presenteA := createTestPresente(t, pool, eventoA, "Aspirador")
+		presenteB := createTestPresente(t, pool, eventoB, "Ventilador")
+		selecao, err := repo.SalvarSelecao(ctx, acessoA, map[uuid.UUID]int{presenteA: 1})
+		require.NoError(t, err)
+
+		_, err = repo.AlterarSelecao(ctx, selecao.ID(), map[uuid.UUID]int{presenteB: 1}, domain.OrigemConvidado(acessoA))
+
+		var outroEventoErr *domain.ErrPresentesDeOutroEvento
+		require.ErrorAs(t, err, &outroEventoErr)
+		assert.Equal(t, domain.StatusSelecionado, statusDoPresente(t, pool, presenteA))
+		assert.Equal(t, domain.StatusDisponivel, statusDoPresente(t, pool, presenteB))
+	})
+
+	t.Run("não deve alcançar a seleção pela mesma chave em outro evento", func(t *testing.T) {
+		presenteA := createTestPresente(t, pool, eventoA, "Batedeira")
+		selecao, err := repo.SalvarSelecao(ctx, acessoA, map[uuid.UUID]int{presenteA: 1})
+		require.NoError(t, err)
+
+		acessoB := domain.AcessoConvidado{IDEvento: eventoB, ChaveDeAcesso: chaveCompartilhada}
+		_, err = repo.AlterarSelecao(ctx, selecao.ID(), nil, domain.OrigemConvidado(acessoB))
+
+		assert.ErrorIs(t, err, domain.ErrSelecaoNaoEncontrada)
+		assert.Equal(t, domain.StatusSelecionado, statusDoPresente(t, pool, presenteA))
+	})
+
+	t.Run("deve cancelar a seleção no evento da chave", func(t *testing.T) {
+		presenteA := createTestPresente(t, pool, eventoA, "Sanduicheira")
+		selecao, err := repo.SalvarSelecao(ctx, acessoA, map[uuid.UUID]int{presenteA: 1})
+		require.NoError(t, err)
+
+		alteracao, err := repo.AlterarSelecao(ctx, selecao.ID(), nil, domain.OrigemConvidado(acessoA))
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.AlteracaoSelecaoCancelada, alteracao.Tipo)
+		assert.Equal(t, domain.StatusDisponivel, statusDoPresente(t, pool, presenteA))
+	})
+}
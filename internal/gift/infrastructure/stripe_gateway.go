@@ -0,0 +1,113 @@
+// file: internal/gift/infrastructure/stripe_gateway.go
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/luiszkm/wedding_backend/internal/gift/domain"
+	"github.com/stripe/stripe-go/v82"
+	"github.com/stripe/stripe-go/v82/client"
+	"github.com/stripe/stripe-go/v82/webhook"
+)
+
+// metadadoTipoCheckout separa os checkouts de presentes dos de assinatura na mesma conta Stripe.
+const (
+	metadadoTipoCheckout = "tipo"
+	tipoCheckoutSelecao  = "selecao_de_presentes"
+)
+
+// StripeGateway abre checkouts avulsos em BRL para as seleções de presentes. O retorno
+// do convidado vai para urlRetorno com o ID da seleção e o resultado na query string.
+type StripeGateway struct {
+	client        *client.API
+	webhookSecret string
+	urlRetorno    string
+	validade      time.Duration
+}
+
+func NewStripeGateway(secretKey, webhookSecret, urlRetorno string, validade time.Duration) domain.PaymentGateway {
+	sc := &client.API{}
+	sc.Init(secretKey, nil)
+	return &StripeGateway{client: sc, webhookSecret: webhookSecret, urlRetorno: urlRetorno, validade: validade}
+}
+
+func (sg *StripeGateway) CriarCheckoutSelecao(ctx context.Context, selecao *domain.Selecao, valor float64) (*domain.CheckoutPagamento, error) {
+	expiraEm := time.Now().Add(sg.validade)
+	params := &stripe.CheckoutSessionParams{
+		Mode: stripe.String(string(stripe.CheckoutSessionModePayment)),
+		LineItems: []*stripe.CheckoutSessionLineItemParams{
+			{
+				PriceData: &stripe.CheckoutSessionLineItemPriceDataParams{
+					Currency:   stripe.String(string(stripe.CurrencyBRL)),
+					UnitAmount: stripe.Int64(int64(math.Round(valor * 100))),
+					ProductData: &stripe.CheckoutSessionLineItemPriceDataProductDataParams{
+						Name: stripe.String(descricaoCheckout(selecao)),
+					},
+				},
+				Quantity: stripe.Int64(1),
+			},
+		},
+		ClientReferenceID: stripe.String(selecao.ID().String()),
+		ExpiresAt:         stripe.Int64(expiraEm.Unix()),
+		SuccessURL:        stripe.String(fmt.Sprintf("%s?selecao=%s&resultado=sucesso", sg.urlRetorno, selecao.ID())),
+		CancelURL:         stripe.String(fmt.Sprintf("%s?selecao=%s&resultado=cancelado", sg.urlRetorno, selecao.ID())),
+	}
+	params.Context = ctx
+	params.AddMetadata(metadadoTipoCheckout, tipoCheckoutSelecao)
+
+	sess, err := sg.client.CheckoutSessions.New(params)
+	if err != nil {
+		return nil, fmt.Errorf("infra: falha ao criar o checkout da seleção na stripe: %w", err)
+	}
+	return &domain.CheckoutPagamento{IDExterno: sess.ID, URL: sess.URL, ExpiraEm: time.Unix(sess.ExpiresAt, 0)}, nil
+}
+
+func descricaoCheckout(selecao *domain.Selecao) string {
+	presentes := selecao.PresentesConfirmados()
+	if len(presentes) == 1 {
+		return fmt.Sprintf("Presente: %s", presentes[0].Nome)
+	}
+	return fmt.Sprintf("Lista de presentes: %d itens", len(presentes))
+}
+
+func (sg *StripeGateway) InterpretarWebhook(payload []byte, cabecalhos map[string][]string) (*domain.EventoPagamento, error) {
+	event, err := webhook.ConstructEvent(payload, http.Header(cabecalhos).Get("Stripe-Signature"), sg.webhookSecret)
+	if err != nil {
+		return nil, fmt.Errorf("%w: assinatura da stripe: %v", domain.ErrNotificacaoPagamentoInvalida, err)
+	}
+
+	var status domain.StatusPagamento
+	switch event.Type {
+	case stripe.EventTypeCheckoutSessionCompleted, stripe.EventTypeCheckoutSessionAsyncPaymentSucceeded:
+		status = domain.StatusPagamentoPago
+	case stripe.EventTypeCheckoutSessionAsyncPaymentFailed:
+		status = domain.StatusPagamentoFalhou
+	case stripe.EventTypeCheckoutSessionExpired:
+		status = domain.StatusPagamentoExpirado
+	default:
+		return nil, nil
+	}
+
+	var session stripe.CheckoutSession
+	if err := json.Unmarshal(event.Data.Raw, &session); err != nil {
+		return nil, fmt.Errorf("%w: sessão ilegível: %v", domain.ErrNotificacaoPagamentoInvalida, err)
+	}
+	if session.Metadata[metadadoTipoCheckout] != tipoCheckoutSelecao {
+		return nil, nil
+	}
+	// Métodos assíncronos, como boleto, completam o checkout antes de o dinheiro entrar.
+	if event.Type == stripe.EventTypeCheckoutSessionCompleted && session.PaymentStatus != stripe.CheckoutSessionPaymentStatusPaid {
+		return nil, nil
+	}
+	idSelecao, err := uuid.Parse(session.ClientReferenceID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: client_reference_id inválido: %v", domain.ErrNotificacaoPagamentoInvalida, err)
+	}
+	return &domain.EventoPagamento{IDSelecao: idSelecao, IDExterno: session.ID, Status: status}, nil
+}
@@ -0,0 +1,205 @@
+// file: internal/gift/interfaces/rest/alteracao_selecao.go
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	eventDomain "github.com/luiszkm/wedding_backend/internal/event/domain"
+	"github.com/luiszkm/wedding_backend/internal/gift/domain"
+	"github.com/luiszkm/wedding_backend/internal/platform/auth"
+	"github.com/luiszkm/wedding_backend/internal/platform/web"
+)
+
+// HandleEditarSelecao troca os itens de uma seleção do grupo, pelo evento e a chave de
+// acesso ou pelo token do convite.
+func (h *GiftHandler) HandleEditarSelecao(w http.ResponseWriter, r *http.Request) {
+	idSelecao, err := uuid.Parse(chi.URLParam(r, "idSelecao"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID da seleção é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	var reqDTO AlterarSelecaoRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&reqDTO); err != nil {
+		web.RespondError(w, r, "CORPO_INVALIDO", "O corpo da requisição está malformado.", http.StatusBadRequest)
+		return
+	}
+	itens, ok := itensDaRequisicao(w, r, reqDTO.Itens)
+	if !ok {
+		return
+	}
+
+	acesso, ok := h.acessoDoConvidado(w, r, reqDTO.IDEvento, reqDTO.UrlSlug, reqDTO.ChaveDeAcesso, reqDTO.Token)
+	if !ok {
+		return
+	}
+
+	alteracao, err := h.service.EditarSelecao(r.Context(), acesso, idSelecao, itens)
+	if err != nil {
+		responderErroAlteracao(w, r, err)
+		return
+	}
+
+	web.Respond(w, r, novaSelecaoAlteradaDTO(alteracao), http.StatusOK)
+}
+
+// HandleCancelarSelecao desfaz uma seleção do grupo. A chave vai no corpo, como na
+// seleção, por isso o cancelamento é um POST.
+func (h *GiftHandler) HandleCancelarSelecao(w http.ResponseWriter, r *http.Request) {
+	idSelecao, err := uuid.Parse(chi.URLParam(r, "idSelecao"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID da seleção é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	var reqDTO CancelarSelecaoRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&reqDTO); err != nil {
+		web.RespondError(w, r, "CORPO_INVALIDO", "O corpo da requisição está malformado.", http.StatusBadRequest)
+		return
+	}
+
+	acesso, ok := h.acessoDoConvidado(w, r, reqDTO.IDEvento, reqDTO.UrlSlug, reqDTO.ChaveDeAcesso, reqDTO.Token)
+	if !ok {
+		return
+	}
+
+	if _, err := h.service.CancelarSelecao(r.Context(), acesso, idSelecao); err != nil {
+		responderErroAlteracao(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *GiftHandler) HandleEditarSelecaoDoEvento(w http.ResponseWriter, r *http.Request) {
+	userID, idEvento, idSelecao, ok := parametrosSelecaoDoEvento(w, r)
+	if !ok {
+		return
+	}
+
+	var reqDTO AlterarSelecaoRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&reqDTO); err != nil {
+		web.RespondError(w, r, "CORPO_INVALIDO", "O corpo da requisição está malformado.", http.StatusBadRequest)
+		return
+	}
+	itens, ok := itensDaRequisicao(w, r, reqDTO.Itens)
+	if !ok {
+		return
+	}
+
+	alteracao, err := h.service.EditarSelecaoDoEvento(r.Context(), userID, idEvento, idSelecao, itens)
+	if err != nil {
+		responderErroAlteracao(w, r, err)
+		return
+	}
+
+	web.Respond(w, r, novaSelecaoAlteradaDTO(alteracao), http.StatusOK)
+}
+
+func (h *GiftHandler) HandleCancelarSelecaoDoEvento(w http.ResponseWriter, r *http.Request) {
+	userID, idEvento, idSelecao, ok := parametrosSelecaoDoEvento(w, r)
+	if !ok {
+		return
+	}
+
+	if _, err := h.service.CancelarSelecaoDoEvento(r.Context(), userID, idEvento, idSelecao); err != nil {
+		responderErroAlteracao(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *GiftHandler) HandleListarAlteracoesDeSelecoes(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente no token.", http.StatusUnauthorized)
+		return
+	}
+	idEvento, err := uuid.Parse(chi.URLParam(r, "idCasamento"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do evento é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	alteracoes, err := h.service.ListarAlteracoesDeSelecoes(r.Context(), userID, idEvento)
+	if err != nil {
+		if errors.Is(err, eventDomain.ErrEventoNaoEncontrado) {
+			web.RespondError(w, r, "EVENTO_NAO_ENCONTRADO", "Evento não encontrado.", http.StatusNotFound)
+			return
+		}
+		log.Printf("ERRO ao listar histórico de seleções: %v\n", err)
+		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao buscar o histórico de seleções.", http.StatusInternalServerError)
+		return
+	}
+
+	respDTO := make([]AlteracaoSelecaoDTO, len(alteracoes))
+	for i, a := range alteracoes {
+		respDTO[i] = AlteracaoSelecaoDTO{
+			ID:              a.ID.String(),
+			IDSelecao:       a.IDSelecao.String(),
+			IDGrupo:         a.IDGrupo.String(),
+			Tipo:            a.Tipo,
+			ItensAnteriores: novosPresentesConfirmadosDTO(a.ItensAnteriores),
+			ItensNovos:      novosPresentesConfirmadosDTO(a.ItensNovos),
+			Canal:           a.Canal,
+			RegistradoEm:    a.RegistradoEm.Format(time.RFC3339),
+		}
+		if a.IDUsuario != nil {
+			idUsuario := a.IDUsuario.String()
+			respDTO[i].IDUsuario = &idUsuario
+		}
+	}
+	web.Respond(w, r, respDTO, http.StatusOK)
+}
+
+// parametrosSelecaoDoEvento lê o usuário do token e os IDs da rota do anfitrião.
+func parametrosSelecaoDoEvento(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, uuid.UUID, bool) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente no token.", http.StatusUnauthorized)
+		return uuid.Nil, uuid.Nil, uuid.Nil, false
+	}
+	idEvento, err := uuid.Parse(chi.URLParam(r, "idCasamento"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do evento é inválido.", http.StatusBadRequest)
+		return uuid.Nil, uuid.Nil, uuid.Nil, false
+	}
+	idSelecao, err := uuid.Parse(chi.URLParam(r, "idSelecao"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID da seleção é inválido.", http.StatusBadRequest)
+		return uuid.Nil, uuid.Nil, uuid.Nil, false
+	}
+	return userID, idEvento, idSelecao, true
+}
+
+func novaSelecaoAlteradaDTO(a *domain.AlteracaoSelecao) SelecaoConfirmadaDTO {
+	valorTotal := domain.HydrateSelecao(a.IDSelecao, a.IDEvento, a.IDGrupo, a.ItensNovos, a.RegistradoEm).CalcularValorTotal()
+	return SelecaoConfirmadaDTO{
+		IDSelecao:            a.IDSelecao.String(),
+		Mensagem:             "Sua seleção foi alterada com sucesso.",
+		ValorTotal:           valorTotal,
+		PresentesConfirmados: novosPresentesConfirmadosDTO(a.ItensNovos),
+	}
+}
+
+// responderErroAlteracao responde às falhas da edição e do cancelamento de seleções; as
+// demais são as mesmas da seleção.
+func responderErroAlteracao(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, domain.ErrSelecaoNaoEncontrada):
+		web.RespondError(w, r, "SELECAO_NAO_ENCONTRADA", "Seleção não encontrada.", http.StatusNotFound)
+	case errors.Is(err, domain.ErrSelecaoComPagamento):
+		web.RespondError(w, r, "SELECAO_COM_PAGAMENTO", domain.ErrSelecaoComPagamento.Error(), http.StatusConflict)
+	case errors.Is(err, eventDomain.ErrEventoNaoEncontrado):
+		web.RespondError(w, r, "EVENTO_NAO_ENCONTRADO", "Evento não encontrado.", http.StatusNotFound)
+	default:
+		responderErroSelecao(w, r, err, "Falha ao alterar a seleção.")
+	}
+}
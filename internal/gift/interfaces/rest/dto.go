@@ -1,9 +1,38 @@
 package rest
 
+import "github.com/luiszkm/wedding_backend/internal/gift/domain"
+
 // DetalhesPresenteDTO representa os detalhes polimórficos na requisição.
+// PRODUTO_EXTERNO usa linkDaLoja; PIX usa a chave e os dados do recebedor.
 type DetalhesPresenteDTO struct {
-	Tipo       string `json:"tipo"`
-	LinkDaLoja string `json:"linkDaLoja,omitempty"`
+	Tipo            string `json:"tipo"`
+	LinkDaLoja      string `json:"linkDaLoja,omitempty"`
+	TipoChavePix    string `json:"tipoChavePix,omitempty"` // CPF, CNPJ, EMAIL, TELEFONE ou ALEATORIA
+	ChavePix        string `json:"chavePix,omitempty"`
+	NomeRecebedor   string `json:"nomeRecebedor,omitempty"`
+	CidadeRecebedor string `json:"cidadeRecebedor,omitempty"`
+}
+
+func (d DetalhesPresenteDTO) paraDominio() domain.DetalhesPresente {
+	return domain.DetalhesPresente{
+		Tipo:            d.Tipo,
+		LinkDaLoja:      d.LinkDaLoja,
+		TipoChavePix:    d.TipoChavePix,
+		ChavePix:        d.ChavePix,
+		NomeRecebedor:   d.NomeRecebedor,
+		CidadeRecebedor: d.CidadeRecebedor,
+	}
+}
+
+func novoDetalhesPresenteDTO(d domain.DetalhesPresente) DetalhesPresenteDTO {
+	return DetalhesPresenteDTO{
+		Tipo:            d.Tipo,
+		LinkDaLoja:      d.LinkDaLoja,
+		TipoChavePix:    d.TipoChavePix,
+		ChavePix:        d.ChavePix,
+		NomeRecebedor:   d.NomeRecebedor,
+		CidadeRecebedor: d.CidadeRecebedor,
+	}
 }
 
 // CriarPresenteRequestDTO é o contrato de entrada para criar um presente.
@@ -52,6 +81,7 @@ type PresentePublicoDTO struct {
 	CotasTotais       *int     `json:"cotasTotais,omitempty"`
 	CotasDisponiveis  *int     `json:"cotasDisponiveis,omitempty"`
 	CotasSelecionadas *int     `json:"cotasSelecionadas,omitempty"`
+	CotasReservadas   *int     `json:"cotasReservadas,omitempty"`
 }
 
 type ItemSelecaoDTO struct {
@@ -59,13 +89,70 @@ type ItemSelecaoDTO struct {
 	Quantidade int    `json:"quantidade"`
 }
 
+// A chave de acesso só vale no evento, dado por IDEvento ou UrlSlug. Token, o do link
+// de convite, substitui a chave e já traz o evento. Com IDReserva, a seleção confirma a
+// reserva do grupo e Itens é ignorado. Com PagamentoOnline, as cotas são cobradas no
+// gateway e ficam reservadas até o pagamento.
 type FinalizarSelecaoRequestDTO struct {
+	IDEvento        string           `json:"idEvento"`
+	UrlSlug         string           `json:"urlSlug"`
+	ChaveDeAcesso   string           `json:"chaveDeAcesso"`
+	Token           string           `json:"token"`
+	Itens           []ItemSelecaoDTO `json:"itens"`
+	IDReserva       string           `json:"idReserva"`
+	PagamentoOnline bool             `json:"pagamentoOnline"`
+}
+
+// ReservarPresentesRequestDTO segura os itens por alguns minutos antes da seleção.
+type ReservarPresentesRequestDTO struct {
+	IDEvento      string           `json:"idEvento"`
+	UrlSlug       string           `json:"urlSlug"`
 	ChaveDeAcesso string           `json:"chaveDeAcesso"`
+	Token         string           `json:"token"`
 	Itens         []ItemSelecaoDTO `json:"itens"`
 }
 
+type ReservaDTO struct {
+	IDReserva           string                  `json:"idReserva"`
+	ExpiraEm            string                  `json:"expiraEm"`
+	ValorTotal          float64                 `json:"valorTotal"`
+	PresentesReservados []PresenteConfirmadoDTO `json:"presentesReservados"`
+}
+
+// AlterarSelecaoRequestDTO troca os itens de uma seleção confirmada. O anfitrião manda
+// só os itens; o convidado, também o evento e a chave de acesso, ou o token.
+type AlterarSelecaoRequestDTO struct {
+	IDEvento      string           `json:"idEvento"`
+	UrlSlug       string           `json:"urlSlug"`
+	ChaveDeAcesso string           `json:"chaveDeAcesso"`
+	Token         string           `json:"token"`
+	Itens         []ItemSelecaoDTO `json:"itens"`
+}
+
+type CancelarSelecaoRequestDTO struct {
+	IDEvento      string `json:"idEvento"`
+	UrlSlug       string `json:"urlSlug"`
+	ChaveDeAcesso string `json:"chaveDeAcesso"`
+	Token         string `json:"token"`
+}
+
+// AlteracaoSelecaoDTO é uma entrada do histórico de seleções do evento.
+type AlteracaoSelecaoDTO struct {
+	ID              string                  `json:"id"`
+	IDSelecao       string                  `json:"idSelecao"`
+	IDGrupo         string                  `json:"idGrupo"`
+	Tipo            string                  `json:"tipo"`
+	ItensAnteriores []PresenteConfirmadoDTO `json:"itensAnteriores"`
+	ItensNovos      []PresenteConfirmadoDTO `json:"itensNovos"`
+	Canal           string                  `json:"canal"`
+	IDUsuario       *string                 `json:"idUsuario,omitempty"`
+	RegistradoEm    string                  `json:"registradoEm"`
+}
+
 // DTO legacy mantido para compatibilidade
 type FinalizarSelecaoLegacyRequestDTO struct {
+	IDEvento        string   `json:"idEvento"`
+	UrlSlug         string   `json:"urlSlug"`
 	ChaveDeAcesso   string   `json:"chaveDeAcesso"`
 	IDsDosPresentes []string `json:"idsDosPresentes"`
 }
@@ -75,6 +162,17 @@ type SelecaoConfirmadaDTO struct {
 	Mensagem             string                  `json:"mensagem"`
 	ValorTotal           float64                 `json:"valorTotal"`
 	PresentesConfirmados []PresenteConfirmadoDTO `json:"presentesConfirmados"`
+	Pagamento            *PagamentoDTO           `json:"pagamento,omitempty"`
+}
+
+// PagamentoDTO é o pagamento online de uma seleção.
+type PagamentoDTO struct {
+	IDSelecao    string  `json:"idSelecao"`
+	Status       string  `json:"status"`
+	Valor        float64 `json:"valor"`
+	CheckoutURL  string  `json:"checkoutUrl,omitempty"` // só enquanto está pendente
+	ExpiraEm     string  `json:"expiraEm"`
+	AtualizadoEm string  `json:"atualizadoEm"`
 }
 
 type PresenteConfirmadoDTO struct {
@@ -91,12 +189,23 @@ type ConflitoSelecaoDTO struct {
 	PresentesConflitantes []string `json:"presentesConflitantes"`
 }
 
+// PresentesDeOutroEventoDTO lista os presentes pedidos que não são do evento da chave.
+type PresentesDeOutroEventoDTO struct {
+	Codigo                 string   `json:"codigo"`
+	Mensagem               string   `json:"mensagem"`
+	IDEvento               string   `json:"idEvento"`
+	PresentesDeOutroEvento []string `json:"presentesDeOutroEvento"`
+}
+
 // SelecaoInfoDTO contém informações sobre quem confirmou o presente
 type SelecaoInfoDTO struct {
+	IDSelecao       string  `json:"idSelecao"`
 	ChaveDeAcesso   string  `json:"chaveDeAcesso"`
 	QuantidadeCotas int     `json:"quantidadeCotas"`
 	ValorConfirmado float64 `json:"valorConfirmado,omitempty"` // apenas para fracionados
 	DataSelecao     string  `json:"dataSelecao"`
+	StatusPagamento string  `json:"statusPagamento,omitempty"` // só nas seleções pagas pela plataforma
+	ReservadaAte    string  `json:"reservadaAte,omitempty"`    // só enquanto a seleção é uma reserva
 }
 
 // PresenteAdminDTO é usado na rota admin para mostrar presentes com informações de confirmação
@@ -115,5 +224,17 @@ type PresenteAdminDTO struct {
 	CotasTotais       *int                `json:"cotasTotais,omitempty"`
 	CotasDisponiveis  *int                `json:"cotasDisponiveis,omitempty"`
 	CotasSelecionadas *int                `json:"cotasSelecionadas,omitempty"`
+	CotasReservadas   *int                `json:"cotasReservadas,omitempty"`
 	Selecao           *SelecaoInfoDTO     `json:"selecao,omitempty"` // null se não confirmado
 }
+
+// CobrancaPixDTO é o PIX copia-e-cola de um presente. Valor zero indica que o
+// convidado digita o valor no aplicativo do banco.
+type CobrancaPixDTO struct {
+	IDPresente    string  `json:"idPresente"`
+	Nome          string  `json:"nome"`
+	Quantidade    int     `json:"quantidade"`
+	Valor         float64 `json:"valor"`
+	BRCode        string  `json:"brCode"`
+	NomeRecebedor string  `json:"nomeRecebedor"`
+}
@@ -1,28 +1,41 @@
 package rest
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
+	eventDomain "github.com/luiszkm/wedding_backend/internal/event/domain"
 	"github.com/luiszkm/wedding_backend/internal/gift/application"
 	"github.com/luiszkm/wedding_backend/internal/gift/domain"
+	guestDomain "github.com/luiszkm/wedding_backend/internal/guest/domain"
 	"github.com/luiszkm/wedding_backend/internal/platform/auth"
 	"github.com/luiszkm/wedding_backend/internal/platform/storage"
 	"github.com/luiszkm/wedding_backend/internal/platform/web"
 )
 
+// ResolvedorDeAcesso troca o token do link de convite pelo evento e pela chave de acesso
+// do grupo; sem token, devolve o evento e a chave recebidos.
+type ResolvedorDeAcesso interface {
+	ResolverChaveDeAcesso(ctx context.Context, eventID uuid.UUID, chaveDeAcesso, token string) (uuid.UUID, string, error)
+}
+
 type GiftHandler struct {
 	service        *application.GiftService
 	storageService storage.FileStorage
+	acessos        ResolvedorDeAcesso
+	pagamentos     *application.PagamentoService
 }
 
-func NewGiftHandler(service *application.GiftService, storageService storage.FileStorage) *GiftHandler {
-	return &GiftHandler{service: service, storageService: storageService}
+func NewGiftHandler(service *application.GiftService, storageService storage.FileStorage, acessos ResolvedorDeAcesso, pagamentos *application.PagamentoService) *GiftHandler {
+	return &GiftHandler{service: service, storageService: storageService, acessos: acessos, pagamentos: pagamentos}
 }
 
 func (h *GiftHandler) HandleCriarPresente(w http.ResponseWriter, r *http.Request) {
@@ -82,10 +95,7 @@ func (h *GiftHandler) HandleCriarPresente(w http.ResponseWriter, r *http.Request
 		fotoFinalURL = reqDTO.FotoURL
 	}
 
-	detalhesDominio := domain.DetalhesPresente{
-		Tipo:       reqDTO.Detalhes.Tipo,
-		LinkDaLoja: reqDTO.Detalhes.LinkDaLoja,
-	}
+	detalhesDominio := reqDTO.Detalhes.paraDominio()
 
 	var novoPresente *domain.Presente
 
@@ -120,6 +130,7 @@ func (h *GiftHandler) HandleCriarPresente(w http.ResponseWriter, r *http.Request
 	if err != nil {
 		if errors.Is(err, domain.ErrNomePresenteObrigatorio) ||
 			errors.Is(err, domain.ErrDetalhesInvalidos) ||
+			ehErroDadosPix(err) ||
 			errors.Is(err, domain.ErrValorTotalInvalido) ||
 			errors.Is(err, domain.ErrNumeroCotasInvalido) {
 			web.RespondError(w, r, "DADOS_INVALIDOS", err.Error(), http.StatusBadRequest)
@@ -158,10 +169,7 @@ func (h *GiftHandler) HandleListarPresentesPublicos(w http.ResponseWriter, r *ht
 			Categoria:  p.Categoria(),
 			Tipo:       p.Tipo(),
 			Status:     p.Status(),
-			Detalhes: DetalhesPresenteDTO{
-				Tipo:       p.Detalhes().Tipo,
-				LinkDaLoja: p.Detalhes().LinkDaLoja,
-			},
+			Detalhes:   novoDetalhesPresenteDTO(p.Detalhes()),
 		}
 
 		if p.EhFracionado() {
@@ -170,12 +178,14 @@ func (h *GiftHandler) HandleListarPresentesPublicos(w http.ResponseWriter, r *ht
 			cotasTotais := len(p.Cotas())
 			cotasDisponiveis := p.ContarCotasDisponiveis()
 			cotasSelecionadas := p.ContarCotasSelecionadas()
+			cotasReservadas := p.ContarCotasReservadas()
 
 			dto.ValorTotal = valorTotal
 			dto.ValorCota = &valorCota
 			dto.CotasTotais = &cotasTotais
 			dto.CotasDisponiveis = &cotasDisponiveis
 			dto.CotasSelecionadas = &cotasSelecionadas
+			dto.CotasReservadas = &cotasReservadas
 		}
 
 		respDTO[i] = dto
@@ -216,10 +226,7 @@ func (h *GiftHandler) HandleListarPresentesAdmin(w http.ResponseWriter, r *http.
 			Categoria:  p.Categoria(),
 			Tipo:       p.Tipo(),
 			Status:     p.Status(),
-			Detalhes: DetalhesPresenteDTO{
-				Tipo:       p.Detalhes().Tipo,
-				LinkDaLoja: p.Detalhes().LinkDaLoja,
-			},
+			Detalhes:   novoDetalhesPresenteDTO(p.Detalhes()),
 		}
 
 		if p.EhFracionado() {
@@ -228,12 +235,14 @@ func (h *GiftHandler) HandleListarPresentesAdmin(w http.ResponseWriter, r *http.
 			cotasTotais := len(p.Cotas())
 			cotasDisponiveis := p.ContarCotasDisponiveis()
 			cotasSelecionadas := p.ContarCotasSelecionadas()
+			cotasReservadas := p.ContarCotasReservadas()
 
 			dto.ValorTotal = valorTotal
 			dto.ValorCota = &valorCota
 			dto.CotasTotais = &cotasTotais
 			dto.CotasDisponiveis = &cotasDisponiveis
 			dto.CotasSelecionadas = &cotasSelecionadas
+			dto.CotasReservadas = &cotasReservadas
 		}
 
 		// Adicionar informações de seleção (se houver)
@@ -244,11 +253,18 @@ func (h *GiftHandler) HandleListarPresentesAdmin(w http.ResponseWriter, r *http.
 			}
 
 			dto.Selecao = &SelecaoInfoDTO{
+				IDSelecao:       pcs.IDSelecao.String(),
 				ChaveDeAcesso:   *pcs.ChaveDeAcesso,
 				QuantidadeCotas: pcs.QuantidadeCotas,
 				ValorConfirmado: valorConfirmado,
 				DataSelecao:     pcs.DataSelecao.Format("2006-01-02T15:04:05Z07:00"),
 			}
+			if pcs.StatusPagamento != nil {
+				dto.Selecao.StatusPagamento = *pcs.StatusPagamento
+			}
+			if pcs.ReservadaAte != nil {
+				dto.Selecao.ReservadaAte = pcs.ReservadaAte.Format("2006-01-02T15:04:05Z07:00")
+			}
 		}
 
 		respDTO[i] = dto
@@ -264,23 +280,90 @@ func (h *GiftHandler) HandleFinalizarSelecao(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	if len(reqDTO.Itens) == 0 {
-		web.RespondError(w, r, "LISTA_VAZIA", "A lista de presentes não pode estar vazia.", http.StatusBadRequest)
+	// Com reserva, os itens são os que ela segura.
+	var idReserva uuid.UUID
+	var itens []application.ItemSelecao
+	if reqDTO.IDReserva != "" {
+		var err error
+		if idReserva, err = uuid.Parse(reqDTO.IDReserva); err != nil {
+			web.RespondError(w, r, "ID_INVALIDO", "O ID da reserva é inválido.", http.StatusBadRequest)
+			return
+		}
+	} else {
+		var ok bool
+		if itens, ok = itensDaRequisicao(w, r, reqDTO.Itens); !ok {
+			return
+		}
+	}
+
+	acesso, ok := h.acessoDoConvidado(w, r, reqDTO.IDEvento, reqDTO.UrlSlug, reqDTO.ChaveDeAcesso, reqDTO.Token)
+	if !ok {
 		return
 	}
 
+	var selecao *domain.Selecao
+	var pagamento *domain.Pagamento
+	var err error
+	switch {
+	case idReserva != uuid.Nil:
+		selecao, pagamento, err = h.service.FinalizarReserva(r.Context(), acesso, idReserva, reqDTO.PagamentoOnline)
+	case reqDTO.PagamentoOnline:
+		selecao, pagamento, err = h.service.FinalizarSelecaoComPagamento(r.Context(), acesso, itens)
+	default:
+		selecao, err = h.service.FinalizarSelecaoDePresentes(r.Context(), acesso, itens)
+	}
+	if err != nil {
+		if errors.Is(err, domain.ErrPagamentoOnlineIndisponivel) {
+			web.RespondError(w, r, "PAGAMENTO_INDISPONIVEL", err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		if errors.Is(err, domain.ErrSemValorAPagar) {
+			web.RespondError(w, r, "SEM_VALOR_A_PAGAR", err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+		if errors.Is(err, application.ErrCheckoutIndisponivel) {
+			log.Printf("ERRO ao abrir checkout da seleção: %v", err)
+			web.RespondError(w, r, "PAGAMENTO_INDISPONIVEL", application.ErrCheckoutIndisponivel.Error(), http.StatusBadGateway)
+			return
+		}
+		responderErroSelecao(w, r, err, "Falha ao finalizar seleção.")
+		return
+	}
+
+	respDTO := SelecaoConfirmadaDTO{
+		IDSelecao:            selecao.ID().String(),
+		Mensagem:             "Sua seleção foi confirmada com sucesso. Obrigado!",
+		ValorTotal:           selecao.CalcularValorTotal(),
+		PresentesConfirmados: novosPresentesConfirmadosDTO(selecao.PresentesConfirmados()),
+	}
+	if pagamento != nil {
+		respDTO.Mensagem = "Seus presentes estão reservados. Conclua o pagamento para confirmar a seleção."
+		pagamentoDTO := novoPagamentoDTO(pagamento)
+		respDTO.Pagamento = &pagamentoDTO
+	}
+
+	web.Respond(w, r, respDTO, http.StatusCreated)
+}
+
+// itensDaRequisicao converte os itens pedidos, respondendo 400 se algum for inválido.
+func itensDaRequisicao(w http.ResponseWriter, r *http.Request, itensDTO []ItemSelecaoDTO) ([]application.ItemSelecao, bool) {
+	if len(itensDTO) == 0 {
+		web.RespondError(w, r, "LISTA_VAZIA", "A lista de presentes não pode estar vazia.", http.StatusBadRequest)
+		return nil, false
+	}
+
 	// Converter DTOs para domain objects
-	itens := make([]application.ItemSelecao, len(reqDTO.Itens))
-	for i, item := range reqDTO.Itens {
+	itens := make([]application.ItemSelecao, len(itensDTO))
+	for i, item := range itensDTO {
 		idPresente, err := uuid.Parse(item.IDPresente)
 		if err != nil {
 			web.RespondError(w, r, "ID_INVALIDO", fmt.Sprintf("ID do presente inválido: %s", item.IDPresente), http.StatusBadRequest)
-			return
+			return nil, false
 		}
 
 		if item.Quantidade <= 0 {
 			web.RespondError(w, r, "QUANTIDADE_INVALIDA", "A quantidade deve ser positiva.", http.StatusBadRequest)
-			return
+			return nil, false
 		}
 
 		itens[i] = application.ItemSelecao{
@@ -288,27 +371,41 @@ func (h *GiftHandler) HandleFinalizarSelecao(w http.ResponseWriter, r *http.Requ
 			Quantidade: item.Quantidade,
 		}
 	}
+	return itens, true
+}
 
-	selecao, err := h.service.FinalizarSelecaoDePresentes(r.Context(), reqDTO.ChaveDeAcesso, itens)
-	if err != nil {
-		var conflitoErr *domain.ErrPresentesConflitantes
-		if errors.As(err, &conflitoErr) {
-			respConflito := ConflitoSelecaoDTO{
-				Codigo:                "CONFLITO_DE_SELECAO",
-				Mensagem:              "Um ou mais itens na sua lista já foram selecionados.",
-				PresentesConflitantes: stringUUIDs(conflitoErr.PresentesIDs),
-			}
-			web.Respond(w, r, respConflito, http.StatusConflict)
-			return
+// responderErroSelecao responde às falhas comuns à seleção e à reserva de presentes.
+func responderErroSelecao(w http.ResponseWriter, r *http.Request, err error, mensagemInterna string) {
+	var conflitoErr *domain.ErrPresentesConflitantes
+	var outroEventoErr *domain.ErrPresentesDeOutroEvento
+	switch {
+	case errors.As(err, &outroEventoErr):
+		web.Respond(w, r, PresentesDeOutroEventoDTO{
+			Codigo:                 "PRESENTE_DE_OUTRO_EVENTO",
+			Mensagem:               "Um ou mais presentes da lista não pertencem ao evento da chave de acesso.",
+			IDEvento:               outroEventoErr.IDEvento.String(),
+			PresentesDeOutroEvento: stringUUIDs(outroEventoErr.PresentesIDs),
+		}, http.StatusUnprocessableEntity)
+	case errors.As(err, &conflitoErr):
+		respConflito := ConflitoSelecaoDTO{
+			Codigo:                "CONFLITO_DE_SELECAO",
+			Mensagem:              "Um ou mais itens na sua lista já foram selecionados ou estão reservados.",
+			PresentesConflitantes: stringUUIDs(conflitoErr.PresentesIDs),
 		}
-
-		log.Printf("ERRO ao finalizar seleção: %v", err)
-		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao finalizar seleção.", http.StatusInternalServerError)
-		return
+		web.Respond(w, r, respConflito, http.StatusConflict)
+	case errors.Is(err, guestDomain.ErrGrupoNaoEncontrado):
+		web.RespondError(w, r, "CHAVE_INVALIDA", "A chave de acesso fornecida é inválida.", http.StatusNotFound)
+	case errors.Is(err, domain.ErrReservaNaoEncontrada):
+		web.RespondError(w, r, "RESERVA_EXPIRADA", domain.ErrReservaNaoEncontrada.Error(), http.StatusGone)
+	default:
+		log.Printf("ERRO: %v", err)
+		web.RespondError(w, r, "ERRO_INTERNO", mensagemInterna, http.StatusInternalServerError)
 	}
+}
 
-	presentesDTO := make([]PresenteConfirmadoDTO, len(selecao.PresentesConfirmados()))
-	for i, p := range selecao.PresentesConfirmados() {
+func novosPresentesConfirmadosDTO(presentes []domain.PresenteConfirmado) []PresenteConfirmadoDTO {
+	presentesDTO := make([]PresenteConfirmadoDTO, len(presentes))
+	for i, p := range presentes {
 		dto := PresenteConfirmadoDTO{
 			ID:         p.ID.String(),
 			Nome:       p.Nome,
@@ -323,15 +420,7 @@ func (h *GiftHandler) HandleFinalizarSelecao(w http.ResponseWriter, r *http.Requ
 
 		presentesDTO[i] = dto
 	}
-
-	respDTO := SelecaoConfirmadaDTO{
-		IDSelecao:            selecao.ID().String(),
-		Mensagem:             "Sua seleção foi confirmada com sucesso. Obrigado!",
-		ValorTotal:           selecao.CalcularValorTotal(),
-		PresentesConfirmados: presentesDTO,
-	}
-
-	web.Respond(w, r, respDTO, http.StatusCreated)
+	return presentesDTO
 }
 
 // Método legacy para compatibilidade
@@ -359,6 +448,8 @@ func (h *GiftHandler) HandleFinalizarSelecaoLegacy(w http.ResponseWriter, r *htt
 
 	// Usar novo método
 	novoReqDTO := FinalizarSelecaoRequestDTO{
+		IDEvento:      reqDTO.IDEvento,
+		UrlSlug:       reqDTO.UrlSlug,
 		ChaveDeAcesso: reqDTO.ChaveDeAcesso,
 		Itens:         make([]ItemSelecaoDTO, len(itens)),
 	}
@@ -371,13 +462,66 @@ func (h *GiftHandler) HandleFinalizarSelecaoLegacy(w http.ResponseWriter, r *htt
 	}
 
 	// Reprocessar com novo handler
-	reqBody, _ := json.Marshal(novoReqDTO)
-	r.Body = http.NoBody
+	reqBody, err := json.Marshal(novoReqDTO)
+	if err != nil {
+		log.Printf("ERRO: %v\n", err)
+		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(reqBody))
 	r.ContentLength = int64(len(reqBody))
 
 	h.HandleFinalizarSelecao(w, r)
 }
 
+// acessoDoConvidado resolve o evento e a chave com que o grupo seleciona presentes. A
+// chave só é única dentro do evento, que vem pelo ID, pelo slug ou pelo token do link.
+func (h *GiftHandler) acessoDoConvidado(w http.ResponseWriter, r *http.Request, idEvento, urlSlug, chaveDeAcesso, token string) (domain.AcessoConvidado, bool) {
+	eventID := uuid.Nil
+	switch {
+	case idEvento != "":
+		var err error
+		if eventID, err = uuid.Parse(idEvento); err != nil {
+			web.RespondError(w, r, "DADOS_INVALIDOS", "ID do evento inválido: "+idEvento, http.StatusBadRequest)
+			return domain.AcessoConvidado{}, false
+		}
+	case urlSlug != "":
+		var err error
+		if eventID, err = h.service.EventoPorSlug(r.Context(), urlSlug); err != nil {
+			if errors.Is(err, eventDomain.ErrEventoNaoEncontrado) {
+				web.RespondError(w, r, "EVENTO_NAO_ENCONTRADO", "Evento não encontrado.", http.StatusNotFound)
+				return domain.AcessoConvidado{}, false
+			}
+			log.Printf("ERRO ao buscar evento da seleção: %v", err)
+			web.RespondError(w, r, "ERRO_INTERNO", "Falha ao validar o acesso.", http.StatusInternalServerError)
+			return domain.AcessoConvidado{}, false
+		}
+	case token == "":
+		web.RespondError(w, r, "EVENTO_OBRIGATORIO", "Informe o evento (idEvento ou urlSlug) junto com a chave de acesso.", http.StatusBadRequest)
+		return domain.AcessoConvidado{}, false
+	}
+
+	eventID, chave, err := h.acessos.ResolverChaveDeAcesso(r.Context(), eventID, chaveDeAcesso, token)
+	if err != nil {
+		responderErroAcesso(w, r, err)
+		return domain.AcessoConvidado{}, false
+	}
+	return domain.AcessoConvidado{IDEvento: eventID, ChaveDeAcesso: chave}, true
+}
+
+// responderErroAcesso responde às falhas de ResolverChaveDeAcesso.
+func responderErroAcesso(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, guestDomain.ErrLinkDeAcessoInvalido):
+		web.RespondError(w, r, "LINK_INVALIDO", err.Error(), http.StatusUnauthorized)
+	case errors.Is(err, guestDomain.ErrGrupoNaoEncontrado):
+		web.RespondError(w, r, "CHAVE_INVALIDA", "A chave de acesso fornecida é inválida.", http.StatusNotFound)
+	default:
+		log.Printf("ERRO ao validar acesso do convidado: %v", err)
+		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao validar o acesso.", http.StatusInternalServerError)
+	}
+}
+
 func parseUUIDs(ids []string) ([]uuid.UUID, error) {
 	uuids := make([]uuid.UUID, 0, len(ids))
 	for _, idStr := range ids {
@@ -482,10 +626,7 @@ func (h *GiftHandler) HandleAtualizarPresente(w http.ResponseWriter, r *http.Req
 		fotoFinalURL = reqDTO.FotoURL
 	}
 
-	detalhesDominio := domain.DetalhesPresente{
-		Tipo:       reqDTO.Detalhes.Tipo,
-		LinkDaLoja: reqDTO.Detalhes.LinkDaLoja,
-	}
+	detalhesDominio := reqDTO.Detalhes.paraDominio()
 
 	err = h.service.AtualizarPresente(
 		r.Context(),
@@ -504,7 +645,7 @@ func (h *GiftHandler) HandleAtualizarPresente(w http.ResponseWriter, r *http.Req
 			web.RespondError(w, r, "NAO_ENCONTRADO", "Presente não encontrado.", http.StatusNotFound)
 			return
 		}
-		if errors.Is(err, domain.ErrNomePresenteObrigatorio) || errors.Is(err, domain.ErrDetalhesInvalidos) {
+		if errors.Is(err, domain.ErrNomePresenteObrigatorio) || errors.Is(err, domain.ErrDetalhesInvalidos) || ehErroDadosPix(err) {
 			web.RespondError(w, r, "DADOS_INVALIDOS", err.Error(), http.StatusBadRequest)
 			return
 		}
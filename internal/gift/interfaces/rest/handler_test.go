@@ -0,0 +1,99 @@
+// file: internal/gift/interfaces/rest/handler_test.go
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/luiszkm/wedding_backend/internal/gift/application"
+	"github.com/luiszkm/wedding_backend/internal/gift/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// presentesEmMemoria atende apenas a busca por IDs usada ao finalizar a seleção.
+type presentesEmMemoria struct {
+	domain.PresenteRepository
+	presentes map[uuid.UUID]*domain.Presente
+}
+
+func (r *presentesEmMemoria) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Presente, error) {
+	var encontrados []*domain.Presente
+	for _, id := range ids {
+		if presente, ok := r.presentes[id]; ok {
+			encontrados = append(encontrados, presente)
+		}
+	}
+	return encontrados, nil
+}
+
+// selecoesEmMemoria guarda o acesso e as quantidades da última seleção salva.
+type selecoesEmMemoria struct {
+	domain.SelecaoRepository
+	acesso      domain.AcessoConvidado
+	quantidades map[uuid.UUID]int
+}
+
+func (r *selecoesEmMemoria) SalvarSelecao(ctx context.Context, acesso domain.AcessoConvidado, quantidades map[uuid.UUID]int) (*domain.Selecao, error) {
+	r.acesso, r.quantidades = acesso, quantidades
+	confirmados := make([]domain.PresenteConfirmado, 0, len(quantidades))
+	for id, quantidade := range quantidades {
+		confirmados = append(confirmados, domain.PresenteConfirmado{ID: id, Quantidade: quantidade})
+	}
+	return domain.NewSelecao(acesso.IDEvento, uuid.New(), confirmados), nil
+}
+
+// resolvedorSemToken aceita o evento e a chave recebidos, como acontece sem token.
+type resolvedorSemToken struct{}
+
+func (resolvedorSemToken) ResolverChaveDeAcesso(ctx context.Context, eventID uuid.UUID, chaveDeAcesso, token string) (uuid.UUID, string, error) {
+	return eventID, chaveDeAcesso, nil
+}
+
+func TestHandleFinalizarSelecaoLegacy(t *testing.T) {
+	idEvento := uuid.New()
+	presente, err := domain.NewPresenteIntegral(idEvento, "Jogo de Panelas", "", "", false, "CASAMENTO",
+		domain.DetalhesPresente{Tipo: "PRODUTO_EXTERNO", LinkDaLoja: "https://loja.exemplo.com/panelas"})
+	require.NoError(t, err)
+
+	novoHandler := func() (*GiftHandler, *selecoesEmMemoria) {
+		selecoes := &selecoesEmMemoria{}
+		presentes := &presentesEmMemoria{presentes: map[uuid.UUID]*domain.Presente{presente.ID(): presente}}
+		service := application.NewGiftService(presentes, selecoes, nil, nil, 0)
+		return NewGiftHandler(service, nil, resolvedorSemToken{}, nil), selecoes
+	}
+
+	t.Run("deve finalizar a seleção com o corpo no formato antigo", func(t *testing.T) {
+		handler, selecoes := novoHandler()
+		corpo := `{"idEvento": "` + idEvento.String() + `", "chaveDeAcesso": "FAMILIA-SILVA", "idsDosPresentes": ["` + presente.ID().String() + `"]}`
+		r := httptest.NewRequest(http.MethodPost, "/selecoes-de-presentes", strings.NewReader(corpo))
+		w := httptest.NewRecorder()
+
+		handler.HandleFinalizarSelecaoLegacy(w, r)
+
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+		assert.Equal(t, domain.AcessoConvidado{IDEvento: idEvento, ChaveDeAcesso: "FAMILIA-SILVA"}, selecoes.acesso)
+		assert.Equal(t, map[uuid.UUID]int{presente.ID(): 1}, selecoes.quantidades)
+		var resposta SelecaoConfirmadaDTO
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&resposta))
+		assert.NotEmpty(t, resposta.IDSelecao)
+	})
+
+	t.Run("deve recusar ID de presente inválido", func(t *testing.T) {
+		handler, selecoes := novoHandler()
+		corpo := `{"idEvento": "` + idEvento.String() + `", "chaveDeAcesso": "FAMILIA-SILVA", "idsDosPresentes": ["nao-e-uuid"]}`
+		r := httptest.NewRequest(http.MethodPost, "/selecoes-de-presentes", strings.NewReader(corpo))
+		w := httptest.NewRecorder()
+
+		handler.HandleFinalizarSelecaoLegacy(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "ID_INVALIDO")
+		assert.Nil(t, selecoes.quantidades)
+	})
+}
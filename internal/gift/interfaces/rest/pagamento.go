@@ -0,0 +1,83 @@
+// file: internal/gift/interfaces/rest/pagamento.go
+package rest
+
+import (
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/luiszkm/wedding_backend/internal/gift/domain"
+	"github.com/luiszkm/wedding_backend/internal/platform/web"
+)
+
+func novoPagamentoDTO(p *domain.Pagamento) PagamentoDTO {
+	dto := PagamentoDTO{
+		IDSelecao:    p.IDSelecao().String(),
+		Status:       string(p.Status()),
+		Valor:        p.Valor(),
+		ExpiraEm:     p.ExpiraEm().Format(time.RFC3339),
+		AtualizadoEm: p.AtualizadoEm().Format(time.RFC3339),
+	}
+	if p.Status() == domain.StatusPagamentoPendente {
+		dto.CheckoutURL = p.CheckoutURL()
+	}
+	return dto
+}
+
+// HandleObterPagamentoSelecao devolve o status do pagamento para a tela de retorno do
+// checkout, que só conhece o ID da seleção.
+func (h *GiftHandler) HandleObterPagamentoSelecao(w http.ResponseWriter, r *http.Request) {
+	idSelecao, err := uuid.Parse(chi.URLParam(r, "idSelecao"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID da seleção é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	pagamento, err := h.pagamentos.ConsultarPagamento(r.Context(), idSelecao)
+	if err != nil {
+		if errors.Is(err, domain.ErrPagamentoNaoEncontrado) {
+			web.RespondError(w, r, "NAO_ENCONTRADO", err.Error(), http.StatusNotFound)
+			return
+		}
+		log.Printf("ERRO ao consultar pagamento da seleção: %v\n", err)
+		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao consultar o pagamento.", http.StatusInternalServerError)
+		return
+	}
+
+	web.Respond(w, r, novoPagamentoDTO(pagamento), http.StatusOK)
+}
+
+// HandleWebhookPagamento recebe as notificações do gateway das seleções de presentes.
+// Responde 200 a tudo o que foi autenticado, mesmo ignorado, para o gateway não reenviar.
+func (h *GiftHandler) HandleWebhookPagamento(w http.ResponseWriter, r *http.Request) {
+	const MaxBodyBytes = int64(65536)
+	r.Body = http.MaxBytesReader(w, r.Body, MaxBodyBytes)
+
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("ERRO ao ler o corpo do webhook de pagamento: %v", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if err := h.pagamentos.ProcessarWebhook(r.Context(), payload, r.Header); err != nil {
+		if errors.Is(err, domain.ErrPagamentoOnlineIndisponivel) {
+			web.RespondError(w, r, "NAO_ENCONTRADO", err.Error(), http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, domain.ErrNotificacaoPagamentoInvalida) {
+			log.Printf("ERRO na verificação do webhook de pagamento: %v", err)
+			web.RespondError(w, r, "ASSINATURA_INVALIDA", "Assinatura do webhook inválida.", http.StatusBadRequest)
+			return
+		}
+		log.Printf("ERRO ao processar webhook de pagamento: %v\n", err)
+		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar a notificação.", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
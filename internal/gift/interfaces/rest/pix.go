@@ -0,0 +1,105 @@
+// file: internal/gift/interfaces/rest/pix.go
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/luiszkm/wedding_backend/internal/gift/domain"
+	"github.com/luiszkm/wedding_backend/internal/platform/qr"
+	"github.com/luiszkm/wedding_backend/internal/platform/web"
+)
+
+// ehErroDadosPix reconhece as validações da chave e do recebedor, que viram DADOS_INVALIDOS.
+func ehErroDadosPix(err error) bool {
+	return errors.Is(err, domain.ErrTipoChavePixInvalido) ||
+		errors.Is(err, domain.ErrChavePixInvalida) ||
+		errors.Is(err, domain.ErrRecebedorPixInvalido)
+}
+
+func (h *GiftHandler) HandleObterPix(w http.ResponseWriter, r *http.Request) {
+	presente, cobranca, quantidade, ok := h.cobrancaPix(w, r)
+	if !ok {
+		return
+	}
+
+	web.Respond(w, r, CobrancaPixDTO{
+		IDPresente:    presente.ID().String(),
+		Nome:          presente.Nome(),
+		Quantidade:    quantidade,
+		Valor:         cobranca.Valor,
+		BRCode:        cobranca.BRCode,
+		NomeRecebedor: presente.Detalhes().NomeRecebedor,
+	}, http.StatusOK)
+}
+
+func (h *GiftHandler) HandleObterQRCodePix(w http.ResponseWriter, r *http.Request) {
+	formato, tamanho, err := qr.ParametrosDaQuery(r)
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	presente, cobranca, _, ok := h.cobrancaPix(w, r)
+	if !ok {
+		return
+	}
+
+	imagem, contentType, err := qr.Gerar(cobranca.BRCode, formato, tamanho)
+	if err != nil {
+		log.Printf("ERRO ao gerar QR code %s: %v\n", formato, err)
+		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao gerar o QR code.", http.StatusInternalServerError)
+		return
+	}
+
+	nomeArquivo := fmt.Sprintf("pix-%s.%s", presente.ID(), formato)
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", nomeArquivo))
+	w.WriteHeader(http.StatusOK)
+	w.Write(imagem)
+}
+
+// cobrancaPix lê o evento, o presente e a quantidade da requisição e monta o PIX,
+// respondendo ao cliente quando algo falha.
+func (h *GiftHandler) cobrancaPix(w http.ResponseWriter, r *http.Request) (*domain.Presente, *domain.CobrancaPix, int, bool) {
+	idEvento, err := uuid.Parse(chi.URLParam(r, "idCasamento"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do evento é inválido.", http.StatusBadRequest)
+		return nil, nil, 0, false
+	}
+	idPresente, err := uuid.Parse(chi.URLParam(r, "idPresente"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do presente é inválido.", http.StatusBadRequest)
+		return nil, nil, 0, false
+	}
+	quantidade := 1
+	if valor := r.URL.Query().Get("quantidade"); valor != "" {
+		quantidade, err = strconv.Atoi(valor)
+		if err != nil {
+			web.RespondError(w, r, "PARAMETRO_INVALIDO", "A quantidade deve ser um número inteiro.", http.StatusBadRequest)
+			return nil, nil, 0, false
+		}
+	}
+
+	presente, cobranca, err := h.service.GerarCobrancaPix(r.Context(), idEvento, idPresente, quantidade)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPresenteNaoEncontrado):
+			web.RespondError(w, r, "NAO_ENCONTRADO", "Presente não encontrado.", http.StatusNotFound)
+		case errors.Is(err, domain.ErrPresenteSemPix):
+			web.RespondError(w, r, "PRESENTE_SEM_PIX", err.Error(), http.StatusUnprocessableEntity)
+		case errors.Is(err, domain.ErrQuantidadePixInvalida):
+			web.RespondError(w, r, "QUANTIDADE_INVALIDA", err.Error(), http.StatusBadRequest)
+		default:
+			log.Printf("ERRO ao gerar PIX do presente: %v\n", err)
+			web.RespondError(w, r, "ERRO_INTERNO", "Falha ao gerar o PIX.", http.StatusInternalServerError)
+		}
+		return nil, nil, 0, false
+	}
+	return presente, cobranca, quantidade, true
+}
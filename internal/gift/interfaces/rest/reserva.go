@@ -0,0 +1,43 @@
+// file: internal/gift/interfaces/rest/reserva.go
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/luiszkm/wedding_backend/internal/platform/web"
+)
+
+// HandleReservarPresentes segura os itens para o grupo enquanto o convidado conclui a
+// seleção. Uma nova reserva do mesmo grupo substitui a anterior.
+func (h *GiftHandler) HandleReservarPresentes(w http.ResponseWriter, r *http.Request) {
+	var reqDTO ReservarPresentesRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&reqDTO); err != nil {
+		web.RespondError(w, r, "CORPO_INVALIDO", "O corpo da requisição está malformado.", http.StatusBadRequest)
+		return
+	}
+
+	itens, ok := itensDaRequisicao(w, r, reqDTO.Itens)
+	if !ok {
+		return
+	}
+
+	acesso, ok := h.acessoDoConvidado(w, r, reqDTO.IDEvento, reqDTO.UrlSlug, reqDTO.ChaveDeAcesso, reqDTO.Token)
+	if !ok {
+		return
+	}
+
+	reserva, err := h.service.ReservarPresentes(r.Context(), acesso, itens)
+	if err != nil {
+		responderErroSelecao(w, r, err, "Falha ao reservar os presentes.")
+		return
+	}
+
+	web.Respond(w, r, ReservaDTO{
+		IDReserva:           reserva.Selecao().ID().String(),
+		ExpiraEm:            reserva.ExpiraEm().Format(time.RFC3339),
+		ValorTotal:          reserva.Selecao().CalcularValorTotal(),
+		PresentesReservados: novosPresentesConfirmadosDTO(reserva.Selecao().PresentesConfirmados()),
+	}, http.StatusCreated)
+}
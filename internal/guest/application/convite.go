@@ -0,0 +1,128 @@
+// file: internal/guest/application/convite.go
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	eventDomain "github.com/luiszkm/wedding_backend/internal/event/domain"
+	"github.com/luiszkm/wedding_backend/internal/guest/domain"
+	"github.com/luiszkm/wedding_backend/internal/platform/auth"
+)
+
+// ConviteService prepara os dados dos convites impressos: o link de acesso de cada grupo,
+// que vira QR code, e o nome, a data e a paleta do evento. Os links levam um token
+// assinado no lugar da chave de acesso, e o serviço também troca esse token pela chave
+// nas rotas públicas.
+type ConviteService struct {
+	repo          domain.GroupRepository
+	eventRepo     eventDomain.EventoRepository
+	tokens        *auth.JWTService
+	urlSite       string        // Endereço do site público, onde fica a página de cada evento
+	validadeToken time.Duration // Prazo dos tokens emitidos nos links
+}
+
+func NewConviteService(repo domain.GroupRepository, eventRepo eventDomain.EventoRepository, tokens *auth.JWTService, urlSite string, validadeToken time.Duration) *ConviteService {
+	return &ConviteService{repo: repo, eventRepo: eventRepo, tokens: tokens, urlSite: urlSite, validadeToken: validadeToken}
+}
+
+// Convite é o grupo com o link aberto pelo QR code e o token embutido nele. Pagina é o
+// endereço do evento sem o token, curto o bastante para ser digitado junto com a chave.
+type Convite struct {
+	Grupo    *domain.GrupoDeConvidados
+	Link     string
+	Pagina   string
+	Token    string
+	ExpiraEm time.Time
+}
+
+// ConvitesEvento reúne os convites de um evento para impressão em lote.
+type ConvitesEvento struct {
+	Evento   *eventDomain.Evento
+	Convites []Convite
+}
+
+// GerarConvite devolve o link de acesso do grupo.
+func (s *ConviteService) GerarConvite(ctx context.Context, userID, groupID uuid.UUID) (*Convite, error) {
+	grupo, err := s.repo.FindByID(ctx, userID, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao buscar grupo: %w", err)
+	}
+	evento, err := s.eventRepo.FindByID(ctx, userID, grupo.IDCasamento())
+	if err != nil {
+		return nil, fmt.Errorf("falha ao buscar evento do grupo: %w", err)
+	}
+	convite, err := s.montarConvite(grupo, evento)
+	if err != nil {
+		return nil, err
+	}
+	return &convite, nil
+}
+
+// GerarConvitesEvento devolve os convites dos grupos do evento, com os mesmos filtros da listagem.
+func (s *ConviteService) GerarConvitesEvento(ctx context.Context, userID, eventID uuid.UUID, filtro domain.FiltroConvidados) (*ConvitesEvento, error) {
+	if err := filtro.Validar(); err != nil {
+		return nil, err
+	}
+	evento, err := s.eventRepo.FindByID(ctx, userID, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao buscar evento: %w", err)
+	}
+	pagina, err := s.repo.FindAllByEventID(ctx, userID, eventID, filtro, domain.PaginacaoGrupos{})
+	if err != nil {
+		return nil, fmt.Errorf("falha ao listar grupos para os convites: %w", err)
+	}
+
+	convites := make([]Convite, len(pagina.Grupos))
+	for i, grupo := range pagina.Grupos {
+		if convites[i], err = s.montarConvite(grupo, evento); err != nil {
+			return nil, err
+		}
+	}
+	return &ConvitesEvento{Evento: evento, Convites: convites}, nil
+}
+
+func (s *ConviteService) montarConvite(grupo *domain.GrupoDeConvidados, evento *eventDomain.Evento) (Convite, error) {
+	token, expiraEm, err := s.tokens.GerarTokenConvidado(grupo.ID(), grupo.IDCasamento(), grupo.ChaveDeAcesso(), s.validadeToken)
+	if err != nil {
+		return Convite{}, fmt.Errorf("falha ao gerar token de acesso do grupo: %w", err)
+	}
+	return Convite{
+		Grupo:    grupo,
+		Link:     domain.LinkDeAcesso(s.urlSite, evento.UrlSlug(), token),
+		Pagina:   domain.PaginaDoEvento(s.urlSite, evento.UrlSlug()),
+		Token:    token,
+		ExpiraEm: expiraEm,
+	}, nil
+}
+
+// ResolverChaveDeAcesso devolve o evento e a chave de acesso com que as rotas públicas
+// seguem. Sem token, valem o evento e a chave informados; com token, valem os do grupo
+// do token, e eventID, se informado, precisa ser o evento do token. Um token que não
+// confere devolve ErrLinkDeAcessoInvalido; um grupo que deixou de existir, ErrGrupoNaoEncontrado.
+func (s *ConviteService) ResolverChaveDeAcesso(ctx context.Context, eventID uuid.UUID, chaveDeAcesso, token string) (uuid.UUID, string, error) {
+	if token == "" {
+		return eventID, chaveDeAcesso, nil
+	}
+	dados, err := s.tokens.ValidarTokenConvidado(token)
+	if err != nil {
+		return uuid.Nil, "", domain.ErrLinkDeAcessoInvalido
+	}
+	if eventID != uuid.Nil && eventID != dados.IDEvento {
+		return uuid.Nil, "", domain.ErrLinkDeAcessoInvalido
+	}
+	chaveAtual, err := s.repo.FindAccessKeyByID(ctx, dados.IDEvento, dados.IDGrupo)
+	if err != nil {
+		if errors.Is(err, domain.ErrGrupoNaoEncontrado) {
+			return uuid.Nil, "", err
+		}
+		return uuid.Nil, "", fmt.Errorf("falha ao conferir token de acesso: %w", err)
+	}
+	if !s.tokens.ConfereChave(dados, chaveAtual) {
+		return uuid.Nil, "", domain.ErrLinkDeAcessoInvalido
+	}
+	return dados.IDEvento, chaveAtual, nil
+}
@@ -3,27 +3,36 @@ package application
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/luiszkm/wedding_backend/internal/guest/domain"
 )
 
 type GuestService struct {
-	repo domain.GroupRepository
+	repo           domain.GroupRepository
+	formularioRepo domain.FormularioRSVPRepository
+	historicoRepo  domain.HistoricoRSVPRepository
+	etiquetaRepo   domain.EtiquetaRepository
+	perfilRepo     domain.PerfilConvidadoRepository
 }
 
-func NewGuestService(repo domain.GroupRepository) *GuestService {
-	return &GuestService{repo: repo}
+func NewGuestService(repo domain.GroupRepository, formularioRepo domain.FormularioRSVPRepository, historicoRepo domain.HistoricoRSVPRepository, etiquetaRepo domain.EtiquetaRepository, perfilRepo domain.PerfilConvidadoRepository) *GuestService {
+	return &GuestService{repo: repo, formularioRepo: formularioRepo, historicoRepo: historicoRepo, etiquetaRepo: etiquetaRepo, perfilRepo: perfilRepo}
 }
 
 // CriarNovoGrupo é um caso de uso da aplicação.
-func (s *GuestService) CriarNovoGrupo(ctx context.Context, idCasamento uuid.UUID, chaveDeAcesso string, nomesDosConvidados []string) (uuid.UUID, error) {
+func (s *GuestService) CriarNovoGrupo(ctx context.Context, idCasamento uuid.UUID, chaveDeAcesso string, convidados []domain.DadosConvidado, limiteAcompanhantes int) (uuid.UUID, error) {
 	// 1. Usa a fábrica do domínio para criar o agregado. A lógica de negócio está protegida.
-	novoGrupo, err := domain.NewGrupoDeConvidados(idCasamento, chaveDeAcesso, nomesDosConvidados)
+	novoGrupo, err := domain.NewGrupoDeConvidadosComDados(idCasamento, chaveDeAcesso, convidados)
 	if err != nil {
 		return uuid.Nil, fmt.Errorf("falha ao criar novo grupo de convidados: %w", err)
 	}
+	if err := novoGrupo.DefinirLimiteAcompanhantes(limiteAcompanhantes); err != nil {
+		return uuid.Nil, err
+	}
 
 	// 2. Usa o repositório para persistir o novo agregado.
 	if err := s.repo.Save(ctx, novoGrupo); err != nil {
@@ -34,6 +43,95 @@ func (s *GuestService) CriarNovoGrupo(ctx context.Context, idCasamento uuid.UUID
 	return novoGrupo.ID(), nil
 }
 
+// tentativasSalvarComChaveGerada cobre a corrida em que outra requisição grava a mesma
+// chave entre a geração e o INSERT.
+const tentativasSalvarComChaveGerada = 3
+
+// CriarNovoGrupoComChaveGerada cria o grupo com uma chave de acesso gerada pelo servidor,
+// diferente de todas as chaves já usadas no evento. Retorna o ID e a chave gerada.
+func (s *GuestService) CriarNovoGrupoComChaveGerada(ctx context.Context, userID, eventID uuid.UUID, config domain.ConfiguracaoChave, convidados []domain.DadosConvidado, limiteAcompanhantes int) (uuid.UUID, string, error) {
+	gerador, err := domain.NewGeradorDeChaves(config)
+	if err != nil {
+		return uuid.Nil, "", err
+	}
+	chavesEmUso, err := s.carregarChavesEmUso(ctx, userID, eventID)
+	if err != nil {
+		return uuid.Nil, "", err
+	}
+
+	for tentativa := 1; ; tentativa++ {
+		chave, err := gerador.GerarUnica(chavesEmUso)
+		if err != nil {
+			return uuid.Nil, "", fmt.Errorf("falha ao gerar chave de acesso: %w", err)
+		}
+		novoGrupo, err := domain.NewGrupoDeConvidadosComDados(eventID, chave, convidados)
+		if err != nil {
+			return uuid.Nil, "", fmt.Errorf("falha ao criar novo grupo de convidados: %w", err)
+		}
+		if err := novoGrupo.DefinirLimiteAcompanhantes(limiteAcompanhantes); err != nil {
+			return uuid.Nil, "", err
+		}
+
+		err = s.repo.Save(ctx, novoGrupo)
+		if err == nil {
+			return novoGrupo.ID(), chave, nil
+		}
+		if !errors.Is(err, domain.ErrChaveDeAcessoEmUso) || tentativa == tentativasSalvarComChaveGerada {
+			return uuid.Nil, "", fmt.Errorf("falha ao salvar novo grupo de convidados: %w", err)
+		}
+	}
+}
+
+// RegenerarChavesDeAcesso troca a chave de todos os grupos do evento por chaves geradas.
+// As chaves antigas deixam de funcionar imediatamente; as novas nunca repetem uma antiga.
+func (s *GuestService) RegenerarChavesDeAcesso(ctx context.Context, userID, eventID uuid.UUID, config domain.ConfiguracaoChave) ([]*domain.GrupoDeConvidados, error) {
+	gerador, err := domain.NewGeradorDeChaves(config)
+	if err != nil {
+		return nil, err
+	}
+	// Carregar as chaves também confirma que o evento pertence ao usuário.
+	chavesEmUso, err := s.carregarChavesEmUso(ctx, userID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	pagina, err := s.repo.FindAllByEventID(ctx, userID, eventID, domain.FiltroConvidados{}, domain.PaginacaoGrupos{})
+	if err != nil {
+		return nil, fmt.Errorf("falha ao listar grupos do evento: %w", err)
+	}
+	grupos := pagina.Grupos
+	if len(grupos) == 0 {
+		return grupos, nil
+	}
+
+	for _, grupo := range grupos {
+		chave, err := gerador.GerarUnica(chavesEmUso)
+		if err != nil {
+			return nil, fmt.Errorf("falha ao gerar chave de acesso: %w", err)
+		}
+		if err := grupo.RedefinirChaveDeAcesso(chave); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repo.UpdateAccessKeys(ctx, userID, eventID, grupos); err != nil {
+		return nil, fmt.Errorf("falha ao salvar novas chaves de acesso: %w", err)
+	}
+	return grupos, nil
+}
+
+func (s *GuestService) carregarChavesEmUso(ctx context.Context, userID, eventID uuid.UUID) (map[string]bool, error) {
+	chaves, err := s.repo.FindAccessKeysByEventID(ctx, userID, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao carregar chaves de acesso do evento: %w", err)
+	}
+	chavesEmUso := make(map[string]bool, len(chaves))
+	for _, chave := range chaves {
+		chavesEmUso[chave] = true
+	}
+	return chavesEmUso, nil
+}
+
 // ObterGrupoPorChaveDeAcesso é o caso de uso para a busca.
 func (s *GuestService) ObterGrupoPorChaveDeAcesso(ctx context.Context, eventID uuid.UUID, accessKey string) (*domain.GrupoDeConvidados, error) {
 	grupo, err := s.repo.FindByAccessKey(ctx, eventID, accessKey)
@@ -44,26 +142,244 @@ func (s *GuestService) ObterGrupoPorChaveDeAcesso(ctx context.Context, eventID u
 	return grupo, nil
 }
 
-func (s *GuestService) ConfirmarPresencaGrupo(ctx context.Context, eventID uuid.UUID, chaveDeAcesso string, respostas []domain.RespostaRSVP) error {
+// ConfirmarPresencaGrupo registra a resposta enviada pelo próprio grupo. Da origem, só
+// IP e UserAgent são considerados; o canal é sempre o da chave de acesso.
+func (s *GuestService) ConfirmarPresencaGrupo(ctx context.Context, eventID uuid.UUID, chaveDeAcesso string, respostas []domain.RespostaRSVP, acompanhantes []string, origem domain.OrigemRSVP) error {
 	// 1. Carregar o agregado pela chave de acesso.
 	grupo, err := s.repo.FindByAccessKey(ctx, eventID, chaveDeAcesso)
 	if err != nil {
 		return fmt.Errorf("falha ao buscar grupo por chave: %w", err)
 	}
 
+	prazoEvento, err := s.repo.FindPrazoRSVPByEventID(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("falha ao consultar prazo de RSVP: %w", err)
+	}
+	if err := grupo.VerificarPrazoRSVP(prazoEvento, time.Now()); err != nil {
+		return err
+	}
+
+	formulario, err := s.formularioRepo.FindByEventID(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("falha ao carregar formulário de RSVP: %w", err)
+	}
+
 	// 2. Executar a lógica de negócio no domínio.
-	if err := grupo.ConfirmarPresenca(respostas); err != nil {
+	if err := grupo.ConfirmarPresencaComFormulario(formulario, respostas, acompanhantes); err != nil {
 		return err // Retorna erros de negócio (status inválido, convidado não pertence, etc.)
 	}
 
 	// 3. Persistir o agregado inteiro com seu novo estado.
-	if err := s.repo.UpdateRSVP(ctx, grupo); err != nil {
+	origem.Canal, origem.IDUsuario = domain.CanalRSVPChaveDeAcesso, nil
+	if err := s.repo.UpdateRSVP(ctx, grupo, origem); err != nil {
 		return fmt.Errorf("falha ao salvar confirmação de presença: %w", err)
 	}
 
 	return nil
 }
-func (s *GuestService) RevisarGrupo(ctx context.Context, userID, groupID uuid.UUID, chaveDeAcesso string, convidadosParaRevisao []domain.ConvidadoParaRevisao) error {
+
+// ConfirmarPresencaPeloAnfitriao registra respostas em nome do grupo. O prazo de RSVP
+// não se aplica ao anfitrião; as demais regras do RSVP continuam valendo.
+func (s *GuestService) ConfirmarPresencaPeloAnfitriao(ctx context.Context, userID, groupID uuid.UUID, respostas []domain.RespostaRSVP, acompanhantes []string, origem domain.OrigemRSVP) error {
+	grupo, err := s.repo.FindByID(ctx, userID, groupID)
+	if err != nil {
+		return fmt.Errorf("falha ao buscar grupo: %w", err)
+	}
+	formulario, err := s.formularioRepo.FindByEventID(ctx, grupo.IDCasamento())
+	if err != nil {
+		return fmt.Errorf("falha ao carregar formulário de RSVP: %w", err)
+	}
+
+	if err := grupo.ConfirmarPresencaComFormulario(formulario, respostas, acompanhantes); err != nil {
+		return err
+	}
+
+	origem.Canal, origem.IDUsuario = domain.CanalRSVPAnfitriao, &userID
+	if err := s.repo.UpdateRSVP(ctx, grupo, origem); err != nil {
+		return fmt.Errorf("falha ao salvar confirmação de presença: %w", err)
+	}
+	return nil
+}
+
+// AcessoConvidado é o que o convidado vê ao abrir o link do grupo.
+type AcessoConvidado struct {
+	Grupo      *domain.GrupoDeConvidados
+	Formulario *domain.FormularioRSVP
+	// PrazoRSVP é o prazo que vale para o grupo, já com a prorrogação; nil sem prazo.
+	PrazoRSVP *time.Time
+	Encerrado bool
+	// Perfil traz os campos do cadastro que o grupo pode corrigir pelo link.
+	Perfil *domain.ConfiguracaoPerfil
+}
+
+// ObterAcessoConvidado reúne o grupo, o formulário e o prazo de RSVP para o link público.
+func (s *GuestService) ObterAcessoConvidado(ctx context.Context, eventID uuid.UUID, accessKey string) (*AcessoConvidado, error) {
+	grupo, err := s.ObterGrupoPorChaveDeAcesso(ctx, eventID, accessKey)
+	if err != nil {
+		return nil, err
+	}
+	prazoEvento, err := s.repo.FindPrazoRSVPByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao consultar prazo de RSVP: %w", err)
+	}
+	perfil, err := s.perfilRepo.FindConfiguracaoByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao carregar configuração de perfil: %w", err)
+	}
+	return s.montarAcessoConvidado(ctx, grupo, prazoEvento, perfil)
+}
+
+func (s *GuestService) montarAcessoConvidado(ctx context.Context, grupo *domain.GrupoDeConvidados, prazoEvento *time.Time, perfil *domain.ConfiguracaoPerfil) (*AcessoConvidado, error) {
+	formulario, err := s.formularioRepo.FindByEventID(ctx, grupo.IDCasamento())
+	if err != nil {
+		return nil, fmt.Errorf("falha ao carregar formulário de RSVP: %w", err)
+	}
+	return &AcessoConvidado{
+		Grupo:      grupo,
+		Formulario: formulario,
+		PrazoRSVP:  grupo.PrazoRSVP(prazoEvento),
+		Encerrado:  grupo.VerificarPrazoRSVP(prazoEvento, time.Now()) != nil,
+		Perfil:     perfil,
+	}, nil
+}
+
+// AtualizarPerfilConvidado aplica as correções enviadas pelo próprio grupo nos campos
+// liberados pelo anfitrião e devolve o acesso atualizado. Nomes e contatos podem ser
+// corrigidos a qualquer momento; a lista de acompanhantes muda a contagem de pessoas
+// e por isso segue o prazo de RSVP.
+func (s *GuestService) AtualizarPerfilConvidado(ctx context.Context, eventID uuid.UUID, chaveDeAcesso string, alteracao domain.AlteracaoPerfil, origem domain.OrigemRSVP) (*AcessoConvidado, error) {
+	grupo, err := s.repo.FindByAccessKey(ctx, eventID, chaveDeAcesso)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao buscar grupo por chave: %w", err)
+	}
+	prazoEvento, err := s.repo.FindPrazoRSVPByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao consultar prazo de RSVP: %w", err)
+	}
+	if alteracao.Acompanhantes != nil {
+		if err := grupo.VerificarPrazoRSVP(prazoEvento, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+	perfil, err := s.perfilRepo.FindConfiguracaoByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao carregar configuração de perfil: %w", err)
+	}
+
+	if err := grupo.AtualizarPerfil(perfil, alteracao); err != nil {
+		return nil, err
+	}
+	if len(grupo.AlteracoesPerfil()) > 0 {
+		origem.Canal, origem.IDUsuario = domain.CanalRSVPChaveDeAcesso, nil
+		if err := s.repo.UpdatePerfil(ctx, grupo, origem); err != nil {
+			return nil, fmt.Errorf("falha ao salvar perfil do grupo: %w", err)
+		}
+	}
+	return s.montarAcessoConvidado(ctx, grupo, prazoEvento, perfil)
+}
+
+// ObterConfiguracaoPerfil retorna os campos que os grupos do evento podem editar.
+func (s *GuestService) ObterConfiguracaoPerfil(ctx context.Context, userID, eventID uuid.UUID) (*domain.ConfiguracaoPerfil, error) {
+	config, err := s.perfilRepo.FindConfiguracaoByEventIDForUser(ctx, userID, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao obter configuração de perfil: %w", err)
+	}
+	return config, nil
+}
+
+// DefinirConfiguracaoPerfil substitui os campos liberados; uma lista vazia desliga a edição.
+func (s *GuestService) DefinirConfiguracaoPerfil(ctx context.Context, userID, eventID uuid.UUID, campos []string) (*domain.ConfiguracaoPerfil, error) {
+	config, err := domain.NewConfiguracaoPerfil(eventID, campos)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.perfilRepo.SaveConfiguracao(ctx, userID, config); err != nil {
+		return nil, fmt.Errorf("falha ao salvar configuração de perfil: %w", err)
+	}
+	return config, nil
+}
+
+// ListarAlteracoesPerfil retorna as alterações feitas pelos grupos do evento, das mais recentes para as mais antigas.
+func (s *GuestService) ListarAlteracoesPerfil(ctx context.Context, userID, eventID uuid.UUID, filtro domain.FiltroAlteracoesPerfil) ([]domain.RegistroAlteracaoPerfil, error) {
+	alteracoes, err := s.perfilRepo.FindAlteracoesByEventID(ctx, userID, eventID, filtro)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao listar alterações de perfil: %w", err)
+	}
+	return alteracoes, nil
+}
+
+func (s *GuestService) MarcarAlteracaoPerfilRevisada(ctx context.Context, userID, alteracaoID uuid.UUID) error {
+	if err := s.perfilRepo.MarcarRevisada(ctx, userID, alteracaoID, time.Now()); err != nil {
+		return fmt.Errorf("falha ao marcar alteração de perfil como revisada: %w", err)
+	}
+	return nil
+}
+
+// EstenderPrazoRSVPGrupo concede ao grupo um prazo próprio; prazo nil remove a prorrogação.
+func (s *GuestService) EstenderPrazoRSVPGrupo(ctx context.Context, userID, groupID uuid.UUID, prazo *time.Time) (*domain.GrupoDeConvidados, error) {
+	grupo, err := s.repo.FindByID(ctx, userID, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao buscar grupo: %w", err)
+	}
+	grupo.EstenderPrazoRSVP(prazo)
+	if err := s.repo.UpdatePrazoRSVP(ctx, userID, grupo); err != nil {
+		return nil, fmt.Errorf("falha ao salvar prazo de RSVP do grupo: %w", err)
+	}
+	return grupo, nil
+}
+
+// RelatorioAtrasosRSVP lista quem não respondeu no prazo e quem respondeu depois dele.
+type RelatorioAtrasosRSVP struct {
+	PrazoRSVP *time.Time
+	GeradoEm  time.Time
+	Atrasos   []domain.AtrasoRSVP
+}
+
+func (s *GuestService) ListarRSVPsAtrasados(ctx context.Context, userID, eventID uuid.UUID) (*RelatorioAtrasosRSVP, error) {
+	pagina, err := s.repo.FindAllByEventID(ctx, userID, eventID, domain.FiltroConvidados{}, domain.PaginacaoGrupos{})
+	if err != nil {
+		return nil, fmt.Errorf("falha ao listar grupos do evento: %w", err)
+	}
+	grupos := pagina.Grupos
+	prazoEvento, err := s.repo.FindPrazoRSVPByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao consultar prazo de RSVP: %w", err)
+	}
+
+	agora := time.Now()
+	return &RelatorioAtrasosRSVP{
+		PrazoRSVP: prazoEvento,
+		GeradoEm:  agora,
+		Atrasos:   domain.ClassificarAtrasos(grupos, prazoEvento, agora),
+	}, nil
+}
+
+// ObterFormularioRSVP devolve o formulário do evento para o anfitrião.
+func (s *GuestService) ObterFormularioRSVP(ctx context.Context, userID, eventID uuid.UUID) (*domain.FormularioRSVP, error) {
+	formulario, err := s.formularioRepo.FindByEventIDForUser(ctx, userID, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao carregar formulário de RSVP: %w", err)
+	}
+	return formulario, nil
+}
+
+// DefinirFormularioRSVP substitui as perguntas do formulário do evento.
+func (s *GuestService) DefinirFormularioRSVP(ctx context.Context, userID, eventID uuid.UUID, perguntas []domain.DadosPergunta) (*domain.FormularioRSVP, error) {
+	formulario, err := s.formularioRepo.FindByEventIDForUser(ctx, userID, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao carregar formulário de RSVP: %w", err)
+	}
+	if err := formulario.Redefinir(perguntas); err != nil {
+		return nil, err
+	}
+	if err := s.formularioRepo.Save(ctx, userID, formulario); err != nil {
+		return nil, fmt.Errorf("falha ao salvar formulário de RSVP: %w", err)
+	}
+	return formulario, nil
+}
+
+// limiteAcompanhantes nil mantém o limite atual do grupo.
+func (s *GuestService) RevisarGrupo(ctx context.Context, userID, groupID uuid.UUID, chaveDeAcesso string, convidadosParaRevisao []domain.ConvidadoParaRevisao, limiteAcompanhantes *int) error {
 	// 1. Carrega o agregado, já com a verificação de propriedade no repositório.
 	grupo, err := s.repo.FindByID(ctx, userID, groupID)
 	if err != nil {
@@ -74,6 +390,11 @@ func (s *GuestService) RevisarGrupo(ctx context.Context, userID, groupID uuid.UU
 	if err := grupo.Revisar(chaveDeAcesso, convidadosParaRevisao); err != nil {
 		return err
 	}
+	if limiteAcompanhantes != nil {
+		if err := grupo.DefinirLimiteAcompanhantes(*limiteAcompanhantes); err != nil {
+			return err
+		}
+	}
 
 	// 3. Persiste as alterações, também com verificação de propriedade.
 	if err := s.repo.Update(ctx, userID, grupo); err != nil {
@@ -82,13 +403,19 @@ func (s *GuestService) RevisarGrupo(ctx context.Context, userID, groupID uuid.UU
 	return nil
 }
 
-// ListarGruposPorEvento retorna todos os grupos de um evento
-func (s *GuestService) ListarGruposPorEvento(ctx context.Context, userID, eventID uuid.UUID, statusFilter string) ([]*domain.GrupoDeConvidados, error) {
-	grupos, err := s.repo.FindAllByEventID(ctx, userID, eventID, statusFilter)
+// ListarGruposPorEvento retorna uma página dos grupos de um evento com os convidados que atendem ao filtro
+func (s *GuestService) ListarGruposPorEvento(ctx context.Context, userID, eventID uuid.UUID, filtro domain.FiltroConvidados, paginacao domain.PaginacaoGrupos) (*domain.PaginaGrupos, error) {
+	if err := filtro.Validar(); err != nil {
+		return nil, err
+	}
+	if err := paginacao.Validar(); err != nil {
+		return nil, err
+	}
+	pagina, err := s.repo.FindAllByEventID(ctx, userID, eventID, filtro, paginacao)
 	if err != nil {
 		return nil, fmt.Errorf("falha ao listar grupos por evento: %w", err)
 	}
-	return grupos, nil
+	return pagina, nil
 }
 
 // ObterGrupoPorID retorna um grupo específico (admin)
@@ -121,11 +448,271 @@ func (s *GuestService) RemoverGrupo(ctx context.Context, userID, groupID uuid.UU
 	return nil
 }
 
-// ObterEstatisticasRSVP retorna estatísticas de RSVP para um evento
-func (s *GuestService) ObterEstatisticasRSVP(ctx context.Context, userID, eventID uuid.UUID) (*domain.RSVPStats, error) {
-	stats, err := s.repo.GetRSVPStats(ctx, userID, eventID)
+// RemoverConvidado retira o convidado do grupo guardando o motivo; ele sai das listas e
+// estatísticas, mas pode ser restaurado com RestaurarConvidado.
+func (s *GuestService) RemoverConvidado(ctx context.Context, userID, groupID, convidadoID uuid.UUID, motivo string, origem domain.OrigemRSVP) (*domain.GrupoDeConvidados, error) {
+	grupo, err := s.repo.FindByID(ctx, userID, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao buscar grupo: %w", err)
+	}
+	if err := grupo.RemoverConvidado(convidadoID, motivo, userID); err != nil {
+		return nil, err
+	}
+
+	origem.Canal, origem.IDUsuario = domain.CanalRSVPAnfitriao, &userID
+	if err := s.repo.UpdateRemocoes(ctx, userID, grupo, origem); err != nil {
+		return nil, fmt.Errorf("falha ao salvar remoção do convidado: %w", err)
+	}
+	return grupo, nil
+}
+
+// RestaurarConvidado devolve ao grupo um convidado removido, com o RSVP que ele tinha.
+func (s *GuestService) RestaurarConvidado(ctx context.Context, userID, groupID, convidadoID uuid.UUID, origem domain.OrigemRSVP) (*domain.GrupoDeConvidados, error) {
+	grupo, err := s.repo.FindByID(ctx, userID, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao buscar grupo: %w", err)
+	}
+	if err := grupo.RestaurarConvidado(convidadoID); err != nil {
+		return nil, err
+	}
+
+	origem.Canal, origem.IDUsuario = domain.CanalRSVPAnfitriao, &userID
+	if err := s.repo.UpdateRemocoes(ctx, userID, grupo, origem); err != nil {
+		return nil, fmt.Errorf("falha ao salvar restauração do convidado: %w", err)
+	}
+	return grupo, nil
+}
+
+// JuntarGrupos passa para o grupo tudo o que é do grupo absorvido, que é removido. O grupo
+// mantém a própria chave de acesso; a chave do absorvido deixa de funcionar.
+func (s *GuestService) JuntarGrupos(ctx context.Context, userID, groupID, absorvidoID uuid.UUID) (*domain.GrupoDeConvidados, error) {
+	grupo, err := s.repo.FindByID(ctx, userID, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao buscar grupo para junção: %w", err)
+	}
+	absorvido, err := s.repo.FindByID(ctx, userID, absorvidoID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao buscar grupo absorvido: %w", err)
+	}
+
+	if err := grupo.Absorver(absorvido); err != nil {
+		return nil, err
+	}
+	if err := s.repo.MergeGroups(ctx, userID, grupo, absorvidoID); err != nil {
+		return nil, fmt.Errorf("falha ao salvar junção de grupos: %w", err)
+	}
+	return grupo, nil
+}
+
+// DividirGrupo cria um grupo novo com os convidados e acompanhantes indicados. Sem
+// chaveDeAcesso, o grupo novo recebe uma chave gerada com a configuração padrão.
+// Retorna o grupo original, já sem os que saíram, e o grupo novo.
+func (s *GuestService) DividirGrupo(ctx context.Context, userID, groupID uuid.UUID, divisao domain.DivisaoGrupo, chaveDeAcesso string) (*domain.GrupoDeConvidados, *domain.GrupoDeConvidados, error) {
+	var gerador *domain.GeradorDeChaves
+	var chavesEmUso map[string]bool
+	if chaveDeAcesso == "" {
+		var err error
+		if gerador, err = domain.NewGeradorDeChaves(domain.ConfiguracaoChavePadrao()); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for tentativa := 1; ; tentativa++ {
+		// O agregado é recarregado a cada tentativa, porque Dividir já o alterou.
+		grupo, err := s.repo.FindByID(ctx, userID, groupID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("falha ao buscar grupo para divisão: %w", err)
+		}
+		chave := chaveDeAcesso
+		if gerador != nil {
+			if chavesEmUso == nil {
+				if chavesEmUso, err = s.carregarChavesEmUso(ctx, userID, grupo.IDCasamento()); err != nil {
+					return nil, nil, err
+				}
+			}
+			if chave, err = gerador.GerarUnica(chavesEmUso); err != nil {
+				return nil, nil, fmt.Errorf("falha ao gerar chave de acesso: %w", err)
+			}
+		}
+
+		novo, err := grupo.Dividir(divisao, chave)
+		if err != nil {
+			return nil, nil, err
+		}
+		err = s.repo.SplitGroup(ctx, userID, grupo, novo)
+		if err == nil {
+			return grupo, novo, nil
+		}
+		if gerador == nil || !errors.Is(err, domain.ErrChaveDeAcessoEmUso) || tentativa == tentativasSalvarComChaveGerada {
+			return nil, nil, fmt.Errorf("falha ao salvar divisão do grupo: %w", err)
+		}
+	}
+}
+
+// ObterEstatisticasRSVP retorna estatísticas de RSVP para um evento, opcionalmente
+// restritas aos grupos com ao menos uma das etiquetas.
+func (s *GuestService) ObterEstatisticasRSVP(ctx context.Context, userID, eventID uuid.UUID, etiquetas []uuid.UUID) (*domain.RSVPStats, error) {
+	stats, err := s.repo.GetRSVPStats(ctx, userID, eventID, etiquetas)
 	if err != nil {
 		return nil, fmt.Errorf("falha ao obter estatísticas RSVP: %w", err)
 	}
 	return stats, nil
 }
+
+// ObterRelatorioBuffet retorna a contagem de confirmados por faixa etária, prato e restrição
+// alimentar, opcionalmente restrita aos grupos com ao menos uma das etiquetas.
+func (s *GuestService) ObterRelatorioBuffet(ctx context.Context, userID, eventID uuid.UUID, etiquetas []uuid.UUID) (*domain.RelatorioBuffet, error) {
+	relatorio, err := s.repo.GetRelatorioBuffet(ctx, userID, eventID, etiquetas)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao obter relatório do buffet: %w", err)
+	}
+	return relatorio, nil
+}
+
+// ImportarGrupos analisa as linhas da planilha e, fora do modo dry-run, grava todos os grupos
+// em uma única transação. Qualquer problema no plano impede a gravação, para que a
+// importação seja tudo ou nada; o plano é sempre devolvido para compor o relatório.
+func (s *GuestService) ImportarGrupos(ctx context.Context, userID, eventID uuid.UUID, linhas []domain.LinhaImportacao, dryRun bool) (*domain.PlanoImportacao, error) {
+	chavesExistentes, err := s.repo.FindAccessKeysByEventID(ctx, userID, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao carregar chaves de acesso do evento: %w", err)
+	}
+
+	plano, err := domain.PlanejarImportacao(eventID, linhas, chavesExistentes)
+	if err != nil {
+		return nil, err
+	}
+	if dryRun {
+		return plano, nil
+	}
+	if plano.TemProblemas() {
+		return plano, domain.ErrImportacaoComProblemas
+	}
+
+	if err := s.repo.SaveAll(ctx, plano.Grupos); err != nil {
+		return plano, fmt.Errorf("falha ao salvar grupos importados: %w", err)
+	}
+	return plano, nil
+}
+
+// ExportacaoConvidados reúne os dados usados na exportação da lista de convidados.
+type ExportacaoConvidados struct {
+	Grupos       []*domain.GrupoDeConvidados
+	Estatisticas *domain.RSVPStats
+	Filtro       domain.FiltroConvidados
+	GeradoEm     time.Time
+}
+
+// ExportarConvidados carrega os grupos (com os mesmos filtros da listagem) e o resumo de RSVP do evento.
+func (s *GuestService) ExportarConvidados(ctx context.Context, userID, eventID uuid.UUID, filtro domain.FiltroConvidados) (*ExportacaoConvidados, error) {
+	if err := filtro.Validar(); err != nil {
+		return nil, err
+	}
+
+	pagina, err := s.repo.FindAllByEventID(ctx, userID, eventID, filtro, domain.PaginacaoGrupos{})
+	if err != nil {
+		return nil, fmt.Errorf("falha ao listar grupos para exportação: %w", err)
+	}
+	grupos := pagina.Grupos
+	stats, err := s.repo.GetRSVPStats(ctx, userID, eventID, filtro.Etiquetas)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao obter estatísticas para exportação: %w", err)
+	}
+
+	return &ExportacaoConvidados{
+		Grupos:       grupos,
+		Estatisticas: stats,
+		Filtro:       filtro,
+		GeradoEm:     time.Now(),
+	}, nil
+}
+
+func (s *GuestService) ListarHistoricoRSVPGrupo(ctx context.Context, userID, groupID uuid.UUID, filtro domain.FiltroHistoricoRSVP) ([]domain.RegistroRSVP, error) {
+	registros, err := s.historicoRepo.FindByGroupID(ctx, userID, groupID, filtro)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao buscar histórico de RSVP do grupo: %w", err)
+	}
+	return registros, nil
+}
+
+func (s *GuestService) ListarHistoricoRSVPEvento(ctx context.Context, userID, eventID uuid.UUID, filtro domain.FiltroHistoricoRSVP) ([]domain.RegistroRSVP, error) {
+	registros, err := s.historicoRepo.FindByEventID(ctx, userID, eventID, filtro)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao buscar histórico de RSVP do evento: %w", err)
+	}
+	return registros, nil
+}
+
+func (s *GuestService) ListarEtiquetas(ctx context.Context, userID, eventID uuid.UUID) ([]*domain.Etiqueta, error) {
+	etiquetas, err := s.etiquetaRepo.FindAllByEventID(ctx, userID, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao listar etiquetas: %w", err)
+	}
+	return etiquetas, nil
+}
+
+func (s *GuestService) CriarEtiqueta(ctx context.Context, userID, eventID uuid.UUID, nome string) (*domain.Etiqueta, error) {
+	etiqueta, err := domain.NewEtiqueta(eventID, nome)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.etiquetaRepo.Save(ctx, userID, etiqueta); err != nil {
+		return nil, fmt.Errorf("falha ao salvar etiqueta: %w", err)
+	}
+	return etiqueta, nil
+}
+
+func (s *GuestService) RenomearEtiqueta(ctx context.Context, userID, etiquetaID uuid.UUID, nome string) (*domain.Etiqueta, error) {
+	etiqueta, err := s.etiquetaRepo.FindByID(ctx, userID, etiquetaID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao buscar etiqueta: %w", err)
+	}
+	if err := etiqueta.Renomear(nome); err != nil {
+		return nil, err
+	}
+	if err := s.etiquetaRepo.Update(ctx, userID, etiqueta); err != nil {
+		return nil, fmt.Errorf("falha ao salvar etiqueta: %w", err)
+	}
+	return etiqueta, nil
+}
+
+// RemoverEtiqueta apaga a etiqueta do catálogo e de todos os grupos que a usavam.
+func (s *GuestService) RemoverEtiqueta(ctx context.Context, userID, etiquetaID uuid.UUID) error {
+	if err := s.etiquetaRepo.Delete(ctx, userID, etiquetaID); err != nil {
+		return fmt.Errorf("falha ao remover etiqueta: %w", err)
+	}
+	return nil
+}
+
+// DefinirEtiquetasGrupo substitui as etiquetas do grupo pelas informadas, que devem
+// existir no catálogo do evento do grupo. Uma lista vazia remove todas.
+func (s *GuestService) DefinirEtiquetasGrupo(ctx context.Context, userID, groupID uuid.UUID, idsEtiquetas []uuid.UUID) (*domain.GrupoDeConvidados, error) {
+	grupo, err := s.repo.FindByID(ctx, userID, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao buscar grupo: %w", err)
+	}
+	catalogo, err := s.etiquetaRepo.FindAllByEventID(ctx, userID, grupo.IDCasamento())
+	if err != nil {
+		return nil, fmt.Errorf("falha ao carregar etiquetas do evento: %w", err)
+	}
+	porID := make(map[uuid.UUID]*domain.Etiqueta, len(catalogo))
+	for _, e := range catalogo {
+		porID[e.ID()] = e
+	}
+
+	etiquetas := make([]*domain.Etiqueta, len(idsEtiquetas))
+	for i, id := range idsEtiquetas {
+		e, ok := porID[id]
+		if !ok {
+			return nil, domain.ErrEtiquetaNaoEncontrada
+		}
+		etiquetas[i] = e
+	}
+	if err := grupo.DefinirEtiquetas(etiquetas); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateEtiquetas(ctx, userID, grupo); err != nil {
+		return nil, fmt.Errorf("falha ao salvar etiquetas do grupo: %w", err)
+	}
+	return grupo, nil
+}
@@ -0,0 +1,162 @@
+// file: internal/guest/domain/chave_acesso.go
+package domain
+
+import (
+	"crypto/rand"
+	"errors"
+	"io"
+	"math/big"
+	"strings"
+)
+
+// Modos de geração de chave de acesso.
+const (
+	ModoChavePalavras     = "PALAVRAS"
+	ModoChaveAlfanumerica = "ALFANUMERICA"
+)
+
+// Limites e padrões do gerador. Tamanho é o número de palavras no modo PALAVRAS
+// e o número de caracteres no modo ALFANUMERICA.
+const (
+	TamanhoPadraoChavePalavras     = 4
+	TamanhoMinimoChavePalavras     = 3
+	TamanhoMaximoChavePalavras     = 8
+	TamanhoPadraoChaveAlfanumerica = 10
+	TamanhoMinimoChaveAlfanumerica = 8
+	TamanhoMaximoChaveAlfanumerica = 32
+	tentativasGeracaoChave         = 20
+)
+
+var (
+	ErrModoChaveInvalido      = errors.New("modo de geração de chave inválido")
+	ErrTamanhoChaveInvalido   = errors.New("tamanho de chave fora dos limites permitidos")
+	ErrFalhaAoGerarChaveUnica = errors.New("não foi possível gerar uma chave de acesso única")
+)
+
+// alfabetoChave omite caracteres fáceis de confundir ao digitar (0/o, 1/l/i).
+const alfabetoChave = "23456789abcdefghjkmnpqrstuvwxyz"
+
+// palavrasChave são palavras curtas, sem acento e fáceis de ditar por telefone.
+var palavrasChave = []string{
+	"abacaxi", "abelha", "acerola", "agulha", "alecrim", "alface", "alvorada", "amora",
+	"anel", "anzol", "arara", "areia", "arroz", "aurora", "avela", "azeite",
+	"bambu", "banana", "barco", "baleia", "bigode", "biscoito", "bolacha", "boneca",
+	"brisa", "bule", "buzina", "cabana", "cacau", "cadeira", "caderno", "cafe",
+	"caju", "caneca", "caneta", "canela", "canoa", "carambola", "carneiro", "castelo",
+	"cavalo", "cebola", "cenoura", "cereja", "chuva", "cidade", "cinema", "coelho",
+	"colina", "cometa", "coruja", "cravo", "dado", "damasco", "dente", "domingo",
+	"doce", "dragao", "duna", "esquilo", "estrela", "farol", "fazenda", "feijao",
+	"figo", "flauta", "floresta", "fogueira", "formiga", "framboesa", "funil", "fuso",
+	"gaivota", "galho", "garfo", "gato", "girafa", "girassol", "goiaba", "golfinho",
+	"gravata", "grilo", "guarana", "harpa", "hortela", "ilha", "ipe", "jabuti",
+	"jaca", "janela", "jardim", "jasmim", "jiboia", "joaninha", "jujuba", "lago",
+	"lagoa", "lanterna", "lapis", "laranja", "leao", "leque", "limao", "lontra",
+	"lua", "luva", "macaco", "madeira", "manga", "maracuja", "mar", "marimba",
+	"martelo", "melancia", "mel", "mesa", "milho", "mochila", "montanha", "morango",
+	"musgo", "navio", "neblina", "ninho", "noz", "nuvem", "oceano", "oliva",
+	"onda", "orvalho", "ostra", "ouro", "ovelha", "palmeira", "panela", "papagaio",
+	"pardal", "pato", "pavao", "pedra", "peixe", "pena", "pera", "perola",
+	"peteca", "piano", "pimenta", "pinguim", "pipa", "pitanga", "planeta", "pomar",
+	"ponte", "porto", "praia", "prato", "quati", "queijo", "quiabo", "raposa",
+	"regador", "relogio", "rio", "rede", "rosa", "sabia", "sabonete", "sapato",
+	"sapo", "seriema", "serra", "sino", "sol", "tamboril", "tangerina", "tapete",
+	"tatu", "teclado", "telhado", "tigre", "tijolo", "tomate", "trem", "trevo",
+	"trigo", "tucano", "tulipa", "uva", "vagem", "vale", "vela", "veleiro",
+	"vento", "violao", "violeta", "vulcao", "xale", "xicara", "zabumba", "zebra",
+}
+
+// ConfiguracaoChave define como as chaves de acesso são geradas.
+type ConfiguracaoChave struct {
+	Modo    string
+	Tamanho int
+}
+
+// ConfiguracaoChavePadrao gera chaves com quatro palavras, como "farol-pera-tucano-brisa".
+func ConfiguracaoChavePadrao() ConfiguracaoChave {
+	return ConfiguracaoChave{Modo: ModoChavePalavras, Tamanho: TamanhoPadraoChavePalavras}
+}
+
+// Normalizar preenche os valores omitidos e valida os limites do modo escolhido.
+func (c ConfiguracaoChave) Normalizar() (ConfiguracaoChave, error) {
+	if c.Modo == "" {
+		c.Modo = ModoChavePalavras
+	}
+	c.Modo = strings.ToUpper(c.Modo)
+
+	var minimo, maximo, padrao int
+	switch c.Modo {
+	case ModoChavePalavras:
+		minimo, maximo, padrao = TamanhoMinimoChavePalavras, TamanhoMaximoChavePalavras, TamanhoPadraoChavePalavras
+	case ModoChaveAlfanumerica:
+		minimo, maximo, padrao = TamanhoMinimoChaveAlfanumerica, TamanhoMaximoChaveAlfanumerica, TamanhoPadraoChaveAlfanumerica
+	default:
+		return c, ErrModoChaveInvalido
+	}
+	if c.Tamanho == 0 {
+		c.Tamanho = padrao
+	}
+	if c.Tamanho < minimo || c.Tamanho > maximo {
+		return c, ErrTamanhoChaveInvalido
+	}
+	return c, nil
+}
+
+// GeradorDeChaves produz chaves de acesso aleatórias e difíceis de adivinhar,
+// evitando as chaves já usadas no evento.
+type GeradorDeChaves struct {
+	config ConfiguracaoChave
+	fonte  io.Reader
+}
+
+// NewGeradorDeChaves cria um gerador com entropia de crypto/rand.
+func NewGeradorDeChaves(config ConfiguracaoChave) (*GeradorDeChaves, error) {
+	return newGeradorDeChavesComFonte(config, rand.Reader)
+}
+
+func newGeradorDeChavesComFonte(config ConfiguracaoChave, fonte io.Reader) (*GeradorDeChaves, error) {
+	normalizada, err := config.Normalizar()
+	if err != nil {
+		return nil, err
+	}
+	return &GeradorDeChaves{config: normalizada, fonte: fonte}, nil
+}
+
+// GerarUnica devolve uma chave que não está em chavesEmUso e a registra nele,
+// para que chamadas seguidas no mesmo lote também não colidam entre si.
+func (g *GeradorDeChaves) GerarUnica(chavesEmUso map[string]bool) (string, error) {
+	for i := 0; i < tentativasGeracaoChave; i++ {
+		chave, err := g.gerar()
+		if err != nil {
+			return "", err
+		}
+		if !chavesEmUso[chave] {
+			chavesEmUso[chave] = true
+			return chave, nil
+		}
+	}
+	return "", ErrFalhaAoGerarChaveUnica
+}
+
+func (g *GeradorDeChaves) gerar() (string, error) {
+	if g.config.Modo == ModoChavePalavras {
+		palavras := make([]string, g.config.Tamanho)
+		for i := range palavras {
+			indice, err := rand.Int(g.fonte, big.NewInt(int64(len(palavrasChave))))
+			if err != nil {
+				return "", err
+			}
+			palavras[i] = palavrasChave[indice.Int64()]
+		}
+		return strings.Join(palavras, "-"), nil
+	}
+
+	var chave strings.Builder
+	for i := 0; i < g.config.Tamanho; i++ {
+		indice, err := rand.Int(g.fonte, big.NewInt(int64(len(alfabetoChave))))
+		if err != nil {
+			return "", err
+		}
+		chave.WriteByte(alfabetoChave[indice.Int64()])
+	}
+	return chave.String(), nil
+}
@@ -0,0 +1,116 @@
+// file: internal/guest/domain/chave_acesso_test.go
+package domain
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfiguracaoChave_Normalizar(t *testing.T) {
+	t.Run("deve aplicar o modo e o tamanho padrão quando omitidos", func(t *testing.T) {
+		config, err := ConfiguracaoChave{}.Normalizar()
+
+		assert.NoError(t, err)
+		assert.Equal(t, ConfiguracaoChavePadrao(), config)
+	})
+
+	t.Run("deve aceitar o modo em minúsculas", func(t *testing.T) {
+		config, err := ConfiguracaoChave{Modo: "alfanumerica"}.Normalizar()
+
+		assert.NoError(t, err)
+		assert.Equal(t, ModoChaveAlfanumerica, config.Modo)
+		assert.Equal(t, TamanhoPadraoChaveAlfanumerica, config.Tamanho)
+	})
+
+	t.Run("deve recusar modo desconhecido", func(t *testing.T) {
+		_, err := ConfiguracaoChave{Modo: "EMOJI"}.Normalizar()
+
+		assert.ErrorIs(t, err, ErrModoChaveInvalido)
+	})
+
+	t.Run("deve recusar tamanhos fora dos limites do modo", func(t *testing.T) {
+		_, err := ConfiguracaoChave{Modo: ModoChavePalavras, Tamanho: TamanhoMinimoChavePalavras - 1}.Normalizar()
+		assert.ErrorIs(t, err, ErrTamanhoChaveInvalido)
+
+		_, err = ConfiguracaoChave{Modo: ModoChaveAlfanumerica, Tamanho: TamanhoMaximoChaveAlfanumerica + 1}.Normalizar()
+		assert.ErrorIs(t, err, ErrTamanhoChaveInvalido)
+	})
+}
+
+func TestGeradorDeChaves(t *testing.T) {
+	t.Run("deve gerar chave com o número de palavras pedido", func(t *testing.T) {
+		gerador, err := NewGeradorDeChaves(ConfiguracaoChave{Modo: ModoChavePalavras, Tamanho: 5})
+		assert.NoError(t, err)
+
+		chave, err := gerador.GerarUnica(map[string]bool{})
+
+		assert.NoError(t, err)
+		assert.Len(t, strings.Split(chave, "-"), 5)
+	})
+
+	t.Run("deve gerar chave alfanumérica sem caracteres ambíguos", func(t *testing.T) {
+		gerador, err := NewGeradorDeChaves(ConfiguracaoChave{Modo: ModoChaveAlfanumerica, Tamanho: 12})
+		assert.NoError(t, err)
+
+		chave, err := gerador.GerarUnica(map[string]bool{})
+
+		assert.NoError(t, err)
+		assert.Len(t, chave, 12)
+		assert.NotContains(t, chave, "0")
+		assert.NotContains(t, chave, "1")
+		assert.NotContains(t, chave, "l")
+		assert.NotContains(t, chave, "o")
+		for _, c := range chave {
+			assert.Contains(t, alfabetoChave, string(c))
+		}
+	})
+
+	t.Run("deve evitar chaves em uso e registrar as novas no mapa", func(t *testing.T) {
+		gerador, err := NewGeradorDeChaves(ConfiguracaoChave{Modo: ModoChavePalavras, Tamanho: 3})
+		assert.NoError(t, err)
+		emUso := map[string]bool{"familia": true}
+
+		geradas := make(map[string]bool)
+		for i := 0; i < 200; i++ {
+			chave, err := gerador.GerarUnica(emUso)
+			assert.NoError(t, err)
+			assert.False(t, geradas[chave], "chave repetida no lote: %s", chave)
+			geradas[chave] = true
+		}
+		assert.Len(t, emUso, 201)
+	})
+
+	t.Run("deve desistir quando toda chave gerada já está em uso", func(t *testing.T) {
+		// Uma fonte de entropia constante gera sempre a mesma chave.
+		fonte := bytes.NewReader(bytes.Repeat([]byte{0}, 4096))
+		gerador, err := newGeradorDeChavesComFonte(ConfiguracaoChave{Modo: ModoChaveAlfanumerica, Tamanho: 8}, fonte)
+		assert.NoError(t, err)
+		emUso := map[string]bool{"22222222": true}
+
+		_, err = gerador.GerarUnica(emUso)
+
+		assert.ErrorIs(t, err, ErrFalhaAoGerarChaveUnica)
+	})
+}
+
+func TestGrupoDeConvidados_RedefinirChaveDeAcesso(t *testing.T) {
+	grupo, _ := NewGrupoDeConvidados(uuid.New(), "familia", []string{"Convidado 1"})
+
+	t.Run("deve trocar a chave do grupo", func(t *testing.T) {
+		err := grupo.RedefinirChaveDeAcesso("farol-pera-tucano-brisa")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "farol-pera-tucano-brisa", grupo.ChaveDeAcesso())
+	})
+
+	t.Run("deve recusar chave vazia", func(t *testing.T) {
+		err := grupo.RedefinirChaveDeAcesso("")
+
+		assert.ErrorIs(t, err, ErrChaveDeAcessoObrigatoria)
+		assert.Equal(t, "farol-pera-tucano-brisa", grupo.ChaveDeAcesso())
+	})
+}
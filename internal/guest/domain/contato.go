@@ -0,0 +1,183 @@
+// file: internal/guest/domain/contato.go
+package domain
+
+import (
+	"errors"
+	"net/mail"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Faixas etárias do convidado. Vazio significa não informado.
+const (
+	FaixaEtariaAdulto  = "ADULTO"
+	FaixaEtariaCrianca = "CRIANCA"
+)
+
+// Lados dos noivos a que o convidado pertence. Vazio significa não informado.
+const (
+	LadoNoiva = "NOIVA"
+	LadoNoivo = "NOIVO"
+)
+
+const (
+	tamanhoMaximoObservacoes = 2000
+	tamanhoMaximoBusca       = 100
+)
+
+var (
+	ErrNomeConvidadoInvalido  = errors.New("o nome do convidado é obrigatório e deve ter até 255 caracteres")
+	ErrTelefoneInvalido       = errors.New("telefone inválido: use o formato internacional E.164, como +5511999990000")
+	ErrEmailInvalido          = errors.New("e-mail inválido")
+	ErrFaixaEtariaInvalida    = errors.New("faixa etária inválida: use ADULTO ou CRIANCA")
+	ErrLadoInvalido           = errors.New("lado inválido: use NOIVA ou NOIVO")
+	ErrObservacoesMuitoLongas = errors.New("as observações devem ter até 2000 caracteres")
+	ErrBuscaInvalida          = errors.New("a busca deve ter até 100 caracteres")
+)
+
+// formatoE164 aceita o "+" seguido de 8 a 15 dígitos, sem zero no código do país.
+var formatoE164 = regexp.MustCompile(`^\+[1-9][0-9]{7,14}$`)
+
+var separadoresTelefone = strings.NewReplacer(" ", "", "-", "", "(", "", ")", "", ".", "", "/", "")
+
+// normalizarTelefone remove a formatação e converte para E.164. Números sem código
+// de país com 10 ou 11 dígitos (DDD + número) são tratados como brasileiros.
+func normalizarTelefone(telefone string) (string, error) {
+	telefone = separadoresTelefone.Replace(strings.TrimSpace(telefone))
+	if telefone == "" {
+		return "", nil
+	}
+	if strings.HasPrefix(telefone, "00") {
+		telefone = "+" + telefone[2:]
+	}
+	if !strings.HasPrefix(telefone, "+") && (len(telefone) == 10 || len(telefone) == 11) {
+		telefone = "+55" + telefone
+	}
+	if !formatoE164.MatchString(telefone) {
+		return "", ErrTelefoneInvalido
+	}
+	return telefone, nil
+}
+
+func normalizarEmail(email string) (string, error) {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return "", nil
+	}
+	endereco, err := mail.ParseAddress(email)
+	if err != nil || endereco.Address != email || utf8.RuneCountInString(email) > tamanhoMaximoCampoTexto {
+		return "", ErrEmailInvalido
+	}
+	return email, nil
+}
+
+var removedorAcentos = strings.NewReplacer("Ç", "C", "Á", "A", "Ã", "A")
+
+func normalizarFaixaEtaria(faixa string) (string, error) {
+	faixa = removedorAcentos.Replace(strings.ToUpper(strings.TrimSpace(faixa)))
+	switch faixa {
+	case "", FaixaEtariaAdulto, FaixaEtariaCrianca:
+		return faixa, nil
+	}
+	return "", ErrFaixaEtariaInvalida
+}
+
+func normalizarLado(lado string) (string, error) {
+	lado = strings.ToUpper(strings.TrimSpace(lado))
+	switch lado {
+	case "", LadoNoiva, LadoNoivo:
+		return lado, nil
+	}
+	return "", ErrLadoInvalido
+}
+
+// normalizarNome junta os espaços repetidos; o nome é válido se não ficar vazio nem longo demais.
+func normalizarNome(nome string) (string, bool) {
+	nome = strings.Join(strings.Fields(nome), " ")
+	return nome, nome != "" && utf8.RuneCountInString(nome) <= tamanhoMaximoCampoTexto
+}
+
+// normalizar valida os dados do convidado e devolve a forma que é gravada.
+func (d DadosConvidado) normalizar() (DadosConvidado, error) {
+	var err error
+	var valido bool
+	if d.Nome, valido = normalizarNome(d.Nome); !valido {
+		return d, ErrNomeConvidadoInvalido
+	}
+	if d.Telefone, err = normalizarTelefone(d.Telefone); err != nil {
+		return d, err
+	}
+	if d.Email, err = normalizarEmail(d.Email); err != nil {
+		return d, err
+	}
+	if d.FaixaEtaria, err = normalizarFaixaEtaria(d.FaixaEtaria); err != nil {
+		return d, err
+	}
+	if d.Lado, err = normalizarLado(d.Lado); err != nil {
+		return d, err
+	}
+	d.Observacoes = strings.TrimSpace(d.Observacoes)
+	if utf8.RuneCountInString(d.Observacoes) > tamanhoMaximoObservacoes {
+		return d, ErrObservacoesMuitoLongas
+	}
+	return d, nil
+}
+
+// Dados devolve os dados cadastrais atuais do convidado.
+func (c *Convidado) Dados() DadosConvidado {
+	return DadosConvidado{
+		Nome:        c.nome,
+		Telefone:    c.telefone,
+		Email:       c.email,
+		FaixaEtaria: c.faixaEtaria,
+		Lado:        c.lado,
+		Observacoes: c.observacoes,
+	}
+}
+
+func (c *Convidado) aplicarDados(d DadosConvidado) {
+	c.nome = d.Nome
+	c.telefone = d.Telefone
+	c.email = d.Email
+	c.faixaEtaria = d.FaixaEtaria
+	c.lado = d.Lado
+	c.observacoes = d.Observacoes
+}
+
+// FiltroConvidados restringe a listagem aos convidados que atendem a todos os
+// critérios informados. Campos vazios ou nil não filtram.
+type FiltroConvidados struct {
+	Status      string
+	FaixaEtaria string
+	Lado        string
+	ComTelefone *bool
+	ComEmail    *bool
+	// Etiquetas restringe aos grupos com ao menos uma das etiquetas.
+	Etiquetas []uuid.UUID
+	// Busca restringe aos grupos cuja chave de acesso ou o nome de algum convidado contém o
+	// texto, sem diferenciar maiúsculas de minúsculas. Os convidados do grupo não são filtrados.
+	Busca string
+}
+
+// Validar normaliza os valores do filtro e recusa os desconhecidos.
+func (f *FiltroConvidados) Validar() error {
+	switch f.Status = strings.ToUpper(strings.TrimSpace(f.Status)); f.Status {
+	case "", StatusRSVPConfirmado, StatusRSVPRecusado, StatusRSVPPendente:
+	default:
+		return ErrStatusRSVPInvalido
+	}
+	var err error
+	if f.FaixaEtaria, err = normalizarFaixaEtaria(f.FaixaEtaria); err != nil {
+		return err
+	}
+	if f.Lado, err = normalizarLado(f.Lado); err != nil {
+		return err
+	}
+	if f.Busca = strings.TrimSpace(f.Busca); utf8.RuneCountInString(f.Busca) > tamanhoMaximoBusca {
+		return ErrBuscaInvalida
+	}
+	return nil
+}
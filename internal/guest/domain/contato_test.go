@@ -0,0 +1,139 @@
+// file: internal/guest/domain/contato_test.go
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGrupoDeConvidadosComDados(t *testing.T) {
+	t.Run("deve normalizar telefone, faixa etária e lado", func(t *testing.T) {
+		grupo, err := NewGrupoDeConvidadosComDados(uuid.New(), "familia", []DadosConvidado{{
+			Nome:        "  João   Silva ",
+			Telefone:    "(11) 99999-0000",
+			Email:       "joao@exemplo.com",
+			FaixaEtaria: "criança",
+			Lado:        "noiva",
+			Observacoes: " alérgico a camarão ",
+		}})
+
+		assert.NoError(t, err)
+		c := grupo.Convidados()[0]
+		assert.Equal(t, "João Silva", c.Nome())
+		assert.Equal(t, "+5511999990000", c.Telefone())
+		assert.Equal(t, "joao@exemplo.com", c.Email())
+		assert.Equal(t, FaixaEtariaCrianca, c.FaixaEtaria())
+		assert.Equal(t, LadoNoiva, c.Lado())
+		assert.Equal(t, "alérgico a camarão", c.Observacoes())
+	})
+
+	t.Run("deve aceitar telefone internacional com 00 ou +", func(t *testing.T) {
+		grupo, err := NewGrupoDeConvidadosComDados(uuid.New(), "familia", []DadosConvidado{
+			{Nome: "Ana", Telefone: "0033 6 12 34 56 78"},
+			{Nome: "Bia", Telefone: "+1 415-555-0100"},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "+33612345678", grupo.Convidados()[0].Telefone())
+		assert.Equal(t, "+14155550100", grupo.Convidados()[1].Telefone())
+	})
+
+	casosInvalidos := []struct {
+		descricao string
+		dados     DadosConvidado
+		esperado  error
+	}{
+		{"nome vazio", DadosConvidado{Nome: "   "}, ErrNomeConvidadoInvalido},
+		{"telefone curto", DadosConvidado{Nome: "Ana", Telefone: "9999-0000"}, ErrTelefoneInvalido},
+		{"telefone com letras", DadosConvidado{Nome: "Ana", Telefone: "+55 11 ABCD-0000"}, ErrTelefoneInvalido},
+		{"e-mail sem domínio", DadosConvidado{Nome: "Ana", Email: "ana@"}, ErrEmailInvalido},
+		{"e-mail com nome de exibição", DadosConvidado{Nome: "Ana", Email: "Ana <ana@exemplo.com>"}, ErrEmailInvalido},
+		{"faixa etária desconhecida", DadosConvidado{Nome: "Ana", FaixaEtaria: "IDOSO"}, ErrFaixaEtariaInvalida},
+		{"lado desconhecido", DadosConvidado{Nome: "Ana", Lado: "AMBOS"}, ErrLadoInvalido},
+		{"observações longas", DadosConvidado{Nome: "Ana", Observacoes: strings.Repeat("a", 2001)}, ErrObservacoesMuitoLongas},
+	}
+	for _, caso := range casosInvalidos {
+		t.Run("deve recusar "+caso.descricao, func(t *testing.T) {
+			grupo, err := NewGrupoDeConvidadosComDados(uuid.New(), "familia", []DadosConvidado{caso.dados})
+
+			assert.Nil(t, grupo)
+			assert.Equal(t, caso.esperado, err)
+		})
+	}
+}
+
+func TestGrupoDeConvidados_RevisarDadosDeContato(t *testing.T) {
+	texto := func(s string) *string { return &s }
+
+	novoGrupo := func(t *testing.T) *GrupoDeConvidados {
+		grupo, err := NewGrupoDeConvidadosComDados(uuid.New(), "familia", []DadosConvidado{
+			{Nome: "João", Telefone: "+5511999990000", Email: "joao@exemplo.com", Lado: LadoNoivo},
+		})
+		assert.NoError(t, err)
+		return grupo
+	}
+
+	t.Run("deve manter os campos omitidos e alterar os informados", func(t *testing.T) {
+		grupo := novoGrupo(t)
+		joao := grupo.Convidados()[0]
+
+		err := grupo.Revisar("familia", []ConvidadoParaRevisao{
+			{ID: joao.ID(), Nome: "João", Email: texto(""), FaixaEtaria: texto("adulto")},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "+5511999990000", joao.Telefone())
+		assert.Empty(t, joao.Email())
+		assert.Equal(t, FaixaEtariaAdulto, joao.FaixaEtaria())
+		assert.Equal(t, LadoNoivo, joao.Lado())
+	})
+
+	t.Run("não deve alterar nenhum convidado se um dado for inválido", func(t *testing.T) {
+		grupo := novoGrupo(t)
+		joao := grupo.Convidados()[0]
+
+		err := grupo.Revisar("familia-nova", []ConvidadoParaRevisao{
+			{ID: joao.ID(), Nome: "João Pedro", Telefone: texto("+5521988887777")},
+			{Nome: "Maria", Email: texto("maria@")},
+		})
+
+		assert.Equal(t, ErrEmailInvalido, err)
+		assert.Equal(t, "João", joao.Nome())
+		assert.Equal(t, "+5511999990000", joao.Telefone())
+		assert.Equal(t, "familia", grupo.ChaveDeAcesso())
+		assert.Len(t, grupo.Convidados(), 1)
+	})
+
+	t.Run("não deve revalidar telefone gravado quando ele não é informado", func(t *testing.T) {
+		legado := HydrateConvidado(uuid.New(), StatusRSVPPendente, DadosConvidado{Nome: "Ana", Telefone: "ramal 12"}, nil)
+		grupo := &GrupoDeConvidados{id: uuid.New(), chaveDeAcesso: "familia", convidados: []*Convidado{legado}}
+
+		err := grupo.Revisar("familia", []ConvidadoParaRevisao{{ID: legado.ID(), Nome: "Ana Maria"}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Ana Maria", legado.Nome())
+		assert.Equal(t, "ramal 12", legado.Telefone())
+	})
+}
+
+func TestFiltroConvidados_Validar(t *testing.T) {
+	t.Run("deve normalizar os valores aceitos", func(t *testing.T) {
+		filtro := FiltroConvidados{Status: "confirmado", FaixaEtaria: "Criança", Lado: "noivo", Busca: "  silva "}
+
+		assert.NoError(t, filtro.Validar())
+		assert.Equal(t, StatusRSVPConfirmado, filtro.Status)
+		assert.Equal(t, FaixaEtariaCrianca, filtro.FaixaEtaria)
+		assert.Equal(t, LadoNoivo, filtro.Lado)
+		assert.Equal(t, "silva", filtro.Busca)
+	})
+
+	t.Run("deve recusar valores desconhecidos", func(t *testing.T) {
+		assert.Equal(t, ErrStatusRSVPInvalido, (&FiltroConvidados{Status: "TALVEZ"}).Validar())
+		assert.Equal(t, ErrFaixaEtariaInvalida, (&FiltroConvidados{FaixaEtaria: "BEBE"}).Validar())
+		assert.Equal(t, ErrLadoInvalido, (&FiltroConvidados{Lado: "AMBOS"}).Validar())
+		assert.Equal(t, ErrBuscaInvalida, (&FiltroConvidados{Busca: strings.Repeat("a", 101)}).Validar())
+	})
+}
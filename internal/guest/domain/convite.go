@@ -0,0 +1,24 @@
+// file: internal/guest/domain/convite.go
+package domain
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// ErrLinkDeAcessoInvalido indica token de convite adulterado, expirado, de outro evento
+// ou emitido para uma chave de acesso que já foi trocada.
+var ErrLinkDeAcessoInvalido = errors.New("o link de acesso é inválido ou expirou")
+
+// PaginaDoEvento é o endereço da página pública do evento no site informado.
+func PaginaDoEvento(urlSite, slugEvento string) string {
+	return strings.TrimRight(urlSite, "/") + "/" + url.PathEscape(slugEvento)
+}
+
+// LinkDeAcesso monta o endereço que o convite leva ao convidado: a página pública do
+// evento com o token de acesso do grupo na query "token". O token evita que a chave de
+// acesso fique no histórico do navegador e nos logs.
+func LinkDeAcesso(urlSite, slugEvento, token string) string {
+	return PaginaDoEvento(urlSite, slugEvento) + "?" + url.Values{"token": {token}}.Encode()
+}
@@ -0,0 +1,24 @@
+// file: internal/guest/domain/convite_test.go
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaginaDoEvento(t *testing.T) {
+	t.Run("deve juntar site e slug sem barra dupla", func(t *testing.T) {
+		assert.Equal(t, "https://casamento.exemplo.com/ana-e-pedro", PaginaDoEvento("https://casamento.exemplo.com/", "ana-e-pedro"))
+	})
+}
+
+func TestLinkDeAcesso(t *testing.T) {
+	t.Run("deve juntar site, slug e token", func(t *testing.T) {
+		assert.Equal(t, "https://casamento.exemplo.com/ana-e-pedro?token=eyJhbGciOiJIUzI1NiJ9.e30.assinatura", LinkDeAcesso("https://casamento.exemplo.com/", "ana-e-pedro", "eyJhbGciOiJIUzI1NiJ9.e30.assinatura"))
+	})
+
+	t.Run("deve escapar caracteres especiais do slug e do token", func(t *testing.T) {
+		assert.Equal(t, "https://exemplo.com/festa%20junina?token=a%2Bb%3D%26c", LinkDeAcesso("https://exemplo.com", "festa junina", "a+b=&c"))
+	})
+}
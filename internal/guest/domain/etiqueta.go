@@ -0,0 +1,97 @@
+// file: internal/guest/domain/etiqueta.go
+package domain
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const tamanhoMaximoNomeEtiqueta = 50
+
+var (
+	ErrNomeEtiquetaInvalido  = errors.New("o nome da etiqueta é obrigatório e deve ter até 50 caracteres")
+	ErrEtiquetaNaoEncontrada = errors.New("etiqueta não encontrada")
+	ErrEtiquetaJaExiste      = errors.New("já existe uma etiqueta com este nome no evento")
+)
+
+// Etiqueta rotula grupos de convidados do evento ("padrinhos", "trabalho") para
+// filtrar, exportar e comparar estatísticas por segmento.
+type Etiqueta struct {
+	id       uuid.UUID
+	idEvento uuid.UUID
+	nome     string
+}
+
+func NewEtiqueta(idEvento uuid.UUID, nome string) (*Etiqueta, error) {
+	e := &Etiqueta{id: uuid.New(), idEvento: idEvento}
+	if err := e.Renomear(nome); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func HydrateEtiqueta(id, idEvento uuid.UUID, nome string) *Etiqueta {
+	return &Etiqueta{id: id, idEvento: idEvento, nome: nome}
+}
+
+// Renomear troca o nome da etiqueta; os grupos etiquetados continuam associados.
+func (e *Etiqueta) Renomear(nome string) error {
+	nome = strings.Join(strings.Fields(nome), " ")
+	if nome == "" || utf8.RuneCountInString(nome) > tamanhoMaximoNomeEtiqueta {
+		return ErrNomeEtiquetaInvalido
+	}
+	e.nome = nome
+	return nil
+}
+
+func (e *Etiqueta) ID() uuid.UUID       { return e.id }
+func (e *Etiqueta) IDEvento() uuid.UUID { return e.idEvento }
+func (e *Etiqueta) Nome() string        { return e.nome }
+
+// DefinirEtiquetas substitui as etiquetas do grupo. Todas devem ser do evento do grupo;
+// repetições são ignoradas.
+func (g *GrupoDeConvidados) DefinirEtiquetas(etiquetas []*Etiqueta) error {
+	vistas := make(map[uuid.UUID]bool, len(etiquetas))
+	definidas := make([]*Etiqueta, 0, len(etiquetas))
+	for _, e := range etiquetas {
+		if e.IDEvento() != g.idCasamento {
+			return ErrEtiquetaNaoEncontrada
+		}
+		if vistas[e.ID()] {
+			continue
+		}
+		vistas[e.ID()] = true
+		definidas = append(definidas, e)
+	}
+	g.etiquetas = definidas
+	return nil
+}
+
+func (g *GrupoDeConvidados) Etiquetas() []*Etiqueta { return g.etiquetas }
+
+// EstatisticaEtiqueta resume o RSVP dos grupos com a etiqueta. Um grupo com várias
+// etiquetas entra na contagem de cada uma.
+type EstatisticaEtiqueta struct {
+	IDEtiqueta               uuid.UUID
+	Nome                     string
+	TotalGrupos              int
+	TotalConvidados          int
+	ConvidadosConfirmados    int
+	ConvidadosRecusados      int
+	ConvidadosPendentes      int
+	AcompanhantesConfirmados int
+}
+
+type EtiquetaRepository interface {
+	// Save devolve ErrEventoNaoEncontrado se o evento não pertencer ao usuário.
+	Save(ctx context.Context, userID uuid.UUID, etiqueta *Etiqueta) error
+	Update(ctx context.Context, userID uuid.UUID, etiqueta *Etiqueta) error
+	// Delete remove também a etiqueta de todos os grupos.
+	Delete(ctx context.Context, userID, etiquetaID uuid.UUID) error
+	FindByID(ctx context.Context, userID, etiquetaID uuid.UUID) (*Etiqueta, error)
+	FindAllByEventID(ctx context.Context, userID, eventID uuid.UUID) ([]*Etiqueta, error)
+}
@@ -0,0 +1,64 @@
+// file: internal/guest/domain/etiqueta_test.go
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEtiqueta(t *testing.T) {
+	t.Run("deve criar etiqueta com o nome sem espaços extras", func(t *testing.T) {
+		etiqueta, err := NewEtiqueta(uuid.New(), "  família   da noiva ")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "família da noiva", etiqueta.Nome())
+	})
+
+	t.Run("deve recusar nome vazio ou longo demais", func(t *testing.T) {
+		_, err := NewEtiqueta(uuid.New(), "   ")
+		assert.Equal(t, ErrNomeEtiquetaInvalido, err)
+
+		_, err = NewEtiqueta(uuid.New(), strings.Repeat("a", 51))
+		assert.Equal(t, ErrNomeEtiquetaInvalido, err)
+	})
+
+	t.Run("deve manter o nome atual quando a renomeação é inválida", func(t *testing.T) {
+		etiqueta, err := NewEtiqueta(uuid.New(), "padrinhos")
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrNomeEtiquetaInvalido, etiqueta.Renomear(""))
+		assert.Equal(t, "padrinhos", etiqueta.Nome())
+	})
+}
+
+func TestGrupoDeConvidados_DefinirEtiquetas(t *testing.T) {
+	idEvento := uuid.New()
+	padrinhos := HydrateEtiqueta(uuid.New(), idEvento, "padrinhos")
+	trabalho := HydrateEtiqueta(uuid.New(), idEvento, "trabalho")
+
+	t.Run("deve substituir as etiquetas ignorando repetições", func(t *testing.T) {
+		grupo, err := NewGrupoDeConvidados(idEvento, "familia", []string{"João"})
+		assert.NoError(t, err)
+
+		assert.NoError(t, grupo.DefinirEtiquetas([]*Etiqueta{padrinhos, trabalho, padrinhos}))
+		assert.Equal(t, []*Etiqueta{padrinhos, trabalho}, grupo.Etiquetas())
+
+		assert.NoError(t, grupo.DefinirEtiquetas(nil))
+		assert.Empty(t, grupo.Etiquetas())
+	})
+
+	t.Run("deve recusar etiqueta de outro evento", func(t *testing.T) {
+		grupo, err := NewGrupoDeConvidados(idEvento, "familia", []string{"João"})
+		assert.NoError(t, err)
+		assert.NoError(t, grupo.DefinirEtiquetas([]*Etiqueta{padrinhos}))
+
+		outroEvento := HydrateEtiqueta(uuid.New(), uuid.New(), "amigos")
+		err = grupo.DefinirEtiquetas([]*Etiqueta{trabalho, outroEvento})
+
+		assert.Equal(t, ErrEtiquetaNaoEncontrada, err)
+		assert.Equal(t, []*Etiqueta{padrinhos}, grupo.Etiquetas())
+	})
+}
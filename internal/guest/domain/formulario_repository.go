@@ -0,0 +1,18 @@
+// file: internal/guest/domain/formulario_repository.go
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type FormularioRSVPRepository interface {
+	// FindByEventID é usado no RSVP público e devolve um formulário vazio se o evento não tiver perguntas.
+	FindByEventID(ctx context.Context, eventID uuid.UUID) (*FormularioRSVP, error)
+	// FindByEventIDForUser verifica a propriedade do evento antes de carregar o formulário.
+	FindByEventIDForUser(ctx context.Context, userID, eventID uuid.UUID) (*FormularioRSVP, error)
+	// Save grava as perguntas em uma transação. Respostas de perguntas removidas ou que
+	// mudaram de tipo são apagadas, assim como opções que deixaram de existir.
+	Save(ctx context.Context, userID uuid.UUID, formulario *FormularioRSVP) error
+}
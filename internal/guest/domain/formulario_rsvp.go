@@ -0,0 +1,314 @@
+// file: internal/guest/domain/formulario_rsvp.go
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Tipos de pergunta do formulário de RSVP.
+const (
+	TipoPerguntaTexto           = "TEXTO"
+	TipoPerguntaEscolhaUnica    = "ESCOLHA_UNICA"
+	TipoPerguntaEscolhaMultipla = "ESCOLHA_MULTIPLA"
+	TipoPerguntaNumero          = "NUMERO"
+)
+
+// Finalidades de pergunta usadas pelo relatório do buffet. Cada formulário tem no máximo
+// uma pergunta de cada finalidade; as demais perguntas não têm finalidade.
+const (
+	FinalidadePerguntaPrato              = "PRATO"
+	FinalidadePerguntaRestricaoAlimentar = "RESTRICAO_ALIMENTAR"
+)
+
+// MaximoPerguntasPorFormulario limita o tamanho do formulário exibido aos convidados.
+const MaximoPerguntasPorFormulario = 30
+
+const (
+	maximoOpcoesPorPergunta    = 30
+	tamanhoMaximoTextoPergunta = 500
+	tamanhoMaximoRespostaTexto = 1000
+)
+
+var (
+	ErrFormularioMuitoGrande      = errors.New("o formulário excede o limite de perguntas")
+	ErrTextoPerguntaInvalido      = errors.New("o texto da pergunta é obrigatório e deve ter até 500 caracteres")
+	ErrTipoPerguntaInvalido       = errors.New("tipo de pergunta inválido")
+	ErrOpcoesPerguntaInvalidas    = errors.New("perguntas de escolha exigem de 2 a 30 opções distintas e não vazias")
+	ErrFaixaPerguntaInvalida      = errors.New("o mínimo da pergunta numérica não pode ser maior que o máximo")
+	ErrPerguntaNaoEncontrada      = errors.New("uma ou mais perguntas não pertencem ao formulário do evento")
+	ErrPerguntaRepetida           = errors.New("a mesma pergunta foi respondida mais de uma vez para o convidado")
+	ErrRespostaInvalida           = errors.New("resposta inválida")
+	ErrRespostaObrigatoriaAusente = errors.New("pergunta obrigatória sem resposta")
+	ErrFinalidadePerguntaInvalida = errors.New("finalidade de pergunta inválida: o prato exige escolha única e as restrições alimentares, escolha ou texto")
+	ErrFinalidadeRepetida         = errors.New("o formulário só pode ter uma pergunta de cada finalidade")
+)
+
+// PerguntaRSVP é uma pergunta do formulário que o evento apresenta a cada convidado
+// confirmado, como a escolha do prato ou as restrições alimentares.
+type PerguntaRSVP struct {
+	id          uuid.UUID
+	texto       string
+	tipo        string
+	opcoes      []string
+	obrigatoria bool
+	minimo      *int
+	maximo      *int
+	finalidade  string
+}
+
+// FormularioRSVP reúne as perguntas de um evento, na ordem em que são exibidas.
+// Um evento sem perguntas tem um formulário vazio.
+type FormularioRSVP struct {
+	idEvento  uuid.UUID
+	perguntas []*PerguntaRSVP
+}
+
+// DadosPergunta são os dados de entrada para criar ou alterar uma pergunta.
+type DadosPergunta struct {
+	ID          uuid.UUID // uuid.Nil para uma pergunta nova
+	Texto       string
+	Tipo        string
+	Opcoes      []string
+	Obrigatoria bool
+	Minimo      *int
+	Maximo      *int
+	Finalidade  string // "" quando a pergunta não alimenta o relatório do buffet
+}
+
+// RespostaPergunta é a resposta de um convidado a uma pergunta. Textos e números
+// ocupam um único valor; perguntas de escolha trazem as opções marcadas.
+type RespostaPergunta struct {
+	IDPergunta uuid.UUID
+	Valores    []string
+}
+
+func NewFormularioRSVP(idEvento uuid.UUID) *FormularioRSVP {
+	return &FormularioRSVP{idEvento: idEvento}
+}
+
+func HydrateFormularioRSVP(idEvento uuid.UUID, perguntas []*PerguntaRSVP) *FormularioRSVP {
+	return &FormularioRSVP{idEvento: idEvento, perguntas: perguntas}
+}
+
+func HydratePerguntaRSVP(id uuid.UUID, texto, tipo string, opcoes []string, obrigatoria bool, minimo, maximo *int, finalidade string) *PerguntaRSVP {
+	return &PerguntaRSVP{id: id, texto: texto, tipo: tipo, opcoes: opcoes, obrigatoria: obrigatoria, minimo: minimo, maximo: maximo, finalidade: finalidade}
+}
+
+// Redefinir substitui as perguntas do formulário. Perguntas com ID são alterações de
+// perguntas existentes e mantêm as respostas já dadas; as omitidas são removidas.
+func (f *FormularioRSVP) Redefinir(dados []DadosPergunta) error {
+	if len(dados) > MaximoPerguntasPorFormulario {
+		return ErrFormularioMuitoGrande
+	}
+
+	existentes := make(map[uuid.UUID]bool, len(f.perguntas))
+	for _, p := range f.perguntas {
+		existentes[p.id] = true
+	}
+
+	novas := make([]*PerguntaRSVP, 0, len(dados))
+	vistas := make(map[uuid.UUID]bool, len(dados))
+	finalidades := make(map[string]bool)
+	for _, d := range dados {
+		id := d.ID
+		if id == uuid.Nil {
+			id = uuid.New()
+		} else if !existentes[id] || vistas[id] {
+			return ErrPerguntaNaoEncontrada
+		}
+		vistas[id] = true
+
+		pergunta, err := novaPergunta(id, d)
+		if err != nil {
+			return err
+		}
+		if pergunta.finalidade != "" {
+			if finalidades[pergunta.finalidade] {
+				return ErrFinalidadeRepetida
+			}
+			finalidades[pergunta.finalidade] = true
+		}
+		novas = append(novas, pergunta)
+	}
+
+	f.perguntas = novas
+	return nil
+}
+
+func novaPergunta(id uuid.UUID, d DadosPergunta) (*PerguntaRSVP, error) {
+	texto := strings.TrimSpace(d.Texto)
+	if texto == "" || utf8.RuneCountInString(texto) > tamanhoMaximoTextoPergunta {
+		return nil, ErrTextoPerguntaInvalido
+	}
+
+	pergunta := &PerguntaRSVP{id: id, texto: texto, tipo: d.Tipo, obrigatoria: d.Obrigatoria, opcoes: []string{}}
+	switch d.Tipo {
+	case TipoPerguntaTexto:
+	case TipoPerguntaNumero:
+		if d.Minimo != nil && d.Maximo != nil && *d.Minimo > *d.Maximo {
+			return nil, ErrFaixaPerguntaInvalida
+		}
+		pergunta.minimo, pergunta.maximo = d.Minimo, d.Maximo
+	case TipoPerguntaEscolhaUnica, TipoPerguntaEscolhaMultipla:
+		if len(d.Opcoes) < 2 || len(d.Opcoes) > maximoOpcoesPorPergunta {
+			return nil, ErrOpcoesPerguntaInvalidas
+		}
+		vistas := make(map[string]bool, len(d.Opcoes))
+		for _, opcao := range d.Opcoes {
+			opcao = strings.TrimSpace(opcao)
+			if opcao == "" || utf8.RuneCountInString(opcao) > tamanhoMaximoCampoTexto || vistas[opcao] {
+				return nil, ErrOpcoesPerguntaInvalidas
+			}
+			vistas[opcao] = true
+			pergunta.opcoes = append(pergunta.opcoes, opcao)
+		}
+	default:
+		return nil, ErrTipoPerguntaInvalido
+	}
+
+	switch d.Finalidade {
+	case "":
+	case FinalidadePerguntaPrato:
+		if d.Tipo != TipoPerguntaEscolhaUnica {
+			return nil, ErrFinalidadePerguntaInvalida
+		}
+	case FinalidadePerguntaRestricaoAlimentar:
+		if d.Tipo == TipoPerguntaNumero {
+			return nil, ErrFinalidadePerguntaInvalida
+		}
+	default:
+		return nil, ErrFinalidadePerguntaInvalida
+	}
+	pergunta.finalidade = d.Finalidade
+	return pergunta, nil
+}
+
+// pergunta devolve a pergunta do formulário com o ID informado, ou nil.
+func (f *FormularioRSVP) pergunta(id uuid.UUID) *PerguntaRSVP {
+	if f == nil {
+		return nil
+	}
+	for _, p := range f.perguntas {
+		if p.id == id {
+			return p
+		}
+	}
+	return nil
+}
+
+// normalizarRespostas valida as respostas de um convidado e descarta as vazias,
+// que equivalem a não responder.
+func (f *FormularioRSVP) normalizarRespostas(respostas []RespostaPergunta) ([]RespostaPergunta, error) {
+	normalizadas := make([]RespostaPergunta, 0, len(respostas))
+	vistas := make(map[uuid.UUID]bool, len(respostas))
+	for _, resposta := range respostas {
+		pergunta := f.pergunta(resposta.IDPergunta)
+		if pergunta == nil {
+			return nil, ErrPerguntaNaoEncontrada
+		}
+		if vistas[resposta.IDPergunta] {
+			return nil, ErrPerguntaRepetida
+		}
+		vistas[resposta.IDPergunta] = true
+
+		valores, err := pergunta.normalizarValores(resposta.Valores)
+		if err != nil {
+			return nil, err
+		}
+		if len(valores) > 0 {
+			normalizadas = append(normalizadas, RespostaPergunta{IDPergunta: pergunta.id, Valores: valores})
+		}
+	}
+	return normalizadas, nil
+}
+
+// validarObrigatorias confirma que todas as perguntas obrigatórias têm resposta.
+func (f *FormularioRSVP) validarObrigatorias(respostas []RespostaPergunta) error {
+	if f == nil {
+		return nil
+	}
+	respondidas := make(map[uuid.UUID]bool, len(respostas))
+	for _, r := range respostas {
+		respondidas[r.IDPergunta] = true
+	}
+	for _, p := range f.perguntas {
+		if p.obrigatoria && !respondidas[p.id] {
+			return fmt.Errorf("%w: %s", ErrRespostaObrigatoriaAusente, p.texto)
+		}
+	}
+	return nil
+}
+
+func (p *PerguntaRSVP) normalizarValores(valores []string) ([]string, error) {
+	limpos := make([]string, 0, len(valores))
+	for _, v := range valores {
+		if v = strings.TrimSpace(v); v != "" {
+			limpos = append(limpos, v)
+		}
+	}
+	if len(limpos) == 0 {
+		return nil, nil
+	}
+
+	invalida := fmt.Errorf("%w para a pergunta: %s", ErrRespostaInvalida, p.texto)
+	switch p.tipo {
+	case TipoPerguntaTexto:
+		if len(limpos) > 1 || utf8.RuneCountInString(limpos[0]) > tamanhoMaximoRespostaTexto {
+			return nil, invalida
+		}
+	case TipoPerguntaNumero:
+		if len(limpos) > 1 {
+			return nil, invalida
+		}
+		numero, err := strconv.Atoi(limpos[0])
+		if err != nil || (p.minimo != nil && numero < *p.minimo) || (p.maximo != nil && numero > *p.maximo) {
+			return nil, invalida
+		}
+		limpos[0] = strconv.Itoa(numero)
+	case TipoPerguntaEscolhaUnica, TipoPerguntaEscolhaMultipla:
+		if p.tipo == TipoPerguntaEscolhaUnica && len(limpos) > 1 {
+			return nil, invalida
+		}
+		marcadas := make(map[string]bool, len(limpos))
+		for _, v := range limpos {
+			if marcadas[v] || !p.temOpcao(v) {
+				return nil, invalida
+			}
+			marcadas[v] = true
+		}
+		// Mantém a ordem das opções do formulário.
+		limpos = limpos[:0]
+		for _, opcao := range p.opcoes {
+			if marcadas[opcao] {
+				limpos = append(limpos, opcao)
+			}
+		}
+	}
+	return limpos, nil
+}
+
+func (p *PerguntaRSVP) temOpcao(valor string) bool {
+	for _, opcao := range p.opcoes {
+		if opcao == valor {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *FormularioRSVP) IDEvento() uuid.UUID        { return f.idEvento }
+func (f *FormularioRSVP) Perguntas() []*PerguntaRSVP { return f.perguntas }
+func (p *PerguntaRSVP) ID() uuid.UUID                { return p.id }
+func (p *PerguntaRSVP) Texto() string                { return p.texto }
+func (p *PerguntaRSVP) Tipo() string                 { return p.tipo }
+func (p *PerguntaRSVP) Opcoes() []string             { return p.opcoes }
+func (p *PerguntaRSVP) Obrigatoria() bool            { return p.obrigatoria }
+func (p *PerguntaRSVP) Minimo() *int                 { return p.minimo }
+func (p *PerguntaRSVP) Maximo() *int                 { return p.maximo }
+func (p *PerguntaRSVP) Finalidade() string           { return p.finalidade }
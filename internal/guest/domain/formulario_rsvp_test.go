@@ -0,0 +1,202 @@
+// file: internal/guest/domain/formulario_rsvp_test.go
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func novoFormularioDeTeste(t *testing.T) *FormularioRSVP {
+	formulario := NewFormularioRSVP(uuid.New())
+	um, dez := 1, 10
+	err := formulario.Redefinir([]DadosPergunta{
+		{Texto: "Escolha do prato", Tipo: TipoPerguntaEscolhaUnica, Opcoes: []string{"Carne", "Peixe", "Vegetariano"}, Obrigatoria: true},
+		{Texto: "Restrições alimentares", Tipo: TipoPerguntaEscolhaMultipla, Opcoes: []string{"Glúten", "Lactose", "Amendoim"}},
+		{Texto: "Alguma observação?", Tipo: TipoPerguntaTexto},
+		{Texto: "Quantas noites de hotel?", Tipo: TipoPerguntaNumero, Minimo: &um, Maximo: &dez},
+	})
+	assert.NoError(t, err)
+	return formulario
+}
+
+func TestFormularioRSVP_Redefinir(t *testing.T) {
+	t.Run("deve criar as perguntas com IDs novos e opções limpas", func(t *testing.T) {
+		formulario := NewFormularioRSVP(uuid.New())
+
+		err := formulario.Redefinir([]DadosPergunta{
+			{Texto: " Qual ônibus? ", Tipo: TipoPerguntaEscolhaUnica, Opcoes: []string{" 18h ", "19h"}},
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, formulario.Perguntas(), 1)
+		assert.NotEqual(t, uuid.Nil, formulario.Perguntas()[0].ID())
+		assert.Equal(t, "Qual ônibus?", formulario.Perguntas()[0].Texto())
+		assert.Equal(t, []string{"18h", "19h"}, formulario.Perguntas()[0].Opcoes())
+	})
+
+	t.Run("deve manter o ID das perguntas alteradas e remover as omitidas", func(t *testing.T) {
+		formulario := novoFormularioDeTeste(t)
+		prato := formulario.Perguntas()[0]
+
+		err := formulario.Redefinir([]DadosPergunta{
+			{ID: prato.ID(), Texto: "Prato principal", Tipo: TipoPerguntaEscolhaUnica, Opcoes: []string{"Carne", "Peixe"}},
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, formulario.Perguntas(), 1)
+		assert.Equal(t, prato.ID(), formulario.Perguntas()[0].ID())
+		assert.Equal(t, "Prato principal", formulario.Perguntas()[0].Texto())
+	})
+
+	t.Run("deve recusar perguntas inválidas", func(t *testing.T) {
+		dois, um := 2, 1
+		casos := []struct {
+			nome     string
+			pergunta DadosPergunta
+			erro     error
+		}{
+			{"texto vazio", DadosPergunta{Texto: "  ", Tipo: TipoPerguntaTexto}, ErrTextoPerguntaInvalido},
+			{"tipo desconhecido", DadosPergunta{Texto: "Cor favorita", Tipo: "DATA"}, ErrTipoPerguntaInvalido},
+			{"escolha com uma opção", DadosPergunta{Texto: "Prato", Tipo: TipoPerguntaEscolhaUnica, Opcoes: []string{"Carne"}}, ErrOpcoesPerguntaInvalidas},
+			{"opções repetidas", DadosPergunta{Texto: "Prato", Tipo: TipoPerguntaEscolhaMultipla, Opcoes: []string{"Carne", "Carne "}}, ErrOpcoesPerguntaInvalidas},
+			{"mínimo maior que o máximo", DadosPergunta{Texto: "Noites", Tipo: TipoPerguntaNumero, Minimo: &dois, Maximo: &um}, ErrFaixaPerguntaInvalida},
+			{"ID de outro formulário", DadosPergunta{ID: uuid.New(), Texto: "Prato", Tipo: TipoPerguntaTexto}, ErrPerguntaNaoEncontrada},
+			{"finalidade desconhecida", DadosPergunta{Texto: "Bebida", Tipo: TipoPerguntaTexto, Finalidade: "BEBIDA"}, ErrFinalidadePerguntaInvalida},
+			{"prato que não é escolha única", DadosPergunta{Texto: "Prato", Tipo: TipoPerguntaEscolhaMultipla, Opcoes: []string{"Carne", "Peixe"}, Finalidade: FinalidadePerguntaPrato}, ErrFinalidadePerguntaInvalida},
+			{"restrição numérica", DadosPergunta{Texto: "Restrições", Tipo: TipoPerguntaNumero, Finalidade: FinalidadePerguntaRestricaoAlimentar}, ErrFinalidadePerguntaInvalida},
+		}
+		for _, caso := range casos {
+			formulario := NewFormularioRSVP(uuid.New())
+			err := formulario.Redefinir([]DadosPergunta{caso.pergunta})
+			assert.ErrorIs(t, err, caso.erro, caso.nome)
+			assert.Empty(t, formulario.Perguntas(), caso.nome)
+		}
+	})
+
+	t.Run("deve aceitar uma pergunta de cada finalidade do buffet", func(t *testing.T) {
+		formulario := NewFormularioRSVP(uuid.New())
+
+		err := formulario.Redefinir([]DadosPergunta{
+			{Texto: "Escolha do prato", Tipo: TipoPerguntaEscolhaUnica, Opcoes: []string{"Carne", "Peixe"}, Finalidade: FinalidadePerguntaPrato},
+			{Texto: "Restrições alimentares", Tipo: TipoPerguntaTexto, Finalidade: FinalidadePerguntaRestricaoAlimentar},
+			{Texto: "Alguma observação?", Tipo: TipoPerguntaTexto},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, FinalidadePerguntaPrato, formulario.Perguntas()[0].Finalidade())
+		assert.Equal(t, FinalidadePerguntaRestricaoAlimentar, formulario.Perguntas()[1].Finalidade())
+		assert.Empty(t, formulario.Perguntas()[2].Finalidade())
+	})
+
+	t.Run("deve recusar duas perguntas com a mesma finalidade", func(t *testing.T) {
+		formulario := NewFormularioRSVP(uuid.New())
+
+		err := formulario.Redefinir([]DadosPergunta{
+			{Texto: "Prato do jantar", Tipo: TipoPerguntaEscolhaUnica, Opcoes: []string{"Carne", "Peixe"}, Finalidade: FinalidadePerguntaPrato},
+			{Texto: "Prato do almoço", Tipo: TipoPerguntaEscolhaUnica, Opcoes: []string{"Massa", "Risoto"}, Finalidade: FinalidadePerguntaPrato},
+		})
+
+		assert.ErrorIs(t, err, ErrFinalidadeRepetida)
+		assert.Empty(t, formulario.Perguntas())
+	})
+
+	t.Run("deve recusar formulários acima do limite", func(t *testing.T) {
+		dados := make([]DadosPergunta, MaximoPerguntasPorFormulario+1)
+		for i := range dados {
+			dados[i] = DadosPergunta{Texto: "Pergunta", Tipo: TipoPerguntaTexto}
+		}
+
+		err := NewFormularioRSVP(uuid.New()).Redefinir(dados)
+
+		assert.ErrorIs(t, err, ErrFormularioMuitoGrande)
+	})
+}
+
+func TestGrupoDeConvidados_ConfirmarPresencaComFormulario(t *testing.T) {
+	formulario := novoFormularioDeTeste(t)
+	prato := formulario.Perguntas()[0].ID()
+	restricoes := formulario.Perguntas()[1].ID()
+	observacao := formulario.Perguntas()[2].ID()
+	noites := formulario.Perguntas()[3].ID()
+
+	novoGrupo := func(t *testing.T) (*GrupoDeConvidados, uuid.UUID) {
+		grupo, err := NewGrupoDeConvidados(formulario.IDEvento(), "familia", []string{"João", "Maria"})
+		assert.NoError(t, err)
+		return grupo, grupo.Convidados()[0].ID()
+	}
+
+	t.Run("deve gravar as respostas normalizadas do convidado confirmado", func(t *testing.T) {
+		grupo, joao := novoGrupo(t)
+		respostas := []RespostaRSVP{{ConvidadoID: joao, Status: StatusRSVPConfirmado, RespostasFormulario: []RespostaPergunta{
+			{IDPergunta: prato, Valores: []string{" Peixe "}},
+			{IDPergunta: restricoes, Valores: []string{"Amendoim", "Glúten"}},
+			{IDPergunta: observacao, Valores: []string{"   "}},
+			{IDPergunta: noites, Valores: []string{"02"}},
+		}}}
+
+		err := grupo.ConfirmarPresencaComFormulario(formulario, respostas, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []RespostaPergunta{
+			{IDPergunta: prato, Valores: []string{"Peixe"}},
+			{IDPergunta: restricoes, Valores: []string{"Glúten", "Amendoim"}},
+			{IDPergunta: noites, Valores: []string{"2"}},
+		}, grupo.Convidados()[0].RespostasFormulario())
+	})
+
+	t.Run("deve exigir as perguntas obrigatórias de quem confirma", func(t *testing.T) {
+		grupo, joao := novoGrupo(t)
+		respostas := []RespostaRSVP{{ConvidadoID: joao, Status: StatusRSVPConfirmado}}
+
+		err := grupo.ConfirmarPresencaComFormulario(formulario, respostas, nil)
+
+		assert.ErrorIs(t, err, ErrRespostaObrigatoriaAusente)
+		assert.Equal(t, StatusRSVPPendente, grupo.Convidados()[0].StatusRSVP())
+	})
+
+	t.Run("deve recusar respostas fora das regras da pergunta", func(t *testing.T) {
+		casos := map[string]RespostaPergunta{
+			"opção inexistente":            {IDPergunta: prato, Valores: []string{"Frango"}},
+			"duas opções em escolha única": {IDPergunta: prato, Valores: []string{"Carne", "Peixe"}},
+			"número inválido":              {IDPergunta: noites, Valores: []string{"duas"}},
+			"número fora da faixa":         {IDPergunta: noites, Valores: []string{"11"}},
+		}
+		for nome, resposta := range casos {
+			grupo, joao := novoGrupo(t)
+			respostas := []RespostaRSVP{{ConvidadoID: joao, Status: StatusRSVPConfirmado, RespostasFormulario: []RespostaPergunta{resposta}}}
+
+			err := grupo.ConfirmarPresencaComFormulario(formulario, respostas, nil)
+
+			assert.ErrorIs(t, err, ErrRespostaInvalida, nome)
+		}
+	})
+
+	t.Run("deve recusar perguntas de outro formulário", func(t *testing.T) {
+		grupo, joao := novoGrupo(t)
+		respostas := []RespostaRSVP{{ConvidadoID: joao, Status: StatusRSVPConfirmado, RespostasFormulario: []RespostaPergunta{
+			{IDPergunta: uuid.New(), Valores: []string{"Sim"}},
+		}}}
+
+		err := grupo.ConfirmarPresencaComFormulario(formulario, respostas, nil)
+
+		assert.ErrorIs(t, err, ErrPerguntaNaoEncontrada)
+	})
+
+	t.Run("deve manter as respostas omitidas e descartá-las quando o convidado recusa", func(t *testing.T) {
+		grupo, joao := novoGrupo(t)
+		primeira := []RespostaRSVP{{ConvidadoID: joao, Status: StatusRSVPConfirmado, RespostasFormulario: []RespostaPergunta{
+			{IDPergunta: prato, Valores: []string{"Carne"}},
+		}}}
+		assert.NoError(t, grupo.ConfirmarPresencaComFormulario(formulario, primeira, nil))
+
+		semRespostas := []RespostaRSVP{{ConvidadoID: joao, Status: StatusRSVPConfirmado}}
+		assert.NoError(t, grupo.ConfirmarPresencaComFormulario(formulario, semRespostas, nil))
+		assert.Len(t, grupo.Convidados()[0].RespostasFormulario(), 1)
+
+		recusa := []RespostaRSVP{{ConvidadoID: joao, Status: StatusRSVPRecusado}}
+		assert.NoError(t, grupo.ConfirmarPresencaComFormulario(formulario, recusa, nil))
+		assert.Empty(t, grupo.Convidados()[0].RespostasFormulario())
+	})
+}
@@ -0,0 +1,152 @@
+// file: internal/guest/domain/fusao_grupos.go
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrFusaoComOMesmoGrupo       = errors.New("não é possível juntar um grupo com ele mesmo")
+	ErrGruposDeEventosDiferentes = errors.New("os grupos pertencem a eventos diferentes")
+	ErrDivisaoSemConvidados      = errors.New("a divisão deve deixar ao menos um convidado em cada grupo")
+)
+
+// Absorver junta o grupo outro a este, que continua com o próprio ID e a própria chave.
+// Os convidados, inclusive os removidos, mudam de grupo com o RSVP, as respostas e os
+// contatos; acompanhantes e etiquetas somam-se aos deste grupo. O limite de acompanhantes
+// passa a ser a soma dos dois, até MaximoAcompanhantesPorGrupo, e vale a prorrogação de
+// prazo mais longa.
+// O grupo outro deve ser removido ao gravar a junção.
+func (g *GrupoDeConvidados) Absorver(outro *GrupoDeConvidados) error {
+	if outro.id == g.id {
+		return ErrFusaoComOMesmoGrupo
+	}
+	if outro.idCasamento != g.idCasamento {
+		return ErrGruposDeEventosDiferentes
+	}
+	acompanhantes := append(append([]*Acompanhante{}, g.acompanhantes...), outro.acompanhantes...)
+	if len(acompanhantes) > MaximoAcompanhantesPorGrupo {
+		return ErrLimiteAcompanhantesExcedido
+	}
+
+	g.convidados = append(g.convidados, outro.convidados...)
+	g.removidos = append(g.removidos, outro.removidos...)
+	g.acompanhantes = acompanhantes
+	g.limiteAcompanhantes = min(g.limiteAcompanhantes+outro.limiteAcompanhantes, MaximoAcompanhantesPorGrupo)
+
+	etiquetas := make(map[uuid.UUID]bool, len(g.etiquetas))
+	for _, e := range g.etiquetas {
+		etiquetas[e.id] = true
+	}
+	for _, e := range outro.etiquetas {
+		if !etiquetas[e.id] {
+			g.etiquetas = append(g.etiquetas, e)
+		}
+	}
+
+	g.prazoRSVPEstendido = maisTarde(g.prazoRSVPEstendido, outro.prazoRSVPEstendido)
+	g.ultimaRespostaEm = maisTarde(g.ultimaRespostaEm, outro.ultimaRespostaEm)
+	g.updatedAt = time.Now()
+	return nil
+}
+
+func maisTarde(a, b *time.Time) *time.Time {
+	if a == nil || (b != nil && b.After(*a)) {
+		return b
+	}
+	return a
+}
+
+// DivisaoGrupo indica quem sai do grupo para formar um grupo novo.
+type DivisaoGrupo struct {
+	Convidados          []uuid.UUID
+	Acompanhantes       []uuid.UUID
+	LimiteAcompanhantes int // Limite do grupo novo; o do grupo original não muda
+}
+
+// Dividir move os convidados e acompanhantes indicados para um grupo novo, com a chave
+// de acesso informada. Os convidados mantêm o RSVP, as respostas e os contatos; o grupo
+// novo herda as etiquetas e a prorrogação de prazo. Cada grupo precisa ficar com ao
+// menos um convidado, e acompanhantes continuam exigindo alguém confirmado no grupo.
+func (g *GrupoDeConvidados) Dividir(divisao DivisaoGrupo, chaveDeAcesso string) (*GrupoDeConvidados, error) {
+	if chaveDeAcesso == "" {
+		return nil, ErrChaveDeAcessoObrigatoria
+	}
+	if divisao.LimiteAcompanhantes < 0 || divisao.LimiteAcompanhantes > MaximoAcompanhantesPorGrupo {
+		return nil, ErrLimiteAcompanhantesInvalido
+	}
+
+	sai := make(map[uuid.UUID]bool, len(divisao.Convidados))
+	for _, id := range divisao.Convidados {
+		sai[id] = true
+	}
+	var ficam, saem []*Convidado
+	for _, c := range g.convidados {
+		if sai[c.id] {
+			saem = append(saem, c)
+			delete(sai, c.id)
+		} else {
+			ficam = append(ficam, c)
+		}
+	}
+	if len(sai) > 0 {
+		return nil, ErrConvidadoNaoEncontradoNoGrupo
+	}
+	if len(saem) == 0 || len(ficam) == 0 {
+		return nil, ErrDivisaoSemConvidados
+	}
+
+	acompanhanteSai := make(map[uuid.UUID]bool, len(divisao.Acompanhantes))
+	for _, id := range divisao.Acompanhantes {
+		acompanhanteSai[id] = true
+	}
+	var acompanhantesFicam, acompanhantesSaem []*Acompanhante
+	for _, a := range g.acompanhantes {
+		if acompanhanteSai[a.id] {
+			acompanhantesSaem = append(acompanhantesSaem, a)
+			delete(acompanhanteSai, a.id)
+		} else {
+			acompanhantesFicam = append(acompanhantesFicam, a)
+		}
+	}
+	if len(acompanhanteSai) > 0 {
+		return nil, ErrAcompanhanteNaoEncontrado
+	}
+	if len(acompanhantesSaem) > divisao.LimiteAcompanhantes {
+		return nil, ErrLimiteAcompanhantesExcedido
+	}
+	if (len(acompanhantesSaem) > 0 && !algumConfirmado(saem)) || (len(acompanhantesFicam) > 0 && !algumConfirmado(ficam)) {
+		return nil, ErrAcompanhantesSemConfirmacao
+	}
+
+	agora := time.Now()
+	novo := &GrupoDeConvidados{
+		id:                  uuid.New(),
+		idCasamento:         g.idCasamento,
+		chaveDeAcesso:       chaveDeAcesso,
+		convidados:          saem,
+		limiteAcompanhantes: divisao.LimiteAcompanhantes,
+		acompanhantes:       acompanhantesSaem,
+		etiquetas:           append([]*Etiqueta{}, g.etiquetas...),
+		prazoRSVPEstendido:  g.prazoRSVPEstendido,
+		ultimaRespostaEm:    g.ultimaRespostaEm,
+		createdAt:           agora,
+		updatedAt:           agora,
+	}
+	g.convidados = ficam
+	g.acompanhantes = acompanhantesFicam
+	g.updatedAt = agora
+	return novo, nil
+}
+
+func algumConfirmado(convidados []*Convidado) bool {
+	for _, c := range convidados {
+		if c.statusRSVP == StatusRSVPConfirmado {
+			return true
+		}
+	}
+	return false
+}
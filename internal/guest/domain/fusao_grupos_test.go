@@ -0,0 +1,134 @@
+// file: internal/guest/domain/fusao_grupos_test.go
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrupoDeConvidados_Absorver(t *testing.T) {
+	idEvento := uuid.New()
+
+	t.Run("deve mover convidados com o RSVP e somar acompanhantes, etiquetas e limites", func(t *testing.T) {
+		padrinhos := HydrateEtiqueta(uuid.New(), idEvento, "padrinhos")
+		familia := HydrateEtiqueta(uuid.New(), idEvento, "família")
+		prazo := time.Now().Add(48 * time.Hour)
+		resposta := time.Now().Add(-time.Minute)
+
+		carlos := HydrateConvidado(uuid.New(), StatusRSVPConfirmado, DadosConvidado{Nome: "Carlos"}, nil)
+		ana := HydrateConvidado(uuid.New(), StatusRSVPRecusado, DadosConvidado{Nome: "Ana"}, nil)
+		grupo := novoGrupoDeTeste(idEvento, "silva", 1, []*Acompanhante{HydrateAcompanhante(uuid.New(), "Paula")}, carlos)
+		grupo.etiquetas = []*Etiqueta{padrinhos}
+		outro := novoGrupoDeTeste(idEvento, "souza", 2, nil, ana)
+		outro.etiquetas = []*Etiqueta{padrinhos, familia}
+		outro.prazoRSVPEstendido = &prazo
+		outro.ultimaRespostaEm = &resposta
+
+		err := grupo.Absorver(outro)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "silva", grupo.ChaveDeAcesso())
+		assert.Equal(t, []*Convidado{carlos, ana}, grupo.Convidados())
+		assert.Equal(t, StatusRSVPRecusado, grupo.Convidados()[1].StatusRSVP())
+		assert.Len(t, grupo.Acompanhantes(), 1)
+		assert.Equal(t, 3, grupo.LimiteAcompanhantes())
+		assert.Equal(t, []*Etiqueta{padrinhos, familia}, grupo.Etiquetas())
+		assert.Equal(t, &prazo, grupo.PrazoRSVPEstendido())
+		assert.Equal(t, &resposta, grupo.UltimaRespostaEm())
+	})
+
+	t.Run("deve limitar a soma dos limites ao máximo por grupo", func(t *testing.T) {
+		grupo := novoGrupoDeTeste(idEvento, "silva", 15, nil, HydrateConvidado(uuid.New(), StatusRSVPPendente, DadosConvidado{Nome: "Carlos"}, nil))
+		outro := novoGrupoDeTeste(idEvento, "souza", 10, nil, HydrateConvidado(uuid.New(), StatusRSVPPendente, DadosConvidado{Nome: "Ana"}, nil))
+
+		assert.NoError(t, grupo.Absorver(outro))
+		assert.Equal(t, MaximoAcompanhantesPorGrupo, grupo.LimiteAcompanhantes())
+	})
+
+	t.Run("deve recusar o mesmo grupo e grupos de outro evento", func(t *testing.T) {
+		grupo := novoGrupoDeTeste(idEvento, "silva", 0, nil, HydrateConvidado(uuid.New(), StatusRSVPPendente, DadosConvidado{Nome: "Carlos"}, nil))
+		deOutroEvento := novoGrupoDeTeste(uuid.New(), "souza", 0, nil, HydrateConvidado(uuid.New(), StatusRSVPPendente, DadosConvidado{Nome: "Ana"}, nil))
+
+		assert.Equal(t, ErrFusaoComOMesmoGrupo, grupo.Absorver(grupo))
+		assert.Equal(t, ErrGruposDeEventosDiferentes, grupo.Absorver(deOutroEvento))
+		assert.Len(t, grupo.Convidados(), 1)
+	})
+}
+
+func TestGrupoDeConvidados_Dividir(t *testing.T) {
+	idEvento := uuid.New()
+	novoGrupo := func() (*GrupoDeConvidados, *Convidado, *Convidado, *Acompanhante) {
+		carlos := HydrateConvidado(uuid.New(), StatusRSVPConfirmado, DadosConvidado{Nome: "Carlos"}, nil)
+		ana := HydrateConvidado(uuid.New(), StatusRSVPConfirmado, DadosConvidado{Nome: "Ana"}, nil)
+		paula := HydrateAcompanhante(uuid.New(), "Paula")
+		grupo := novoGrupoDeTeste(idEvento, "silva", 2, []*Acompanhante{paula}, carlos, ana)
+		grupo.etiquetas = []*Etiqueta{HydrateEtiqueta(uuid.New(), idEvento, "padrinhos")}
+		return grupo, carlos, ana, paula
+	}
+
+	t.Run("deve criar o grupo novo com os convidados e acompanhantes indicados", func(t *testing.T) {
+		grupo, carlos, ana, paula := novoGrupo()
+
+		novo, err := grupo.Dividir(DivisaoGrupo{Convidados: []uuid.UUID{ana.ID()}, Acompanhantes: []uuid.UUID{paula.ID()}, LimiteAcompanhantes: 1}, "souza")
+
+		assert.NoError(t, err)
+		assert.NotEqual(t, grupo.ID(), novo.ID())
+		assert.Equal(t, idEvento, novo.IDCasamento())
+		assert.Equal(t, "souza", novo.ChaveDeAcesso())
+		assert.Equal(t, []*Convidado{ana}, novo.Convidados())
+		assert.Equal(t, StatusRSVPConfirmado, novo.Convidados()[0].StatusRSVP())
+		assert.Equal(t, []*Acompanhante{paula}, novo.Acompanhantes())
+		assert.Equal(t, 1, novo.LimiteAcompanhantes())
+		assert.Len(t, novo.Etiquetas(), 1)
+		assert.Equal(t, []*Convidado{carlos}, grupo.Convidados())
+		assert.Empty(t, grupo.Acompanhantes())
+		assert.Equal(t, 2, grupo.LimiteAcompanhantes())
+	})
+
+	t.Run("deve deixar ao menos um convidado em cada grupo", func(t *testing.T) {
+		grupo, carlos, ana, _ := novoGrupo()
+
+		_, semNinguem := grupo.Dividir(DivisaoGrupo{}, "souza")
+		_, todos := grupo.Dividir(DivisaoGrupo{Convidados: []uuid.UUID{carlos.ID(), ana.ID()}}, "souza")
+
+		assert.Equal(t, ErrDivisaoSemConvidados, semNinguem)
+		assert.Equal(t, ErrDivisaoSemConvidados, todos)
+		assert.Len(t, grupo.Convidados(), 2)
+	})
+
+	t.Run("deve recusar convidado ou acompanhante de outro grupo", func(t *testing.T) {
+		grupo, _, ana, _ := novoGrupo()
+
+		_, errConvidado := grupo.Dividir(DivisaoGrupo{Convidados: []uuid.UUID{uuid.New()}}, "souza")
+		_, errAcompanhante := grupo.Dividir(DivisaoGrupo{Convidados: []uuid.UUID{ana.ID()}, Acompanhantes: []uuid.UUID{uuid.New()}, LimiteAcompanhantes: 1}, "souza")
+
+		assert.Equal(t, ErrConvidadoNaoEncontradoNoGrupo, errConvidado)
+		assert.Equal(t, ErrAcompanhanteNaoEncontrado, errAcompanhante)
+	})
+
+	t.Run("deve aplicar as regras de acompanhantes ao grupo novo", func(t *testing.T) {
+		grupo, carlos, ana, paula := novoGrupo()
+		carlos.statusRSVP = StatusRSVPPendente
+
+		_, acimaDoLimite := grupo.Dividir(DivisaoGrupo{Convidados: []uuid.UUID{ana.ID()}, Acompanhantes: []uuid.UUID{paula.ID()}}, "souza")
+		_, semConfirmado := grupo.Dividir(DivisaoGrupo{Convidados: []uuid.UUID{carlos.ID()}, Acompanhantes: []uuid.UUID{paula.ID()}, LimiteAcompanhantes: 1}, "souza")
+		_, ficaSemConfirmado := grupo.Dividir(DivisaoGrupo{Convidados: []uuid.UUID{ana.ID()}}, "souza")
+
+		assert.Equal(t, ErrLimiteAcompanhantesExcedido, acimaDoLimite)
+		assert.Equal(t, ErrAcompanhantesSemConfirmacao, semConfirmado)
+		assert.Equal(t, ErrAcompanhantesSemConfirmacao, ficaSemConfirmado)
+	})
+
+	t.Run("deve exigir chave de acesso e limite válido", func(t *testing.T) {
+		grupo, _, ana, _ := novoGrupo()
+
+		_, semChave := grupo.Dividir(DivisaoGrupo{Convidados: []uuid.UUID{ana.ID()}}, "")
+		_, limiteInvalido := grupo.Dividir(DivisaoGrupo{Convidados: []uuid.UUID{ana.ID()}, LimiteAcompanhantes: MaximoAcompanhantesPorGrupo + 1}, "souza")
+
+		assert.Equal(t, ErrChaveDeAcessoObrigatoria, semChave)
+		assert.Equal(t, ErrLimiteAcompanhantesInvalido, limiteInvalido)
+	})
+}
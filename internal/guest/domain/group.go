@@ -15,21 +15,44 @@ var (
 	ErrConvidadoNaoEncontradoNoGrupo = errors.New("um ou mais convidados não pertencem a este grupo")
 	ErrStatusRSVPInvalido            = errors.New("status de rsvp inválido")
 	ErrNaoPodeRemoverGrupoComRSVP    = errors.New("não é possível remover grupo com confirmações de presença")
+	ErrEventoNaoEncontrado           = errors.New("evento não encontrado")
+	ErrChaveDeAcessoEmUso            = errors.New("a chave de acesso já está em uso neste evento")
+	ErrLimiteAcompanhantesInvalido   = errors.New("o limite de acompanhantes deve estar entre 0 e 20")
+	ErrLimiteAcompanhantesExcedido   = errors.New("o número de acompanhantes excede o permitido para o grupo")
+	ErrLimiteMenorQueAcompanhantes   = errors.New("o grupo já tem mais acompanhantes confirmados que o novo limite")
+	ErrNomeAcompanhanteInvalido      = errors.New("o nome de cada acompanhante é obrigatório e deve ter até 255 caracteres")
+	ErrAcompanhantesSemConfirmacao   = errors.New("acompanhantes exigem ao menos um convidado confirmado no grupo")
 )
 
+// MaximoAcompanhantesPorGrupo é o teto para o limite definido pelo anfitrião.
+const MaximoAcompanhantesPorGrupo = 20
+
 // GrupoDeConvidados é o agregado raiz para o contexto de RSVP.
 // Ele é a fronteira de consistência para as operações.
 type GrupoDeConvidados struct {
-	id            uuid.UUID
-	idCasamento   uuid.UUID
-	chaveDeAcesso string
-	convidados    []*Convidado
-	createdAt     time.Time
-	updatedAt     time.Time
+	id                  uuid.UUID
+	idCasamento         uuid.UUID
+	chaveDeAcesso       string
+	convidados          []*Convidado
+	limiteAcompanhantes int
+	acompanhantes       []*Acompanhante
+	etiquetas           []*Etiqueta
+	prazoRSVPEstendido  *time.Time // Prazo próprio do grupo, quando o anfitrião concede mais tempo
+	ultimaRespostaEm    *time.Time // Última vez em que o grupo confirmou ou recusou presença
+	createdAt           time.Time
+	updatedAt           time.Time
+	// respostasRegistradas alimentam o histórico de RSVP ao salvar a confirmação.
+	respostasRegistradas []RegistroRSVP
+	// alteracoesPerfil são gravadas para revisão do anfitrião ao salvar o perfil.
+	alteracoesPerfil []RegistroAlteracaoPerfil
+	// removidos ficam fora de convidados: não contam nas listas nem no RSVP até serem restaurados.
+	removidos []*ConvidadoRemovido
 }
 type RespostaRSVP struct {
 	ConvidadoID uuid.UUID
 	Status      string
+	// RespostasFormulario nil mantém as respostas atuais do convidado.
+	RespostasFormulario []RespostaPergunta
 }
 
 // Convidado é uma entidade interna do agregado.
@@ -37,11 +60,76 @@ type Convidado struct {
 	id         uuid.UUID
 	nome       string
 	statusRSVP string
+	telefone   string // E.164
+	email      string
+	// Metadados opcionais usados na organização do evento; vazios quando não informados.
+	faixaEtaria string
+	lado        string
+	observacoes string
+	// respostasFormulario só existem para convidados confirmados.
+	respostasFormulario []RespostaPergunta
+}
+
+// Acompanhante é uma pessoa extra, sem convite nominal, cujo nome é informado
+// pelo próprio grupo ao confirmar presença. Todo acompanhante registrado vai ao evento.
+type Acompanhante struct {
+	id   uuid.UUID
+	nome string
+}
+
+// DadosConvidado são os dados cadastrais de um convidado. Apenas o nome é obrigatório.
+type DadosConvidado struct {
+	Nome        string
+	Telefone    string
+	Email       string
+	FaixaEtaria string
+	Lado        string
+	Observacoes string
 }
 
+// ConvidadoParaRevisao traz o nome e, opcionalmente, os demais dados do convidado.
+// Campos nil mantêm o valor atual; em convidados novos, equivalem a não informado.
 type ConvidadoParaRevisao struct {
-	ID   uuid.UUID // Pode ser uuid.Nil se for um novo convidado
-	Nome string
+	ID          uuid.UUID // Pode ser uuid.Nil se for um novo convidado
+	Nome        string
+	Telefone    *string
+	Email       *string
+	FaixaEtaria *string
+	Lado        *string
+	Observacoes *string
+}
+
+// revisar valida os campos informados e mantém os demais como estão. Só o que foi
+// informado é validado, para que dados gravados antes das regras atuais não impeçam
+// a revisão do grupo.
+func (c ConvidadoParaRevisao) revisar(atuais DadosConvidado) (DadosConvidado, error) {
+	campos := []struct {
+		novo     *string
+		revisado func(d *DadosConvidado) *string
+	}{
+		{c.Telefone, func(d *DadosConvidado) *string { return &d.Telefone }},
+		{c.Email, func(d *DadosConvidado) *string { return &d.Email }},
+		{c.FaixaEtaria, func(d *DadosConvidado) *string { return &d.FaixaEtaria }},
+		{c.Lado, func(d *DadosConvidado) *string { return &d.Lado }},
+		{c.Observacoes, func(d *DadosConvidado) *string { return &d.Observacoes }},
+	}
+
+	informados := DadosConvidado{Nome: c.Nome}
+	for _, campo := range campos {
+		if campo.novo != nil {
+			*campo.revisado(&informados) = *campo.novo
+		}
+	}
+	revisados, err := informados.normalizar()
+	if err != nil {
+		return atuais, err
+	}
+	for _, campo := range campos {
+		if campo.novo == nil {
+			*campo.revisado(&revisados) = *campo.revisado(&atuais)
+		}
+	}
+	return revisados, nil
 }
 
 const (
@@ -54,7 +142,9 @@ func (g *GrupoDeConvidados) UpdatedAt() time.Time {
 	return g.updatedAt
 }
 
-// Revisar atualiza o estado do agregado com base em novos dados.
+// Revisar atualiza o estado do agregado com base em novos dados. Convidados omitidos saem
+// do grupo, mas só se ainda não responderam ao RSVP; quem já confirmou ou recusou precisa
+// ser removido com RemoverConvidado, que guarda o motivo e permite restaurá-lo.
 func (g *GrupoDeConvidados) Revisar(novaChaveDeAcesso string, convidadosParaRevisao []ConvidadoParaRevisao) error {
 	if novaChaveDeAcesso == "" {
 		return ErrChaveDeAcessoObrigatoria
@@ -63,25 +153,29 @@ func (g *GrupoDeConvidados) Revisar(novaChaveDeAcesso string, convidadosParaRevi
 		return ErrPeloMenosUmConvidado
 	}
 
-	g.chaveDeAcesso = novaChaveDeAcesso
-
 	convidadosAtuaisMap := make(map[uuid.UUID]*Convidado)
 	for _, c := range g.convidados {
 		convidadosAtuaisMap[c.id] = c
 	}
 
 	var convidadosFinais []*Convidado
+	var dadosRevisados []DadosConvidado
 	idsProcessados := make(map[uuid.UUID]bool)
 
 	for _, cRevisao := range convidadosParaRevisao {
 		// Se o ID for zero, é um novo convidado
 		if cRevisao.ID == uuid.Nil {
+			dados, err := cRevisao.revisar(DadosConvidado{})
+			if err != nil {
+				return err
+			}
 			novoConvidado := &Convidado{
 				id:         uuid.New(),
-				nome:       cRevisao.Nome,
 				statusRSVP: StatusRSVPPendente,
 			}
+			novoConvidado.aplicarDados(dados)
 			convidadosFinais = append(convidadosFinais, novoConvidado)
+			dadosRevisados = append(dadosRevisados, dados)
 		} else {
 			// Se o ID existe, é um convidado existente (potencialmente renomeado)
 			convidadoExistente, ok := convidadosAtuaisMap[cRevisao.ID]
@@ -89,13 +183,27 @@ func (g *GrupoDeConvidados) Revisar(novaChaveDeAcesso string, convidadosParaRevi
 				// Tentou editar um convidado que não pertencia a este grupo
 				return ErrConvidadoNaoEncontradoNoGrupo
 			}
-			convidadoExistente.nome = cRevisao.Nome // Atualiza o nome
+			dados, err := cRevisao.revisar(convidadoExistente.Dados())
+			if err != nil {
+				return err
+			}
 			convidadosFinais = append(convidadosFinais, convidadoExistente)
+			dadosRevisados = append(dadosRevisados, dados)
 			idsProcessados[cRevisao.ID] = true
 		}
 	}
 
-	// Substitui a lista de convidados antiga pela nova
+	for _, c := range g.convidados {
+		if !idsProcessados[c.id] && c.statusRSVP != StatusRSVPPendente {
+			return ErrConvidadoComRSVPOmitido
+		}
+	}
+
+	// Os dados só são aplicados depois que todos os convidados foram validados.
+	for i, c := range convidadosFinais {
+		c.aplicarDados(dadosRevisados[i])
+	}
+	g.chaveDeAcesso = novaChaveDeAcesso
 	g.convidados = convidadosFinais
 	g.updatedAt = time.Now()
 
@@ -104,20 +212,30 @@ func (g *GrupoDeConvidados) Revisar(novaChaveDeAcesso string, convidadosParaRevi
 
 // NewGrupoDeConvidados é a fábrica para nosso agregado. Garante que ele seja criado em um estado válido.
 func NewGrupoDeConvidados(idCasamento uuid.UUID, chaveDeAcesso string, nomesDosConvidados []string) (*GrupoDeConvidados, error) {
+	dados := make([]DadosConvidado, len(nomesDosConvidados))
+	for i, nome := range nomesDosConvidados {
+		dados[i] = DadosConvidado{Nome: nome}
+	}
+	return NewGrupoDeConvidadosComDados(idCasamento, chaveDeAcesso, dados)
+}
+
+// NewGrupoDeConvidadosComDados cria o grupo já com os dados de contato de cada convidado.
+func NewGrupoDeConvidadosComDados(idCasamento uuid.UUID, chaveDeAcesso string, dadosDosConvidados []DadosConvidado) (*GrupoDeConvidados, error) {
 	if chaveDeAcesso == "" {
 		return nil, ErrChaveDeAcessoObrigatoria
 	}
-	if len(nomesDosConvidados) == 0 {
+	if len(dadosDosConvidados) == 0 {
 		return nil, ErrPeloMenosUmConvidado
 	}
 
-	convidados := make([]*Convidado, len(nomesDosConvidados))
-	for i, nome := range nomesDosConvidados {
-		convidados[i] = &Convidado{
-			id:         uuid.New(),
-			nome:       nome,
-			statusRSVP: StatusRSVPPendente,
+	convidados := make([]*Convidado, len(dadosDosConvidados))
+	for i, dados := range dadosDosConvidados {
+		dados, err := dados.normalizar()
+		if err != nil {
+			return nil, err
 		}
+		convidados[i] = &Convidado{id: uuid.New(), statusRSVP: StatusRSVPPendente}
+		convidados[i].aplicarDados(dados)
 	}
 
 	return &GrupoDeConvidados{
@@ -128,26 +246,44 @@ func NewGrupoDeConvidados(idCasamento uuid.UUID, chaveDeAcesso string, nomesDosC
 	}, nil
 }
 
-func HydrateGroup(id, idCasamento uuid.UUID, chaveDeAcesso string, convidados []*Convidado, createdAt, updatedAt time.Time) *GrupoDeConvidados {
+func HydrateGroup(id, idCasamento uuid.UUID, chaveDeAcesso string, convidados []*Convidado, limiteAcompanhantes int, acompanhantes []*Acompanhante, etiquetas []*Etiqueta, prazoRSVPEstendido, ultimaRespostaEm *time.Time, createdAt, updatedAt time.Time) *GrupoDeConvidados {
 	return &GrupoDeConvidados{
-		id:            id,
-		idCasamento:   idCasamento,
-		chaveDeAcesso: chaveDeAcesso,
-		convidados:    convidados,
-		createdAt:     createdAt,
-		updatedAt:     updatedAt,
+		id:                  id,
+		idCasamento:         idCasamento,
+		chaveDeAcesso:       chaveDeAcesso,
+		convidados:          convidados,
+		limiteAcompanhantes: limiteAcompanhantes,
+		acompanhantes:       acompanhantes,
+		etiquetas:           etiquetas,
+		prazoRSVPEstendido:  prazoRSVPEstendido,
+		ultimaRespostaEm:    ultimaRespostaEm,
+		createdAt:           createdAt,
+		updatedAt:           updatedAt,
 	}
 }
 
-func HydrateConvidado(id uuid.UUID, nome, statusRSVP string) *Convidado {
-	return &Convidado{
-		id:         id,
-		nome:       nome,
-		statusRSVP: statusRSVP,
-	}
+func HydrateAcompanhante(id uuid.UUID, nome string) *Acompanhante {
+	return &Acompanhante{id: id, nome: nome}
+}
+
+func HydrateConvidado(id uuid.UUID, statusRSVP string, dados DadosConvidado, respostasFormulario []RespostaPergunta) *Convidado {
+	convidado := &Convidado{id: id, statusRSVP: statusRSVP, respostasFormulario: respostasFormulario}
+	convidado.aplicarDados(dados)
+	return convidado
+}
+
+// ConfirmarPresenca aplica as respostas dos convidados e, quando acompanhantes não é nil,
+// substitui a lista de acompanhantes pelos nomes informados. Com acompanhantes nil a
+// lista atual é mantida, exceto se ninguém do grupo continuar confirmado.
+// Respostas ao formulário do evento são recusadas; use ConfirmarPresencaComFormulario.
+func (g *GrupoDeConvidados) ConfirmarPresenca(respostas []RespostaRSVP, acompanhantes []string) error {
+	return g.ConfirmarPresencaComFormulario(nil, respostas, acompanhantes)
 }
 
-func (g *GrupoDeConvidados) ConfirmarPresenca(respostas []RespostaRSVP) error {
+// ConfirmarPresencaComFormulario faz o mesmo que ConfirmarPresenca e também grava as
+// respostas ao formulário do evento. Cada convidado confirmado nesta chamada precisa
+// ter respondido todas as perguntas obrigatórias; quem recusa perde as respostas.
+func (g *GrupoDeConvidados) ConfirmarPresencaComFormulario(formulario *FormularioRSVP, respostas []RespostaRSVP, acompanhantes []string) error {
 	// Cria um mapa para busca rápida dos convidados do grupo.
 	convidadosDoGrupo := make(map[uuid.UUID]*Convidado)
 	for _, c := range g.convidados {
@@ -155,23 +291,117 @@ func (g *GrupoDeConvidados) ConfirmarPresenca(respostas []RespostaRSVP) error {
 	}
 
 	// Primeira passagem: validação. Garante que a operação seja atômica.
+	statusFinal := make(map[uuid.UUID]string, len(g.convidados))
+	for _, c := range g.convidados {
+		statusFinal[c.id] = c.statusRSVP
+	}
+	respostasFinais := make(map[uuid.UUID][]RespostaPergunta, len(respostas))
 	for _, resposta := range respostas {
 		// Regra 1: O status deve ser válido.
 		if resposta.Status != StatusRSVPConfirmado && resposta.Status != StatusRSVPRecusado {
 			return ErrStatusRSVPInvalido
 		}
 		// Regra 2: O convidado da resposta deve pertencer ao grupo.
-		if _, ok := convidadosDoGrupo[resposta.ConvidadoID]; !ok {
+		convidado, ok := convidadosDoGrupo[resposta.ConvidadoID]
+		if !ok {
 			return ErrConvidadoNaoEncontradoNoGrupo
 		}
+		statusFinal[resposta.ConvidadoID] = resposta.Status
+
+		// Regra 4: respostas ao formulário seguem o tipo de cada pergunta, e quem
+		// confirma responde todas as obrigatórias.
+		respostasDoConvidado := convidado.respostasFormulario
+		if resposta.RespostasFormulario != nil {
+			normalizadas, err := formulario.normalizarRespostas(resposta.RespostasFormulario)
+			if err != nil {
+				return err
+			}
+			respostasDoConvidado = normalizadas
+		}
+		if resposta.Status == StatusRSVPRecusado {
+			respostasDoConvidado = nil
+		} else if err := formulario.validarObrigatorias(respostasDoConvidado); err != nil {
+			return err
+		}
+		respostasFinais[resposta.ConvidadoID] = respostasDoConvidado
+	}
+
+	algumConfirmado := false
+	for _, status := range statusFinal {
+		if status == StatusRSVPConfirmado {
+			algumConfirmado = true
+			break
+		}
+	}
+
+	// Regra 3: acompanhantes respeitam o limite do grupo e acompanham alguém confirmado.
+	var novosAcompanhantes []*Acompanhante
+	if acompanhantes != nil {
+		if len(acompanhantes) > g.limiteAcompanhantes {
+			return ErrLimiteAcompanhantesExcedido
+		}
+		if len(acompanhantes) > 0 && !algumConfirmado {
+			return ErrAcompanhantesSemConfirmacao
+		}
+		novosAcompanhantes = make([]*Acompanhante, len(acompanhantes))
+		for i, nome := range acompanhantes {
+			nome, valido := normalizarNome(nome)
+			if !valido {
+				return ErrNomeAcompanhanteInvalido
+			}
+			novosAcompanhantes[i] = &Acompanhante{id: uuid.New(), nome: nome}
+		}
 	}
 
 	// Segunda passagem: atualização. Ocorre apenas se toda a validação passou.
+	agora := time.Now()
+	g.respostasRegistradas = make([]RegistroRSVP, 0, len(respostas))
 	for _, resposta := range respostas {
 		convidado := convidadosDoGrupo[resposta.ConvidadoID]
+		g.respostasRegistradas = append(g.respostasRegistradas, RegistroRSVP{
+			ID:             uuid.New(),
+			IDGrupo:        g.id,
+			IDConvidado:    convidado.id,
+			NomeConvidado:  convidado.nome,
+			StatusAnterior: convidado.statusRSVP,
+			StatusNovo:     resposta.Status,
+			RegistradoEm:   agora,
+		})
 		convidado.statusRSVP = resposta.Status
+		convidado.respostasFormulario = respostasFinais[resposta.ConvidadoID]
+	}
+	switch {
+	case acompanhantes != nil:
+		g.acompanhantes = novosAcompanhantes
+	case !algumConfirmado:
+		g.acompanhantes = nil
+	}
+
+	g.ultimaRespostaEm = &agora
+	g.updatedAt = agora
+	return nil
+}
+
+// DefinirLimiteAcompanhantes altera quantos acompanhantes o grupo pode trazer.
+// O limite não pode ficar abaixo dos acompanhantes já informados.
+func (g *GrupoDeConvidados) DefinirLimiteAcompanhantes(limite int) error {
+	if limite < 0 || limite > MaximoAcompanhantesPorGrupo {
+		return ErrLimiteAcompanhantesInvalido
 	}
+	if limite < len(g.acompanhantes) {
+		return ErrLimiteMenorQueAcompanhantes
+	}
+	g.limiteAcompanhantes = limite
+	g.updatedAt = time.Now()
+	return nil
+}
 
+// RedefinirChaveDeAcesso troca a chave do grupo, invalidando a antiga.
+func (g *GrupoDeConvidados) RedefinirChaveDeAcesso(novaChave string) error {
+	if novaChave == "" {
+		return ErrChaveDeAcessoObrigatoria
+	}
+	g.chaveDeAcesso = novaChave
 	g.updatedAt = time.Now()
 	return nil
 }
@@ -187,11 +417,23 @@ func (g *GrupoDeConvidados) PodeSerRemovido() error {
 }
 
 // Getters para expor campos privados de forma controlada
-func (g *GrupoDeConvidados) ID() uuid.UUID            { return g.id }
-func (g *GrupoDeConvidados) IDCasamento() uuid.UUID   { return g.idCasamento }
-func (g *GrupoDeConvidados) ChaveDeAcesso() string    { return g.chaveDeAcesso }
-func (g *GrupoDeConvidados) Convidados() []*Convidado { return g.convidados }
-func (g *GrupoDeConvidados) CreatedAt() time.Time     { return g.createdAt }
-func (c *Convidado) ID() uuid.UUID                    { return c.id }
-func (c *Convidado) Nome() string                     { return c.nome }
-func (c *Convidado) StatusRSVP() string               { return c.statusRSVP }
+func (g *GrupoDeConvidados) ID() uuid.UUID                   { return g.id }
+func (g *GrupoDeConvidados) IDCasamento() uuid.UUID          { return g.idCasamento }
+func (g *GrupoDeConvidados) ChaveDeAcesso() string           { return g.chaveDeAcesso }
+func (g *GrupoDeConvidados) Convidados() []*Convidado        { return g.convidados }
+func (g *GrupoDeConvidados) LimiteAcompanhantes() int        { return g.limiteAcompanhantes }
+func (g *GrupoDeConvidados) Acompanhantes() []*Acompanhante  { return g.acompanhantes }
+func (g *GrupoDeConvidados) PrazoRSVPEstendido() *time.Time  { return g.prazoRSVPEstendido }
+func (g *GrupoDeConvidados) UltimaRespostaEm() *time.Time    { return g.ultimaRespostaEm }
+func (g *GrupoDeConvidados) CreatedAt() time.Time            { return g.createdAt }
+func (c *Convidado) ID() uuid.UUID                           { return c.id }
+func (c *Convidado) Nome() string                            { return c.nome }
+func (c *Convidado) StatusRSVP() string                      { return c.statusRSVP }
+func (c *Convidado) Telefone() string                        { return c.telefone }
+func (c *Convidado) Email() string                           { return c.email }
+func (c *Convidado) FaixaEtaria() string                     { return c.faixaEtaria }
+func (c *Convidado) Lado() string                            { return c.lado }
+func (c *Convidado) Observacoes() string                     { return c.observacoes }
+func (c *Convidado) RespostasFormulario() []RespostaPergunta { return c.respostasFormulario }
+func (a *Acompanhante) ID() uuid.UUID                        { return a.id }
+func (a *Acompanhante) Nome() string                         { return a.nome }
@@ -3,6 +3,7 @@ package domain
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -62,7 +63,7 @@ func TestGrupoDeConvidados_ConfirmarPresenca(t *testing.T) {
 			{ConvidadoID: convidado2ID, Status: StatusRSVPRecusado},
 		}
 
-		err := grupo.ConfirmarPresenca(respostas)
+		err := grupo.ConfirmarPresenca(respostas, nil)
 
 		assert.NoError(t, err)
 		assert.Equal(t, StatusRSVPConfirmado, grupo.Convidados()[0].StatusRSVP())
@@ -74,7 +75,7 @@ func TestGrupoDeConvidados_ConfirmarPresenca(t *testing.T) {
 			{ConvidadoID: convidado1ID, Status: "INVALIDO"},
 		}
 
-		err := grupo.ConfirmarPresenca(respostas)
+		err := grupo.ConfirmarPresenca(respostas, nil)
 
 		assert.Error(t, err)
 		assert.Equal(t, ErrStatusRSVPInvalido, err)
@@ -86,7 +87,7 @@ func TestGrupoDeConvidados_ConfirmarPresenca(t *testing.T) {
 			{ConvidadoID: convidadoInexistente, Status: StatusRSVPConfirmado},
 		}
 
-		err := grupo.ConfirmarPresenca(respostas)
+		err := grupo.ConfirmarPresenca(respostas, nil)
 
 		assert.Error(t, err)
 		assert.Equal(t, ErrConvidadoNaoEncontradoNoGrupo, err)
@@ -172,7 +173,7 @@ func TestGrupoDeConvidados_PodeSerRemovido(t *testing.T) {
 			{ConvidadoID: convidados[0].ID(), Status: StatusRSVPConfirmado},
 		}
 
-		err := grupo.ConfirmarPresenca(respostas)
+		err := grupo.ConfirmarPresenca(respostas, nil)
 		assert.NoError(t, err)
 
 		// Agora deve impedir remoção
@@ -192,7 +193,7 @@ func TestGrupoDeConvidados_PodeSerRemovido(t *testing.T) {
 			{ConvidadoID: convidados[0].ID(), Status: StatusRSVPRecusado},
 		}
 
-		err = grupo2.ConfirmarPresenca(respostas)
+		err = grupo2.ConfirmarPresenca(respostas, nil)
 		assert.NoError(t, err)
 
 		// Agora deve impedir remoção
@@ -201,3 +202,81 @@ func TestGrupoDeConvidados_PodeSerRemovido(t *testing.T) {
 		assert.Equal(t, ErrNaoPodeRemoverGrupoComRSVP, err)
 	})
 }
+
+func TestGrupoDeConvidados_Acompanhantes(t *testing.T) {
+	novoGrupo := func(t *testing.T, limite int) *GrupoDeConvidados {
+		grupo, err := NewGrupoDeConvidados(uuid.New(), "familia", []string{"João", "Maria"})
+		assert.NoError(t, err)
+		assert.NoError(t, grupo.DefinirLimiteAcompanhantes(limite))
+		return grupo
+	}
+	confirmarJoao := func(grupo *GrupoDeConvidados) []RespostaRSVP {
+		return []RespostaRSVP{{ConvidadoID: grupo.Convidados()[0].ID(), Status: StatusRSVPConfirmado}}
+	}
+
+	t.Run("deve registrar os acompanhantes dentro do limite", func(t *testing.T) {
+		grupo := novoGrupo(t, 2)
+
+		err := grupo.ConfirmarPresenca(confirmarJoao(grupo), []string{"  Ana   Souza ", "Pedro"})
+
+		assert.NoError(t, err)
+		assert.Len(t, grupo.Acompanhantes(), 2)
+		assert.Equal(t, "Ana Souza", grupo.Acompanhantes()[0].Nome())
+	})
+
+	t.Run("deve retornar erro se os acompanhantes excederem o limite", func(t *testing.T) {
+		grupo := novoGrupo(t, 1)
+
+		err := grupo.ConfirmarPresenca(confirmarJoao(grupo), []string{"Ana", "Pedro"})
+
+		assert.ErrorIs(t, err, ErrLimiteAcompanhantesExcedido)
+		assert.Equal(t, StatusRSVPPendente, grupo.Convidados()[0].StatusRSVP())
+	})
+
+	t.Run("deve retornar erro se nenhum convidado estiver confirmado", func(t *testing.T) {
+		grupo := novoGrupo(t, 1)
+		respostas := []RespostaRSVP{{ConvidadoID: grupo.Convidados()[0].ID(), Status: StatusRSVPRecusado}}
+
+		err := grupo.ConfirmarPresenca(respostas, []string{"Ana"})
+
+		assert.ErrorIs(t, err, ErrAcompanhantesSemConfirmacao)
+	})
+
+	t.Run("deve retornar erro se o nome do acompanhante for vazio", func(t *testing.T) {
+		grupo := novoGrupo(t, 1)
+
+		err := grupo.ConfirmarPresenca(confirmarJoao(grupo), []string{"   "})
+
+		assert.ErrorIs(t, err, ErrNomeAcompanhanteInvalido)
+	})
+
+	t.Run("deve manter os acompanhantes quando a lista for omitida e removê-los quando todos recusarem", func(t *testing.T) {
+		grupo := novoGrupo(t, 1)
+		assert.NoError(t, grupo.ConfirmarPresenca(confirmarJoao(grupo), []string{"Ana"}))
+
+		assert.NoError(t, grupo.ConfirmarPresenca(confirmarJoao(grupo), nil))
+		assert.Len(t, grupo.Acompanhantes(), 1)
+
+		recusa := []RespostaRSVP{{ConvidadoID: grupo.Convidados()[0].ID(), Status: StatusRSVPRecusado}}
+		assert.NoError(t, grupo.ConfirmarPresenca(recusa, nil))
+		assert.Empty(t, grupo.Acompanhantes())
+	})
+
+	t.Run("deve validar o novo limite de acompanhantes", func(t *testing.T) {
+		grupo := novoGrupo(t, 2)
+		assert.NoError(t, grupo.ConfirmarPresenca(confirmarJoao(grupo), []string{"Ana", "Pedro"}))
+
+		assert.ErrorIs(t, grupo.DefinirLimiteAcompanhantes(-1), ErrLimiteAcompanhantesInvalido)
+		assert.ErrorIs(t, grupo.DefinirLimiteAcompanhantes(MaximoAcompanhantesPorGrupo+1), ErrLimiteAcompanhantesInvalido)
+		assert.ErrorIs(t, grupo.DefinirLimiteAcompanhantes(1), ErrLimiteMenorQueAcompanhantes)
+		assert.NoError(t, grupo.DefinirLimiteAcompanhantes(3))
+		assert.Equal(t, 3, grupo.LimiteAcompanhantes())
+	})
+}
+
+// novoGrupoDeTeste monta um grupo já gravado, criado há uma hora, para os testes das
+// operações sobre o agregado.
+func novoGrupoDeTeste(idEvento uuid.UUID, chave string, limite int, acompanhantes []*Acompanhante, convidados ...*Convidado) *GrupoDeConvidados {
+	criadoEm := time.Now().Add(-time.Hour)
+	return HydrateGroup(uuid.New(), idEvento, chave, convidados, limite, acompanhantes, nil, nil, nil, criadoEm, criadoEm)
+}
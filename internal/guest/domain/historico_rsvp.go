@@ -0,0 +1,55 @@
+// file: internal/guest/domain/historico_rsvp.go
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Canais pelos quais uma resposta de RSVP chega.
+const (
+	CanalRSVPChaveDeAcesso = "CHAVE_DE_ACESSO"
+	CanalRSVPAnfitriao     = "ANFITRIAO"
+)
+
+// OrigemRSVP identifica quem registrou a resposta e de onde. IDUsuario só é
+// preenchido quando o anfitrião responde pelo grupo.
+type OrigemRSVP struct {
+	Canal     string
+	IDUsuario *uuid.UUID
+	IP        string
+	UserAgent string
+}
+
+// RegistroRSVP é uma entrada do histórico de respostas de um convidado. O histórico
+// só recebe inclusões: uma resposta nova nunca apaga as anteriores.
+type RegistroRSVP struct {
+	ID             uuid.UUID
+	IDGrupo        uuid.UUID
+	IDConvidado    uuid.UUID
+	NomeConvidado  string // Nome na data da resposta; o convidado pode ser renomeado ou removido depois
+	StatusAnterior string
+	StatusNovo     string
+	Origem         OrigemRSVP
+	RegistradoEm   time.Time
+}
+
+// FiltroHistoricoRSVP restringe a linha do tempo a um convidado; uuid.Nil traz todos.
+type FiltroHistoricoRSVP struct {
+	IDConvidado uuid.UUID
+}
+
+type HistoricoRSVPRepository interface {
+	// FindByGroupID devolve ErrGrupoNaoEncontrado se o grupo não pertencer ao usuário.
+	FindByGroupID(ctx context.Context, userID, groupID uuid.UUID, filtro FiltroHistoricoRSVP) ([]RegistroRSVP, error)
+	// FindByEventID devolve ErrEventoNaoEncontrado se o evento não pertencer ao usuário.
+	FindByEventID(ctx context.Context, userID, eventID uuid.UUID, filtro FiltroHistoricoRSVP) ([]RegistroRSVP, error)
+}
+
+// RespostasRegistradas devolve as respostas da última confirmação ainda não gravadas
+// no histórico, uma por convidado respondido, sem a origem.
+func (g *GrupoDeConvidados) RespostasRegistradas() []RegistroRSVP {
+	return g.respostasRegistradas
+}
@@ -0,0 +1,48 @@
+// file: internal/guest/domain/historico_rsvp_test.go
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrupoDeConvidados_RespostasRegistradas(t *testing.T) {
+	t.Run("deve registrar o status anterior e o novo de cada convidado respondido", func(t *testing.T) {
+		grupo, err := NewGrupoDeConvidados(uuid.New(), "familia", []string{"João", "Maria"})
+		assert.NoError(t, err)
+		joao, maria := grupo.Convidados()[0], grupo.Convidados()[1]
+
+		assert.NoError(t, grupo.ConfirmarPresenca([]RespostaRSVP{{ConvidadoID: joao.ID(), Status: StatusRSVPConfirmado}}, nil))
+		primeira := grupo.RespostasRegistradas()
+		assert.Len(t, primeira, 1)
+		assert.Equal(t, grupo.ID(), primeira[0].IDGrupo)
+		assert.Equal(t, joao.ID(), primeira[0].IDConvidado)
+		assert.Equal(t, "João", primeira[0].NomeConvidado)
+		assert.Equal(t, StatusRSVPPendente, primeira[0].StatusAnterior)
+		assert.Equal(t, StatusRSVPConfirmado, primeira[0].StatusNovo)
+
+		err = grupo.ConfirmarPresenca([]RespostaRSVP{
+			{ConvidadoID: joao.ID(), Status: StatusRSVPRecusado},
+			{ConvidadoID: maria.ID(), Status: StatusRSVPConfirmado},
+		}, nil)
+		assert.NoError(t, err)
+		segunda := grupo.RespostasRegistradas()
+		assert.Len(t, segunda, 2)
+		assert.Equal(t, StatusRSVPConfirmado, segunda[0].StatusAnterior)
+		assert.Equal(t, StatusRSVPRecusado, segunda[0].StatusNovo)
+		assert.NotEqual(t, primeira[0].ID, segunda[0].ID)
+		assert.Equal(t, StatusRSVPPendente, segunda[1].StatusAnterior)
+	})
+
+	t.Run("não deve registrar nada quando a resposta é recusada pela validação", func(t *testing.T) {
+		grupo, err := NewGrupoDeConvidados(uuid.New(), "familia", []string{"João"})
+		assert.NoError(t, err)
+
+		err = grupo.ConfirmarPresenca([]RespostaRSVP{{ConvidadoID: grupo.Convidados()[0].ID(), Status: "TALVEZ"}}, nil)
+
+		assert.ErrorIs(t, err, ErrStatusRSVPInvalido)
+		assert.Empty(t, grupo.RespostasRegistradas())
+	})
+}
@@ -0,0 +1,167 @@
+// file: internal/guest/domain/importacao.go
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Códigos dos problemas reportados na importação de convidados.
+const (
+	ProblemaLinhaInvalida      = "LINHA_INVALIDA"
+	ProblemaEmailInvalido      = "EMAIL_INVALIDO"
+	ProblemaTelefoneInvalido   = "TELEFONE_INVALIDO"
+	ProblemaConvidadoDuplicado = "CONVIDADO_DUPLICADO"
+	ProblemaChaveEmUsoNoEvento = "CHAVE_EM_USO"
+	tamanhoMaximoCampoTexto    = 255
+)
+
+var (
+	ErrImportacaoVazia        = errors.New("a planilha não contém convidados")
+	ErrImportacaoComProblemas = errors.New("a planilha contém linhas com problemas")
+	ErrImportacaoMuitoGrande  = errors.New("a planilha excede o limite de linhas por importação")
+)
+
+// MaximoLinhasPorImportacao limita o tamanho de uma importação (uma transação só).
+const MaximoLinhasPorImportacao = 5000
+
+// LinhaImportacao é uma linha da planilha já separada em colunas.
+// Numero é a linha original no arquivo, usada nos relatórios.
+type LinhaImportacao struct {
+	Numero        int
+	ChaveDeAcesso string
+	Nome          string
+	Telefone      string
+	Email         string
+	FaixaEtaria   string
+	Lado          string
+	Observacoes   string
+}
+
+// ProblemaImportacao descreve uma linha que impede a importação.
+type ProblemaImportacao struct {
+	Linha         int
+	Codigo        string
+	ChaveDeAcesso string
+	Mensagem      string
+}
+
+// PlanoImportacao é o resultado da análise da planilha: os grupos que seriam criados
+// e os problemas encontrados. Ele serve tanto para o dry-run quanto para a gravação.
+type PlanoImportacao struct {
+	TotalLinhas     int
+	TotalConvidados int
+	Grupos          []*GrupoDeConvidados
+	Problemas       []ProblemaImportacao
+}
+
+// TemProblemas indica se a importação deve ser recusada.
+func (p *PlanoImportacao) TemProblemas() bool { return len(p.Problemas) > 0 }
+
+// PlanejarImportacao agrupa as linhas pela chave de acesso e valida cada uma delas.
+// As chaves já existentes no evento são informadas para detectar colisões com a
+// restrição UNIQUE(id_evento, chave_de_acesso) antes de tocar no banco.
+func PlanejarImportacao(idEvento uuid.UUID, linhas []LinhaImportacao, chavesExistentes []string) (*PlanoImportacao, error) {
+	if len(linhas) == 0 {
+		return nil, ErrImportacaoVazia
+	}
+	if len(linhas) > MaximoLinhasPorImportacao {
+		return nil, fmt.Errorf("%w (%d)", ErrImportacaoMuitoGrande, MaximoLinhasPorImportacao)
+	}
+
+	existentes := make(map[string]bool, len(chavesExistentes))
+	for _, chave := range chavesExistentes {
+		existentes[chave] = true
+	}
+
+	plano := &PlanoImportacao{TotalLinhas: len(linhas)}
+	var ordemDasChaves []string
+	convidadosPorChave := make(map[string][]DadosConvidado)
+	nomesPorChave := make(map[string]map[string]int)
+	colisoesReportadas := make(map[string]bool)
+
+	for _, linha := range linhas {
+		chave := strings.TrimSpace(linha.ChaveDeAcesso)
+		dados, problema := validarLinha(chave, DadosConvidado{
+			Nome:        linha.Nome,
+			Telefone:    linha.Telefone,
+			Email:       linha.Email,
+			FaixaEtaria: linha.FaixaEtaria,
+			Lado:        linha.Lado,
+			Observacoes: linha.Observacoes,
+		})
+		if problema != nil {
+			problema.Linha = linha.Numero
+			problema.ChaveDeAcesso = chave
+			plano.Problemas = append(plano.Problemas, *problema)
+			continue
+		}
+
+		if existentes[chave] {
+			if !colisoesReportadas[chave] {
+				colisoesReportadas[chave] = true
+				plano.Problemas = append(plano.Problemas, ProblemaImportacao{
+					Linha:         linha.Numero,
+					Codigo:        ProblemaChaveEmUsoNoEvento,
+					ChaveDeAcesso: chave,
+					Mensagem:      "já existe um grupo com esta chave de acesso no evento",
+				})
+			}
+			continue
+		}
+
+		if _, ok := nomesPorChave[chave]; !ok {
+			nomesPorChave[chave] = make(map[string]int)
+			ordemDasChaves = append(ordemDasChaves, chave)
+		}
+		nome := dados.Nome
+		nomeNormalizado := strings.ToLower(nome)
+		if linhaOriginal, duplicado := nomesPorChave[chave][nomeNormalizado]; duplicado {
+			plano.Problemas = append(plano.Problemas, ProblemaImportacao{
+				Linha:         linha.Numero,
+				Codigo:        ProblemaConvidadoDuplicado,
+				ChaveDeAcesso: chave,
+				Mensagem:      fmt.Sprintf("convidado %q repetido no grupo (primeira ocorrência na linha %d)", nome, linhaOriginal),
+			})
+			continue
+		}
+		nomesPorChave[chave][nomeNormalizado] = linha.Numero
+		convidadosPorChave[chave] = append(convidadosPorChave[chave], dados)
+	}
+
+	for _, chave := range ordemDasChaves {
+		grupo, err := NewGrupoDeConvidadosComDados(idEvento, chave, convidadosPorChave[chave])
+		if err != nil {
+			return nil, fmt.Errorf("falha ao montar grupo %q: %w", chave, err)
+		}
+		plano.Grupos = append(plano.Grupos, grupo)
+		plano.TotalConvidados += len(grupo.Convidados())
+	}
+
+	return plano, nil
+}
+
+func validarLinha(chave string, dados DadosConvidado) (DadosConvidado, *ProblemaImportacao) {
+	switch {
+	case chave == "":
+		return dados, &ProblemaImportacao{Codigo: ProblemaLinhaInvalida, Mensagem: "chave de acesso ausente"}
+	case strings.TrimSpace(dados.Nome) == "":
+		return dados, &ProblemaImportacao{Codigo: ProblemaLinhaInvalida, Mensagem: "nome do convidado ausente"}
+	case utf8.RuneCountInString(chave) > tamanhoMaximoCampoTexto:
+		return dados, &ProblemaImportacao{Codigo: ProblemaLinhaInvalida, Mensagem: "chave de acesso muito longa"}
+	}
+	normalizados, err := dados.normalizar()
+	switch {
+	case errors.Is(err, ErrEmailInvalido):
+		return dados, &ProblemaImportacao{Codigo: ProblemaEmailInvalido, Mensagem: fmt.Sprintf("e-mail inválido: %q", strings.TrimSpace(dados.Email))}
+	case errors.Is(err, ErrTelefoneInvalido):
+		return dados, &ProblemaImportacao{Codigo: ProblemaTelefoneInvalido, Mensagem: fmt.Sprintf("telefone inválido: %q", strings.TrimSpace(dados.Telefone))}
+	case err != nil:
+		return dados, &ProblemaImportacao{Codigo: ProblemaLinhaInvalida, Mensagem: err.Error()}
+	}
+	return normalizados, nil
+}
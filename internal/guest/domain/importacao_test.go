@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanejarImportacao(t *testing.T) {
+	idEvento := uuid.New()
+
+	t.Run("deve agrupar as linhas pela chave de acesso mantendo a ordem", func(t *testing.T) {
+		linhas := []LinhaImportacao{
+			{Numero: 2, ChaveDeAcesso: "silva", Nome: "João Silva", Telefone: "+5511999990000"},
+			{Numero: 3, ChaveDeAcesso: "souza", Nome: "Ana Souza", Email: "ana@exemplo.com"},
+			{Numero: 4, ChaveDeAcesso: " silva ", Nome: "  Maria   Silva "},
+		}
+
+		plano, err := PlanejarImportacao(idEvento, linhas, nil)
+
+		assert.NoError(t, err)
+		assert.False(t, plano.TemProblemas())
+		assert.Equal(t, 3, plano.TotalLinhas)
+		assert.Equal(t, 3, plano.TotalConvidados)
+		assert.Len(t, plano.Grupos, 2)
+		assert.Equal(t, "silva", plano.Grupos[0].ChaveDeAcesso())
+		assert.Equal(t, idEvento, plano.Grupos[0].IDCasamento())
+		assert.Equal(t, "Maria Silva", plano.Grupos[0].Convidados()[1].Nome())
+		assert.Equal(t, "+5511999990000", plano.Grupos[0].Convidados()[0].Telefone())
+		assert.Equal(t, "ana@exemplo.com", plano.Grupos[1].Convidados()[0].Email())
+	})
+
+	t.Run("deve reportar linhas inválidas e e-mails malformados", func(t *testing.T) {
+		linhas := []LinhaImportacao{
+			{Numero: 2, ChaveDeAcesso: "", Nome: "Sem Chave"},
+			{Numero: 3, ChaveDeAcesso: "grupo", Nome: ""},
+			{Numero: 4, ChaveDeAcesso: "grupo", Nome: "Carlos", Email: "carlos@"},
+			{Numero: 5, ChaveDeAcesso: "grupo", Nome: "Bia"},
+		}
+
+		plano, err := PlanejarImportacao(idEvento, linhas, nil)
+
+		assert.NoError(t, err)
+		assert.Len(t, plano.Problemas, 3)
+		assert.Equal(t, ProblemaLinhaInvalida, plano.Problemas[0].Codigo)
+		assert.Equal(t, 2, plano.Problemas[0].Linha)
+		assert.Equal(t, ProblemaLinhaInvalida, plano.Problemas[1].Codigo)
+		assert.Equal(t, ProblemaEmailInvalido, plano.Problemas[2].Codigo)
+		assert.Len(t, plano.Grupos, 1)
+	})
+
+	t.Run("deve reportar convidado repetido no mesmo grupo", func(t *testing.T) {
+		linhas := []LinhaImportacao{
+			{Numero: 2, ChaveDeAcesso: "familia", Nome: "José"},
+			{Numero: 3, ChaveDeAcesso: "familia", Nome: "josé"},
+			{Numero: 4, ChaveDeAcesso: "amigos", Nome: "José"},
+		}
+
+		plano, err := PlanejarImportacao(idEvento, linhas, nil)
+
+		assert.NoError(t, err)
+		assert.Len(t, plano.Problemas, 1)
+		assert.Equal(t, ProblemaConvidadoDuplicado, plano.Problemas[0].Codigo)
+		assert.Equal(t, 3, plano.Problemas[0].Linha)
+		assert.Equal(t, 2, plano.TotalConvidados)
+	})
+
+	t.Run("deve reportar uma vez cada chave que já existe no evento", func(t *testing.T) {
+		linhas := []LinhaImportacao{
+			{Numero: 2, ChaveDeAcesso: "existente", Nome: "A"},
+			{Numero: 3, ChaveDeAcesso: "existente", Nome: "B"},
+			{Numero: 4, ChaveDeAcesso: "nova", Nome: "C"},
+		}
+
+		plano, err := PlanejarImportacao(idEvento, linhas, []string{"existente"})
+
+		assert.NoError(t, err)
+		assert.Len(t, plano.Problemas, 1)
+		assert.Equal(t, ProblemaChaveEmUsoNoEvento, plano.Problemas[0].Codigo)
+		assert.Equal(t, "existente", plano.Problemas[0].ChaveDeAcesso)
+		assert.Len(t, plano.Grupos, 1)
+	})
+
+	t.Run("deve recusar planilha vazia ou grande demais", func(t *testing.T) {
+		_, err := PlanejarImportacao(idEvento, nil, nil)
+		assert.ErrorIs(t, err, ErrImportacaoVazia)
+
+		_, err = PlanejarImportacao(idEvento, make([]LinhaImportacao, MaximoLinhasPorImportacao+1), nil)
+		assert.ErrorIs(t, err, ErrImportacaoMuitoGrande)
+	})
+}
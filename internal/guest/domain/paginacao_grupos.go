@@ -0,0 +1,124 @@
+// file: internal/guest/domain/paginacao_grupos.go
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Campos de ordenação da listagem de grupos.
+const (
+	OrdenarGruposPorCriacao     = "criadoEm"
+	OrdenarGruposPorAtualizacao = "atualizadoEm"
+	OrdenarGruposPorNome        = "nome"
+	OrdenarGruposPorStatus      = "status"
+)
+
+const (
+	OrdemCrescente   = "asc"
+	OrdemDecrescente = "desc"
+)
+
+const (
+	LimitePadraoGrupos = 50
+	LimiteMaximoGrupos = 200
+)
+
+var (
+	ErrOrdenacaoInvalida    = errors.New("ordenação inválida: use nome, criadoEm, atualizadoEm ou status, em ordem asc ou desc")
+	ErrLimitePaginaInvalido = errors.New("o limite da página deve ser entre 1 e 200")
+	ErrCursorInvalido       = errors.New("cursor inválido ou de outra ordenação")
+)
+
+// PaginacaoGrupos define a ordem e a página da listagem de grupos. O valor zero traz
+// todos os grupos, dos criados mais recentemente para os mais antigos.
+//
+// A paginação é por cursor: Cursor é o ProximoCursor da página anterior, e a página
+// seguinte começa logo depois do último grupo entregue, mesmo que grupos tenham sido
+// criados ou removidos entre as requisições.
+type PaginacaoGrupos struct {
+	OrdenarPor string
+	Ordem      string // vazio usa a ordem natural do campo: asc para nome e status, desc para as datas
+	Limite     int    // 0 traz todos os grupos
+	Cursor     string
+}
+
+// PosicaoGrupo identifica o último grupo de uma página: o valor do campo de ordenação,
+// no texto em que o banco o devolveu, e o ID que desempata grupos com o mesmo valor.
+type PosicaoGrupo struct {
+	Valor string
+	ID    uuid.UUID
+}
+
+// PaginaGrupos é uma página da listagem. Total conta todos os grupos que atendem ao
+// filtro, não só os da página; ProximoCursor é vazio na última página.
+type PaginaGrupos struct {
+	Grupos        []*GrupoDeConvidados
+	Total         int
+	ProximoCursor string
+}
+
+type cursorGrupos struct {
+	OrdenarPor string    `json:"o"`
+	Ordem      string    `json:"d"`
+	Valor      string    `json:"v"`
+	ID         uuid.UUID `json:"id"`
+}
+
+// Validar completa a ordenação padrão e recusa valores desconhecidos e cursores que
+// não foram gerados para a mesma ordenação.
+func (p *PaginacaoGrupos) Validar() error {
+	switch p.OrdenarPor = strings.TrimSpace(p.OrdenarPor); p.OrdenarPor {
+	case "":
+		p.OrdenarPor = OrdenarGruposPorCriacao
+	case OrdenarGruposPorCriacao, OrdenarGruposPorAtualizacao, OrdenarGruposPorNome, OrdenarGruposPorStatus:
+	default:
+		return ErrOrdenacaoInvalida
+	}
+
+	switch p.Ordem = strings.ToLower(strings.TrimSpace(p.Ordem)); p.Ordem {
+	case "":
+		p.Ordem = OrdemDecrescente
+		if p.OrdenarPor == OrdenarGruposPorNome || p.OrdenarPor == OrdenarGruposPorStatus {
+			p.Ordem = OrdemCrescente
+		}
+	case OrdemCrescente, OrdemDecrescente:
+	default:
+		return ErrOrdenacaoInvalida
+	}
+
+	if p.Limite < 0 || p.Limite > LimiteMaximoGrupos {
+		return ErrLimitePaginaInvalido
+	}
+	_, err := p.Posicao()
+	return err
+}
+
+// Posicao decodifica o cursor; nil significa a primeira página.
+func (p PaginacaoGrupos) Posicao() (*PosicaoGrupo, error) {
+	if p.Cursor == "" {
+		return nil, nil
+	}
+	dados, err := base64.RawURLEncoding.DecodeString(p.Cursor)
+	if err != nil {
+		return nil, ErrCursorInvalido
+	}
+	var c cursorGrupos
+	if err := json.Unmarshal(dados, &c); err != nil || c.ID == uuid.Nil {
+		return nil, ErrCursorInvalido
+	}
+	if c.OrdenarPor != p.OrdenarPor || c.Ordem != p.Ordem {
+		return nil, ErrCursorInvalido
+	}
+	return &PosicaoGrupo{Valor: c.Valor, ID: c.ID}, nil
+}
+
+// CursorApos gera o cursor da página que começa depois do grupo na posição informada.
+func (p PaginacaoGrupos) CursorApos(posicao PosicaoGrupo) string {
+	dados, _ := json.Marshal(cursorGrupos{OrdenarPor: p.OrdenarPor, Ordem: p.Ordem, Valor: posicao.Valor, ID: posicao.ID})
+	return base64.RawURLEncoding.EncodeToString(dados)
+}
@@ -0,0 +1,76 @@
+// file: internal/guest/domain/paginacao_grupos_test.go
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaginacaoGrupos_Validar(t *testing.T) {
+	t.Run("deve ordenar pelos criados mais recentemente quando nada é informado", func(t *testing.T) {
+		paginacao := PaginacaoGrupos{}
+
+		assert.NoError(t, paginacao.Validar())
+		assert.Equal(t, OrdenarGruposPorCriacao, paginacao.OrdenarPor)
+		assert.Equal(t, OrdemDecrescente, paginacao.Ordem)
+	})
+
+	t.Run("deve usar a ordem natural de cada campo", func(t *testing.T) {
+		nome := PaginacaoGrupos{OrdenarPor: OrdenarGruposPorNome}
+		status := PaginacaoGrupos{OrdenarPor: OrdenarGruposPorStatus}
+		atualizacao := PaginacaoGrupos{OrdenarPor: OrdenarGruposPorAtualizacao, Ordem: " ASC "}
+
+		assert.NoError(t, nome.Validar())
+		assert.NoError(t, status.Validar())
+		assert.NoError(t, atualizacao.Validar())
+		assert.Equal(t, OrdemCrescente, nome.Ordem)
+		assert.Equal(t, OrdemCrescente, status.Ordem)
+		assert.Equal(t, OrdemCrescente, atualizacao.Ordem)
+	})
+
+	t.Run("deve recusar ordenação e limite inválidos", func(t *testing.T) {
+		assert.Equal(t, ErrOrdenacaoInvalida, (&PaginacaoGrupos{OrdenarPor: "chave"}).Validar())
+		assert.Equal(t, ErrOrdenacaoInvalida, (&PaginacaoGrupos{Ordem: "aleatoria"}).Validar())
+		assert.Equal(t, ErrLimitePaginaInvalido, (&PaginacaoGrupos{Limite: -1}).Validar())
+		assert.Equal(t, ErrLimitePaginaInvalido, (&PaginacaoGrupos{Limite: LimiteMaximoGrupos + 1}).Validar())
+	})
+
+	t.Run("deve recusar cursor malformado", func(t *testing.T) {
+		assert.Equal(t, ErrCursorInvalido, (&PaginacaoGrupos{Cursor: "não é base64"}).Validar())
+		assert.Equal(t, ErrCursorInvalido, (&PaginacaoGrupos{Cursor: "e30"}).Validar()) // {}
+	})
+}
+
+func TestPaginacaoGrupos_Cursor(t *testing.T) {
+	t.Run("deve devolver a posição do último grupo da página", func(t *testing.T) {
+		paginacao := PaginacaoGrupos{OrdenarPor: OrdenarGruposPorNome, Limite: 20}
+		assert.NoError(t, paginacao.Validar())
+		posicao := PosicaoGrupo{Valor: "ana silva", ID: uuid.New()}
+
+		seguinte := paginacao
+		seguinte.Cursor = paginacao.CursorApos(posicao)
+		assert.NoError(t, seguinte.Validar())
+		decodificada, err := seguinte.Posicao()
+
+		assert.NoError(t, err)
+		assert.Equal(t, &posicao, decodificada)
+	})
+
+	t.Run("deve recusar o cursor gerado para outra ordenação", func(t *testing.T) {
+		porNome := PaginacaoGrupos{OrdenarPor: OrdenarGruposPorNome}
+		assert.NoError(t, porNome.Validar())
+		cursor := porNome.CursorApos(PosicaoGrupo{Valor: "ana", ID: uuid.New()})
+
+		assert.Equal(t, ErrCursorInvalido, (&PaginacaoGrupos{OrdenarPor: OrdenarGruposPorStatus, Cursor: cursor}).Validar())
+		assert.Equal(t, ErrCursorInvalido, (&PaginacaoGrupos{OrdenarPor: OrdenarGruposPorNome, Ordem: OrdemDecrescente, Cursor: cursor}).Validar())
+	})
+
+	t.Run("não deve ter posição na primeira página", func(t *testing.T) {
+		posicao, err := PaginacaoGrupos{}.Posicao()
+
+		assert.NoError(t, err)
+		assert.Nil(t, posicao)
+	})
+}
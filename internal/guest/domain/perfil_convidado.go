@@ -0,0 +1,292 @@
+// file: internal/guest/domain/perfil_convidado.go
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Campos do cadastro que o anfitrião pode liberar para o próprio grupo editar pelo link.
+const (
+	CampoPerfilNome          = "NOME"
+	CampoPerfilTelefone      = "TELEFONE"
+	CampoPerfilEmail         = "EMAIL"
+	CampoPerfilAcompanhantes = "ACOMPANHANTES"
+)
+
+// camposPerfil fixa a ordem em que os campos liberados são devolvidos.
+var camposPerfil = []string{CampoPerfilNome, CampoPerfilTelefone, CampoPerfilEmail, CampoPerfilAcompanhantes}
+
+var (
+	ErrCampoPerfilInvalido          = errors.New("campo de perfil inválido: use NOME, TELEFONE, EMAIL ou ACOMPANHANTES")
+	ErrCampoPerfilNaoEditavel       = errors.New("o anfitrião não liberou a edição deste campo")
+	ErrAcompanhanteNaoEncontrado    = errors.New("um ou mais acompanhantes não pertencem a este grupo")
+	ErrAlteracaoPerfilNaoEncontrada = errors.New("alteração de perfil não encontrada")
+)
+
+// ConfiguracaoPerfil define quais campos o grupo pode editar pelo link de acesso.
+// Um evento sem configuração não libera nenhum campo.
+type ConfiguracaoPerfil struct {
+	idEvento uuid.UUID
+	campos   []string
+}
+
+// NewConfiguracaoPerfil valida os campos liberados; repetições são ignoradas.
+func NewConfiguracaoPerfil(idEvento uuid.UUID, campos []string) (*ConfiguracaoPerfil, error) {
+	liberados := make(map[string]bool, len(campos))
+	for _, campo := range campos {
+		if !campoPerfilConhecido(campo) {
+			return nil, ErrCampoPerfilInvalido
+		}
+		liberados[campo] = true
+	}
+	config := &ConfiguracaoPerfil{idEvento: idEvento, campos: []string{}}
+	for _, campo := range camposPerfil {
+		if liberados[campo] {
+			config.campos = append(config.campos, campo)
+		}
+	}
+	return config, nil
+}
+
+func HydrateConfiguracaoPerfil(idEvento uuid.UUID, campos []string) *ConfiguracaoPerfil {
+	if campos == nil {
+		campos = []string{}
+	}
+	return &ConfiguracaoPerfil{idEvento: idEvento, campos: campos}
+}
+
+func campoPerfilConhecido(campo string) bool {
+	for _, c := range camposPerfil {
+		if c == campo {
+			return true
+		}
+	}
+	return false
+}
+
+// Permite informa se o grupo pode editar o campo; uma configuração nil não permite nada.
+func (c *ConfiguracaoPerfil) Permite(campo string) bool {
+	if c == nil {
+		return false
+	}
+	for _, liberado := range c.campos {
+		if liberado == campo {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *ConfiguracaoPerfil) IDEvento() uuid.UUID { return c.idEvento }
+func (c *ConfiguracaoPerfil) Campos() []string    { return c.campos }
+
+// AlteracaoConvidado traz os dados que o convidado quer corrigir; campos nil ficam como estão.
+// Telefone e e-mail vazios removem o contato.
+type AlteracaoConvidado struct {
+	ID       uuid.UUID
+	Nome     *string
+	Telefone *string
+	Email    *string
+}
+
+// AcompanhanteParaPerfil é um acompanhante da lista enviada pelo grupo. Com ID, o
+// acompanhante existente é mantido com o nome informado; sem ID, é incluído.
+type AcompanhanteParaPerfil struct {
+	ID   uuid.UUID
+	Nome string
+}
+
+// AlteracaoPerfil é o que o grupo envia pelo link. Acompanhantes nil mantém a lista
+// atual; caso contrário, a lista enviada a substitui e os omitidos são removidos.
+type AlteracaoPerfil struct {
+	Convidados    []AlteracaoConvidado
+	Acompanhantes []AcompanhanteParaPerfil
+}
+
+// RegistroAlteracaoPerfil é uma mudança feita pelo grupo, guardada para o anfitrião
+// revisar. Nas mudanças de acompanhantes, IDConvidado é nil e o valor vazio indica
+// inclusão (ValorAnterior) ou remoção (ValorNovo).
+type RegistroAlteracaoPerfil struct {
+	ID            uuid.UUID
+	IDGrupo       uuid.UUID
+	IDConvidado   *uuid.UUID
+	NomeConvidado string // Nome do convidado já com a alteração
+	Campo         string
+	ValorAnterior string
+	ValorNovo     string
+	Origem        OrigemRSVP // Só IP e UserAgent são preenchidos
+	RegistradoEm  time.Time
+	RevisadaEm    *time.Time
+}
+
+// FiltroAlteracoesPerfil restringe a listagem às alterações que o anfitrião ainda não revisou.
+type FiltroAlteracoesPerfil struct {
+	SomentePendentes bool
+}
+
+type PerfilConvidadoRepository interface {
+	// FindConfiguracaoByEventID é usado no link público e devolve uma configuração vazia se não houver.
+	FindConfiguracaoByEventID(ctx context.Context, eventID uuid.UUID) (*ConfiguracaoPerfil, error)
+	// FindConfiguracaoByEventIDForUser verifica a propriedade do evento antes de carregar.
+	FindConfiguracaoByEventIDForUser(ctx context.Context, userID, eventID uuid.UUID) (*ConfiguracaoPerfil, error)
+	SaveConfiguracao(ctx context.Context, userID uuid.UUID, config *ConfiguracaoPerfil) error
+	// FindAlteracoesByEventID devolve ErrEventoNaoEncontrado se o evento não pertencer ao usuário.
+	FindAlteracoesByEventID(ctx context.Context, userID, eventID uuid.UUID, filtro FiltroAlteracoesPerfil) ([]RegistroAlteracaoPerfil, error)
+	// MarcarRevisada devolve ErrAlteracaoPerfilNaoEncontrada se a alteração não for de um evento do usuário.
+	MarcarRevisada(ctx context.Context, userID, alteracaoID uuid.UUID, revisadaEm time.Time) error
+}
+
+// AtualizarPerfil aplica as correções enviadas pelo grupo, restritas aos campos que a
+// configuração libera. Nada é alterado se alguma correção for inválida. Cada valor que
+// de fato mudou gera um registro em AlteracoesPerfil.
+func (g *GrupoDeConvidados) AtualizarPerfil(config *ConfiguracaoPerfil, alteracao AlteracaoPerfil) error {
+	convidadosDoGrupo := make(map[uuid.UUID]*Convidado, len(g.convidados))
+	for _, c := range g.convidados {
+		convidadosDoGrupo[c.id] = c
+	}
+
+	// Primeira passagem: validação, sem tocar no agregado.
+	novosDados := make(map[uuid.UUID]DadosConvidado, len(alteracao.Convidados))
+	for _, a := range alteracao.Convidados {
+		convidado, ok := convidadosDoGrupo[a.ID]
+		if !ok {
+			return ErrConvidadoNaoEncontradoNoGrupo
+		}
+		dados, ok := novosDados[a.ID]
+		if !ok {
+			dados = convidado.Dados()
+		}
+		var err error
+		if a.Nome != nil {
+			if !config.Permite(CampoPerfilNome) {
+				return ErrCampoPerfilNaoEditavel
+			}
+			var valido bool
+			if dados.Nome, valido = normalizarNome(*a.Nome); !valido {
+				return ErrNomeConvidadoInvalido
+			}
+		}
+		if a.Telefone != nil {
+			if !config.Permite(CampoPerfilTelefone) {
+				return ErrCampoPerfilNaoEditavel
+			}
+			if dados.Telefone, err = normalizarTelefone(*a.Telefone); err != nil {
+				return err
+			}
+		}
+		if a.Email != nil {
+			if !config.Permite(CampoPerfilEmail) {
+				return ErrCampoPerfilNaoEditavel
+			}
+			if dados.Email, err = normalizarEmail(*a.Email); err != nil {
+				return err
+			}
+		}
+		novosDados[a.ID] = dados
+	}
+
+	var novosAcompanhantes []*Acompanhante
+	if alteracao.Acompanhantes != nil {
+		if !config.Permite(CampoPerfilAcompanhantes) {
+			return ErrCampoPerfilNaoEditavel
+		}
+		var err error
+		if novosAcompanhantes, err = g.validarAcompanhantesDoPerfil(alteracao.Acompanhantes); err != nil {
+			return err
+		}
+	}
+
+	// Segunda passagem: registra o que mudou e aplica.
+	agora := time.Now()
+	var registros []RegistroAlteracaoPerfil
+	registrar := func(idConvidado *uuid.UUID, nomeConvidado, campo, anterior, novo string) {
+		if anterior == novo {
+			return
+		}
+		registros = append(registros, RegistroAlteracaoPerfil{
+			ID:            uuid.New(),
+			IDGrupo:       g.id,
+			IDConvidado:   idConvidado,
+			NomeConvidado: nomeConvidado,
+			Campo:         campo,
+			ValorAnterior: anterior,
+			ValorNovo:     novo,
+			RegistradoEm:  agora,
+		})
+	}
+	for _, c := range g.convidados {
+		dados, ok := novosDados[c.id]
+		if !ok {
+			continue
+		}
+		id := c.id
+		registrar(&id, dados.Nome, CampoPerfilNome, c.nome, dados.Nome)
+		registrar(&id, dados.Nome, CampoPerfilTelefone, c.telefone, dados.Telefone)
+		registrar(&id, dados.Nome, CampoPerfilEmail, c.email, dados.Email)
+		c.aplicarDados(dados)
+	}
+	if novosAcompanhantes != nil {
+		anteriores := make(map[uuid.UUID]string, len(g.acompanhantes))
+		for _, a := range g.acompanhantes {
+			anteriores[a.id] = a.nome
+		}
+		for _, a := range novosAcompanhantes {
+			registrar(nil, "", CampoPerfilAcompanhantes, anteriores[a.id], a.nome)
+			delete(anteriores, a.id)
+		}
+		for _, a := range g.acompanhantes {
+			if nome, removido := anteriores[a.id]; removido {
+				registrar(nil, "", CampoPerfilAcompanhantes, nome, "")
+			}
+		}
+		g.acompanhantes = novosAcompanhantes
+	}
+
+	g.alteracoesPerfil = registros
+	if len(registros) > 0 {
+		g.updatedAt = agora
+	}
+	return nil
+}
+
+// validarAcompanhantesDoPerfil monta a nova lista de acompanhantes com as regras do RSVP:
+// o limite do grupo e ao menos um convidado confirmado.
+func (g *GrupoDeConvidados) validarAcompanhantesDoPerfil(lista []AcompanhanteParaPerfil) ([]*Acompanhante, error) {
+	if len(lista) > g.limiteAcompanhantes {
+		return nil, ErrLimiteAcompanhantesExcedido
+	}
+	if len(lista) > 0 && !algumConfirmado(g.convidados) {
+		return nil, ErrAcompanhantesSemConfirmacao
+	}
+
+	atuais := make(map[uuid.UUID]bool, len(g.acompanhantes))
+	for _, a := range g.acompanhantes {
+		atuais[a.id] = true
+	}
+	vistos := make(map[uuid.UUID]bool, len(lista))
+	novos := make([]*Acompanhante, len(lista))
+	for i, a := range lista {
+		nome, valido := normalizarNome(a.Nome)
+		if !valido {
+			return nil, ErrNomeAcompanhanteInvalido
+		}
+		id := a.ID
+		if id == uuid.Nil {
+			id = uuid.New()
+		} else if !atuais[id] || vistos[id] {
+			return nil, ErrAcompanhanteNaoEncontrado
+		}
+		vistos[id] = true
+		novos[i] = &Acompanhante{id: id, nome: nome}
+	}
+	return novos, nil
+}
+
+// AlteracoesPerfil devolve as mudanças da última atualização de perfil, ainda não gravadas.
+func (g *GrupoDeConvidados) AlteracoesPerfil() []RegistroAlteracaoPerfil {
+	return g.alteracoesPerfil
+}
@@ -0,0 +1,148 @@
+// file: internal/guest/domain/perfil_convidado_test.go
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewConfiguracaoPerfil(t *testing.T) {
+	t.Run("deve ignorar repetições e manter a ordem dos campos", func(t *testing.T) {
+		config, err := NewConfiguracaoPerfil(uuid.New(), []string{CampoPerfilAcompanhantes, CampoPerfilTelefone, CampoPerfilAcompanhantes})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{CampoPerfilTelefone, CampoPerfilAcompanhantes}, config.Campos())
+		assert.True(t, config.Permite(CampoPerfilTelefone))
+		assert.False(t, config.Permite(CampoPerfilNome))
+	})
+
+	t.Run("deve recusar campo desconhecido", func(t *testing.T) {
+		config, err := NewConfiguracaoPerfil(uuid.New(), []string{"FAIXA_ETARIA"})
+
+		assert.Equal(t, ErrCampoPerfilInvalido, err)
+		assert.Nil(t, config)
+	})
+
+	t.Run("não deve permitir nada sem configuração", func(t *testing.T) {
+		var config *ConfiguracaoPerfil
+
+		assert.False(t, config.Permite(CampoPerfilNome))
+	})
+}
+
+func novoCarlosParaPerfil(statusRSVP string) *Convidado {
+	return HydrateConvidado(uuid.New(), statusRSVP, DadosConvidado{Nome: "Carlos Silva", Telefone: "+5511999990000"}, nil)
+}
+
+func TestGrupoDeConvidados_AtualizarPerfil(t *testing.T) {
+	todos, _ := NewConfiguracaoPerfil(uuid.New(), []string{CampoPerfilNome, CampoPerfilTelefone, CampoPerfilEmail, CampoPerfilAcompanhantes})
+	texto := func(s string) *string { return &s }
+
+	t.Run("deve registrar só os valores que mudaram", func(t *testing.T) {
+		grupo := novoGrupoDeTeste(uuid.New(), "familia", 2, nil, novoCarlosParaPerfil(StatusRSVPPendente))
+		convidado := grupo.Convidados()[0]
+
+		err := grupo.AtualizarPerfil(todos, AlteracaoPerfil{Convidados: []AlteracaoConvidado{{
+			ID:       convidado.ID(),
+			Nome:     texto(" Carlos  Silva "),
+			Telefone: texto("(11) 91234-5678"),
+			Email:    texto(""),
+		}}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "+5511912345678", convidado.Telefone())
+		registros := grupo.AlteracoesPerfil()
+		if assert.Len(t, registros, 1) {
+			assert.Equal(t, CampoPerfilTelefone, registros[0].Campo)
+			assert.Equal(t, "+5511999990000", registros[0].ValorAnterior)
+			assert.Equal(t, "+5511912345678", registros[0].ValorNovo)
+			assert.Equal(t, convidado.ID(), *registros[0].IDConvidado)
+		}
+	})
+
+	t.Run("deve recusar campo não liberado", func(t *testing.T) {
+		soTelefone, _ := NewConfiguracaoPerfil(uuid.New(), []string{CampoPerfilTelefone})
+		grupo := novoGrupoDeTeste(uuid.New(), "familia", 2, nil, novoCarlosParaPerfil(StatusRSVPPendente))
+
+		err := grupo.AtualizarPerfil(soTelefone, AlteracaoPerfil{Convidados: []AlteracaoConvidado{{ID: grupo.Convidados()[0].ID(), Nome: texto("Outro")}}})
+
+		assert.Equal(t, ErrCampoPerfilNaoEditavel, err)
+		assert.Equal(t, "Carlos Silva", grupo.Convidados()[0].Nome())
+	})
+
+	t.Run("não deve alterar nada se uma correção for inválida", func(t *testing.T) {
+		grupo := novoGrupoDeTeste(uuid.New(), "familia", 2, nil, novoCarlosParaPerfil(StatusRSVPPendente))
+		id := grupo.Convidados()[0].ID()
+
+		err := grupo.AtualizarPerfil(todos, AlteracaoPerfil{Convidados: []AlteracaoConvidado{
+			{ID: id, Nome: texto("Carlos Souza")},
+			{ID: id, Telefone: texto("9999-0000")},
+		}})
+
+		assert.Equal(t, ErrTelefoneInvalido, err)
+		assert.Equal(t, "Carlos Silva", grupo.Convidados()[0].Nome())
+		assert.Empty(t, grupo.AlteracoesPerfil())
+	})
+
+	t.Run("deve recusar convidado de outro grupo", func(t *testing.T) {
+		grupo := novoGrupoDeTeste(uuid.New(), "familia", 2, nil, novoCarlosParaPerfil(StatusRSVPPendente))
+
+		err := grupo.AtualizarPerfil(todos, AlteracaoPerfil{Convidados: []AlteracaoConvidado{{ID: uuid.New(), Nome: texto("Ana")}}})
+
+		assert.Equal(t, ErrConvidadoNaoEncontradoNoGrupo, err)
+	})
+
+	t.Run("deve renomear, incluir e remover acompanhantes", func(t *testing.T) {
+		paula := HydrateAcompanhante(uuid.New(), "Paula Lima")
+		marcos := HydrateAcompanhante(uuid.New(), "Marcos Lima")
+		grupo := novoGrupoDeTeste(uuid.New(), "familia", 2, []*Acompanhante{paula, marcos}, novoCarlosParaPerfil(StatusRSVPConfirmado))
+
+		err := grupo.AtualizarPerfil(todos, AlteracaoPerfil{Acompanhantes: []AcompanhanteParaPerfil{
+			{ID: paula.ID(), Nome: "Paula Lima Souza"},
+			{Nome: "Bruno Costa"},
+		}})
+
+		assert.NoError(t, err)
+		acompanhantes := grupo.Acompanhantes()
+		if assert.Len(t, acompanhantes, 2) {
+			assert.Equal(t, paula.ID(), acompanhantes[0].ID())
+			assert.Equal(t, "Paula Lima Souza", acompanhantes[0].Nome())
+			assert.NotEqual(t, uuid.Nil, acompanhantes[1].ID())
+		}
+		registros := grupo.AlteracoesPerfil()
+		if assert.Len(t, registros, 3) {
+			assert.Equal(t, "Paula Lima", registros[0].ValorAnterior)
+			assert.Equal(t, "", registros[1].ValorAnterior)
+			assert.Equal(t, "Bruno Costa", registros[1].ValorNovo)
+			assert.Equal(t, "Marcos Lima", registros[2].ValorAnterior)
+			assert.Equal(t, "", registros[2].ValorNovo)
+			assert.Nil(t, registros[2].IDConvidado)
+		}
+	})
+
+	t.Run("deve manter os acompanhantes quando a lista é omitida", func(t *testing.T) {
+		paula := HydrateAcompanhante(uuid.New(), "Paula Lima")
+		grupo := novoGrupoDeTeste(uuid.New(), "familia", 2, []*Acompanhante{paula}, novoCarlosParaPerfil(StatusRSVPConfirmado))
+
+		err := grupo.AtualizarPerfil(todos, AlteracaoPerfil{})
+
+		assert.NoError(t, err)
+		assert.Len(t, grupo.Acompanhantes(), 1)
+		assert.Empty(t, grupo.AlteracoesPerfil())
+	})
+
+	t.Run("deve aplicar as regras de acompanhantes do RSVP", func(t *testing.T) {
+		paula := HydrateAcompanhante(uuid.New(), "Paula Lima")
+		confirmado := novoGrupoDeTeste(uuid.New(), "familia", 2, []*Acompanhante{paula}, novoCarlosParaPerfil(StatusRSVPConfirmado))
+		pendente := novoGrupoDeTeste(uuid.New(), "familia", 2, nil, novoCarlosParaPerfil(StatusRSVPPendente))
+
+		acimaDoLimite := []AcompanhanteParaPerfil{{Nome: "A"}, {Nome: "B"}, {Nome: "C"}}
+		assert.Equal(t, ErrLimiteAcompanhantesExcedido, confirmado.AtualizarPerfil(todos, AlteracaoPerfil{Acompanhantes: acimaDoLimite}))
+		assert.Equal(t, ErrAcompanhantesSemConfirmacao, pendente.AtualizarPerfil(todos, AlteracaoPerfil{Acompanhantes: []AcompanhanteParaPerfil{{Nome: "Ana"}}}))
+		assert.Equal(t, ErrAcompanhanteNaoEncontrado, confirmado.AtualizarPerfil(todos, AlteracaoPerfil{Acompanhantes: []AcompanhanteParaPerfil{{ID: uuid.New(), Nome: "Ana"}}}))
+		assert.Equal(t, ErrAcompanhanteNaoEncontrado, confirmado.AtualizarPerfil(todos, AlteracaoPerfil{Acompanhantes: []AcompanhanteParaPerfil{{ID: paula.ID(), Nome: "Paula"}, {ID: paula.ID(), Nome: "Paula"}}}))
+		assert.Equal(t, "Paula Lima", confirmado.Acompanhantes()[0].Nome())
+	})
+}
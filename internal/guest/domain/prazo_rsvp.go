@@ -0,0 +1,71 @@
+// file: internal/guest/domain/prazo_rsvp.go
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrPrazoRSVPEncerrado = errors.New("o prazo para responder ao convite foi encerrado")
+
+// PrazoRSVP devolve o prazo que vale para o grupo: o do evento ou, se for mais tarde,
+// a prorrogação concedida ao grupo. nil significa que não há prazo.
+func (g *GrupoDeConvidados) PrazoRSVP(prazoEvento *time.Time) *time.Time {
+	if prazoEvento == nil {
+		return nil
+	}
+	if g.prazoRSVPEstendido != nil && g.prazoRSVPEstendido.After(*prazoEvento) {
+		return g.prazoRSVPEstendido
+	}
+	return prazoEvento
+}
+
+// VerificarPrazoRSVP recusa respostas do próprio grupo depois do prazo. O anfitrião
+// continua podendo registrar respostas em nome do grupo.
+func (g *GrupoDeConvidados) VerificarPrazoRSVP(prazoEvento *time.Time, agora time.Time) error {
+	if prazo := g.PrazoRSVP(prazoEvento); prazo != nil && agora.After(*prazo) {
+		return ErrPrazoRSVPEncerrado
+	}
+	return nil
+}
+
+// EstenderPrazoRSVP concede ao grupo um prazo próprio; nil remove a prorrogação.
+func (g *GrupoDeConvidados) EstenderPrazoRSVP(prazo *time.Time) {
+	g.prazoRSVPEstendido = prazo
+	g.updatedAt = time.Now()
+}
+
+// AtrasoRSVP descreve um grupo que não respondeu no prazo ou que respondeu depois dele.
+type AtrasoRSVP struct {
+	Grupo               *GrupoDeConvidados
+	PrazoEfetivo        *time.Time
+	ConvidadosPendentes []*Convidado
+	// RespondeuAposPrazo indica a última resposta registrada depois do prazo do evento,
+	// por prorrogação ou pelo anfitrião.
+	RespondeuAposPrazo bool
+}
+
+// ClassificarAtrasos seleciona os grupos com convidados pendentes cujo prazo já passou
+// e os que responderam depois do prazo do evento. Sem prazo no evento, ninguém está atrasado.
+func ClassificarAtrasos(grupos []*GrupoDeConvidados, prazoEvento *time.Time, agora time.Time) []AtrasoRSVP {
+	atrasos := []AtrasoRSVP{}
+	if prazoEvento == nil {
+		return atrasos
+	}
+	for _, g := range grupos {
+		prazo := g.PrazoRSVP(prazoEvento)
+		atraso := AtrasoRSVP{Grupo: g, PrazoEfetivo: prazo}
+		if agora.After(*prazo) {
+			for _, c := range g.convidados {
+				if c.statusRSVP == StatusRSVPPendente {
+					atraso.ConvidadosPendentes = append(atraso.ConvidadosPendentes, c)
+				}
+			}
+		}
+		atraso.RespondeuAposPrazo = g.ultimaRespostaEm != nil && g.ultimaRespostaEm.After(*prazoEvento)
+		if len(atraso.ConvidadosPendentes) > 0 || atraso.RespondeuAposPrazo {
+			atrasos = append(atrasos, atraso)
+		}
+	}
+	return atrasos
+}
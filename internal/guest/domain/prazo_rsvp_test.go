@@ -0,0 +1,117 @@
+// file: internal/guest/domain/prazo_rsvp_test.go
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrupoDeConvidados_PrazoRSVP(t *testing.T) {
+	prazoEvento := time.Date(2026, 5, 1, 23, 59, 0, 0, time.UTC)
+
+	novoGrupo := func(t *testing.T) *GrupoDeConvidados {
+		grupo, err := NewGrupoDeConvidados(uuid.New(), "familia", []string{"João", "Maria"})
+		assert.NoError(t, err)
+		return grupo
+	}
+
+	t.Run("sem prazo no evento, o grupo pode responder a qualquer momento", func(t *testing.T) {
+		grupo := novoGrupo(t)
+
+		assert.Nil(t, grupo.PrazoRSVP(nil))
+		assert.NoError(t, grupo.VerificarPrazoRSVP(nil, prazoEvento.AddDate(1, 0, 0)))
+	})
+
+	t.Run("deve recusar respostas depois do prazo do evento", func(t *testing.T) {
+		grupo := novoGrupo(t)
+
+		assert.NoError(t, grupo.VerificarPrazoRSVP(&prazoEvento, prazoEvento))
+		assert.ErrorIs(t, grupo.VerificarPrazoRSVP(&prazoEvento, prazoEvento.Add(time.Minute)), ErrPrazoRSVPEncerrado)
+	})
+
+	t.Run("a prorrogação do grupo só vale quando é posterior ao prazo do evento", func(t *testing.T) {
+		grupo := novoGrupo(t)
+		prorrogacao := prazoEvento.AddDate(0, 0, 7)
+		grupo.EstenderPrazoRSVP(&prorrogacao)
+
+		assert.Equal(t, &prorrogacao, grupo.PrazoRSVP(&prazoEvento))
+		assert.NoError(t, grupo.VerificarPrazoRSVP(&prazoEvento, prazoEvento.AddDate(0, 0, 3)))
+
+		anterior := prazoEvento.AddDate(0, 0, -7)
+		grupo.EstenderPrazoRSVP(&anterior)
+		assert.Equal(t, &prazoEvento, grupo.PrazoRSVP(&prazoEvento))
+
+		grupo.EstenderPrazoRSVP(nil)
+		assert.Nil(t, grupo.PrazoRSVPEstendido())
+	})
+
+	t.Run("deve registrar o momento da última resposta", func(t *testing.T) {
+		grupo := novoGrupo(t)
+		assert.Nil(t, grupo.UltimaRespostaEm())
+
+		respostas := []RespostaRSVP{{ConvidadoID: grupo.Convidados()[0].ID(), Status: StatusRSVPConfirmado}}
+		assert.NoError(t, grupo.ConfirmarPresenca(respostas, nil))
+
+		assert.NotNil(t, grupo.UltimaRespostaEm())
+	})
+}
+
+func TestClassificarAtrasos(t *testing.T) {
+	prazoEvento := time.Date(2026, 5, 1, 23, 59, 0, 0, time.UTC)
+	agora := prazoEvento.AddDate(0, 0, 2)
+
+	novoGrupo := func(t *testing.T, chave string) *GrupoDeConvidados {
+		grupo, err := NewGrupoDeConvidados(uuid.New(), chave, []string{"João", "Maria"})
+		assert.NoError(t, err)
+		return grupo
+	}
+
+	pendente := novoGrupo(t, "pendente")
+
+	respondido := novoGrupo(t, "respondido")
+	for _, c := range respondido.Convidados() {
+		c.statusRSVP = StatusRSVPConfirmado
+	}
+	antes := prazoEvento.AddDate(0, 0, -1)
+	respondido.ultimaRespostaEm = &antes
+
+	prorrogado := novoGrupo(t, "prorrogado")
+	prorrogacao := prazoEvento.AddDate(0, 0, 5)
+	prorrogado.EstenderPrazoRSVP(&prorrogacao)
+
+	tardio := novoGrupo(t, "tardio")
+	tardio.convidados[0].statusRSVP = StatusRSVPRecusado
+	tardio.convidados[1].statusRSVP = StatusRSVPConfirmado
+	depois := prazoEvento.AddDate(0, 0, 1)
+	tardio.ultimaRespostaEm = &depois
+
+	grupos := []*GrupoDeConvidados{pendente, respondido, prorrogado, tardio}
+
+	t.Run("sem prazo no evento, ninguém está atrasado", func(t *testing.T) {
+		assert.Empty(t, ClassificarAtrasos(grupos, nil, agora))
+	})
+
+	t.Run("deve listar pendentes após o prazo efetivo e respostas tardias", func(t *testing.T) {
+		atrasos := ClassificarAtrasos(grupos, &prazoEvento, agora)
+
+		assert.Len(t, atrasos, 2)
+		assert.Equal(t, pendente, atrasos[0].Grupo)
+		assert.Len(t, atrasos[0].ConvidadosPendentes, 2)
+		assert.False(t, atrasos[0].RespondeuAposPrazo)
+
+		assert.Equal(t, tardio, atrasos[1].Grupo)
+		assert.Empty(t, atrasos[1].ConvidadosPendentes)
+		assert.True(t, atrasos[1].RespondeuAposPrazo)
+	})
+
+	t.Run("o grupo prorrogado entra na lista quando a prorrogação também vence", func(t *testing.T) {
+		atrasos := ClassificarAtrasos(grupos, &prazoEvento, prorrogacao.Add(time.Hour))
+
+		assert.Len(t, atrasos, 3)
+		assert.Equal(t, prorrogado, atrasos[1].Grupo)
+		assert.Equal(t, &prorrogacao, atrasos[1].PrazoEfetivo)
+	})
+}
@@ -0,0 +1,41 @@
+// file: internal/guest/domain/relatorio_buffet.go
+package domain
+
+import "github.com/google/uuid"
+
+// ContagemFaixaEtaria separa uma contagem de convidados confirmados por faixa etária.
+type ContagemFaixaEtaria struct {
+	Adultos      int
+	Criancas     int
+	NaoInformada int
+}
+
+func (c ContagemFaixaEtaria) Total() int { return c.Adultos + c.Criancas + c.NaoInformada }
+
+// ItemBuffet é uma opção de prato ou uma restrição alimentar com quantos confirmados a marcaram.
+type ItemBuffet struct {
+	Opcao string
+	ContagemFaixaEtaria
+}
+
+// ResumoPerguntaBuffet agrega as respostas dos confirmados à pergunta do formulário que tem
+// a finalidade. SemResposta são os confirmados que não responderam: no prato, quem ainda
+// precisa escolher; nas restrições, quem não informou nenhuma.
+type ResumoPerguntaBuffet struct {
+	IDPergunta  uuid.UUID
+	Texto       string
+	Itens       []ItemBuffet
+	SemResposta ContagemFaixaEtaria
+}
+
+// RelatorioBuffet é a contagem de pessoas pedida pelo buffet, calculada só com os confirmados.
+// Acompanhantes não têm faixa etária nem respondem ao formulário, então aparecem à parte.
+// Prato e RestricoesAlimentares são nil quando o formulário não tem pergunta com a finalidade.
+type RelatorioBuffet struct {
+	Convidados            ContagemFaixaEtaria
+	Acompanhantes         int
+	Prato                 *ResumoPerguntaBuffet
+	RestricoesAlimentares *ResumoPerguntaBuffet
+}
+
+func (r *RelatorioBuffet) TotalPessoas() int { return r.Convidados.Total() + r.Acompanhantes }
@@ -0,0 +1,126 @@
+// file: internal/guest/domain/remocao_convidado.go
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrConvidadoComRSVPOmitido        = errors.New("convidados que já responderam ao RSVP não podem ser omitidos na revisão; remova-os informando o motivo")
+	ErrMotivoRemocaoInvalido          = errors.New("o motivo da remoção é obrigatório e deve ter até 500 caracteres")
+	ErrConvidadoRemovidoNaoEncontrado = errors.New("convidado removido não encontrado neste grupo")
+)
+
+// StatusHistoricoRemovido marca no histórico de RSVP a remoção de um convidado. Não é um
+// status de RSVP: o convidado removido guarda a última resposta para ser restaurado com ela.
+const StatusHistoricoRemovido = "REMOVIDO"
+
+const tamanhoMaximoMotivoRemocao = 500
+
+// ConvidadoRemovido é um convidado retirado do grupo pelo anfitrião. Ele deixa de contar
+// nas listas e estatísticas, mas continua no grupo com o RSVP e as respostas, até ser restaurado.
+type ConvidadoRemovido struct {
+	convidado   *Convidado
+	motivo      string
+	removidoEm  time.Time
+	removidoPor *uuid.UUID // nil se o usuário que removeu não existir mais
+}
+
+func HydrateConvidadoRemovido(convidado *Convidado, motivo string, removidoEm time.Time, removidoPor *uuid.UUID) *ConvidadoRemovido {
+	return &ConvidadoRemovido{convidado: convidado, motivo: motivo, removidoEm: removidoEm, removidoPor: removidoPor}
+}
+
+// HydrateRemovidos devolve o grupo com os convidados removidos carregados à parte dos ativos.
+func HydrateRemovidos(g *GrupoDeConvidados, removidos []*ConvidadoRemovido) *GrupoDeConvidados {
+	g.removidos = removidos
+	return g
+}
+
+// RemoverConvidado retira o convidado do grupo sem apagá-lo, guardando o motivo e quem
+// removeu. A remoção entra no histórico de RSVP e o grupo precisa continuar com ao menos
+// um convidado. Se ninguém do grupo continuar confirmado, os acompanhantes saem junto,
+// como acontece ao confirmar presença.
+func (g *GrupoDeConvidados) RemoverConvidado(idConvidado uuid.UUID, motivo string, idUsuario uuid.UUID) error {
+	motivo = strings.TrimSpace(motivo)
+	if motivo == "" || utf8.RuneCountInString(motivo) > tamanhoMaximoMotivoRemocao {
+		return ErrMotivoRemocaoInvalido
+	}
+
+	indice := -1
+	for i, c := range g.convidados {
+		if c.id == idConvidado {
+			indice = i
+			break
+		}
+	}
+	if indice < 0 {
+		return ErrConvidadoNaoEncontradoNoGrupo
+	}
+	if len(g.convidados) == 1 {
+		return ErrPeloMenosUmConvidado
+	}
+
+	agora := time.Now()
+	convidado := g.convidados[indice]
+	g.convidados = append(g.convidados[:indice:indice], g.convidados[indice+1:]...)
+	g.removidos = append(g.removidos, &ConvidadoRemovido{convidado: convidado, motivo: motivo, removidoEm: agora, removidoPor: &idUsuario})
+	if !algumConfirmado(g.convidados) {
+		g.acompanhantes = nil
+	}
+
+	g.respostasRegistradas = []RegistroRSVP{{
+		ID:             uuid.New(),
+		IDGrupo:        g.id,
+		IDConvidado:    convidado.id,
+		NomeConvidado:  convidado.nome,
+		StatusAnterior: convidado.statusRSVP,
+		StatusNovo:     StatusHistoricoRemovido,
+		RegistradoEm:   agora,
+	}}
+	g.updatedAt = agora
+	return nil
+}
+
+// RestaurarConvidado devolve ao grupo um convidado removido, com o RSVP que ele tinha.
+func (g *GrupoDeConvidados) RestaurarConvidado(idConvidado uuid.UUID) error {
+	indice := -1
+	for i, r := range g.removidos {
+		if r.convidado.id == idConvidado {
+			indice = i
+			break
+		}
+	}
+	if indice < 0 {
+		return ErrConvidadoRemovidoNaoEncontrado
+	}
+
+	agora := time.Now()
+	convidado := g.removidos[indice].convidado
+	g.removidos = append(g.removidos[:indice:indice], g.removidos[indice+1:]...)
+	g.convidados = append(g.convidados, convidado)
+
+	g.respostasRegistradas = []RegistroRSVP{{
+		ID:             uuid.New(),
+		IDGrupo:        g.id,
+		IDConvidado:    convidado.id,
+		NomeConvidado:  convidado.nome,
+		StatusAnterior: StatusHistoricoRemovido,
+		StatusNovo:     convidado.statusRSVP,
+		RegistradoEm:   agora,
+	}}
+	g.updatedAt = agora
+	return nil
+}
+
+// Removidos devolve os convidados removidos do grupo, do mais antigo ao mais recente.
+func (g *GrupoDeConvidados) Removidos() []*ConvidadoRemovido { return g.removidos }
+
+func (r *ConvidadoRemovido) Convidado() *Convidado   { return r.convidado }
+func (r *ConvidadoRemovido) Motivo() string          { return r.motivo }
+func (r *ConvidadoRemovido) RemovidoEm() time.Time   { return r.removidoEm }
+func (r *ConvidadoRemovido) RemovidoPor() *uuid.UUID { return r.removidoPor }
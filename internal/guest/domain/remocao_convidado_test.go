@@ -0,0 +1,112 @@
+// file: internal/guest/domain/remocao_convidado_test.go
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrupoDeConvidados_RemoverConvidado(t *testing.T) {
+	idUsuario := uuid.New()
+
+	t.Run("deve tirar o convidado da lista guardando motivo, RSVP e histórico", func(t *testing.T) {
+		carlos := HydrateConvidado(uuid.New(), StatusRSVPConfirmado, DadosConvidado{Nome: "Carlos"}, nil)
+		ana := HydrateConvidado(uuid.New(), StatusRSVPRecusado, DadosConvidado{Nome: "Ana"}, nil)
+		grupo := novoGrupoDeTeste(uuid.New(), "familia", 2, nil, carlos, ana)
+
+		err := grupo.RemoverConvidado(ana.ID(), "  Desistiu do convite  ", idUsuario)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*Convidado{carlos}, grupo.Convidados())
+		if assert.Len(t, grupo.Removidos(), 1) {
+			removido := grupo.Removidos()[0]
+			assert.Equal(t, ana, removido.Convidado())
+			assert.Equal(t, StatusRSVPRecusado, removido.Convidado().StatusRSVP())
+			assert.Equal(t, "Desistiu do convite", removido.Motivo())
+			assert.Equal(t, &idUsuario, removido.RemovidoPor())
+		}
+		registros := grupo.RespostasRegistradas()
+		if assert.Len(t, registros, 1) {
+			assert.Equal(t, ana.ID(), registros[0].IDConvidado)
+			assert.Equal(t, StatusRSVPRecusado, registros[0].StatusAnterior)
+			assert.Equal(t, StatusHistoricoRemovido, registros[0].StatusNovo)
+		}
+	})
+
+	t.Run("deve descartar os acompanhantes se ninguém continuar confirmado", func(t *testing.T) {
+		carlos := HydrateConvidado(uuid.New(), StatusRSVPConfirmado, DadosConvidado{Nome: "Carlos"}, nil)
+		ana := HydrateConvidado(uuid.New(), StatusRSVPPendente, DadosConvidado{Nome: "Ana"}, nil)
+		grupo := novoGrupoDeTeste(uuid.New(), "familia", 2, []*Acompanhante{HydrateAcompanhante(uuid.New(), "Paula")}, carlos, ana)
+
+		assert.NoError(t, grupo.RemoverConvidado(carlos.ID(), "Viagem", idUsuario))
+		assert.Empty(t, grupo.Acompanhantes())
+	})
+
+	t.Run("deve exigir motivo e manter ao menos um convidado", func(t *testing.T) {
+		carlos := HydrateConvidado(uuid.New(), StatusRSVPConfirmado, DadosConvidado{Nome: "Carlos"}, nil)
+		grupo := novoGrupoDeTeste(uuid.New(), "familia", 2, nil, carlos)
+
+		assert.Equal(t, ErrMotivoRemocaoInvalido, grupo.RemoverConvidado(carlos.ID(), "   ", idUsuario))
+		assert.Equal(t, ErrMotivoRemocaoInvalido, grupo.RemoverConvidado(carlos.ID(), strings.Repeat("a", 501), idUsuario))
+		assert.Equal(t, ErrPeloMenosUmConvidado, grupo.RemoverConvidado(carlos.ID(), "Viagem", idUsuario))
+		assert.Equal(t, ErrConvidadoNaoEncontradoNoGrupo, grupo.RemoverConvidado(uuid.New(), "Viagem", idUsuario))
+		assert.Len(t, grupo.Convidados(), 1)
+		assert.Empty(t, grupo.Removidos())
+	})
+}
+
+func TestGrupoDeConvidados_RestaurarConvidado(t *testing.T) {
+	t.Run("deve devolver o convidado ao grupo com o RSVP que tinha", func(t *testing.T) {
+		carlos := HydrateConvidado(uuid.New(), StatusRSVPPendente, DadosConvidado{Nome: "Carlos"}, nil)
+		ana := HydrateConvidado(uuid.New(), StatusRSVPConfirmado, DadosConvidado{Nome: "Ana"}, nil)
+		grupo := novoGrupoDeTeste(uuid.New(), "familia", 2, nil, carlos)
+		grupo = HydrateRemovidos(grupo, []*ConvidadoRemovido{HydrateConvidadoRemovido(ana, "Engano", time.Now(), nil)})
+
+		err := grupo.RestaurarConvidado(ana.ID())
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*Convidado{carlos, ana}, grupo.Convidados())
+		assert.Empty(t, grupo.Removidos())
+		registros := grupo.RespostasRegistradas()
+		if assert.Len(t, registros, 1) {
+			assert.Equal(t, StatusHistoricoRemovido, registros[0].StatusAnterior)
+			assert.Equal(t, StatusRSVPConfirmado, registros[0].StatusNovo)
+		}
+	})
+
+	t.Run("deve recusar convidado que não foi removido", func(t *testing.T) {
+		carlos := HydrateConvidado(uuid.New(), StatusRSVPPendente, DadosConvidado{Nome: "Carlos"}, nil)
+		grupo := novoGrupoDeTeste(uuid.New(), "familia", 2, nil, carlos)
+
+		assert.Equal(t, ErrConvidadoRemovidoNaoEncontrado, grupo.RestaurarConvidado(carlos.ID()))
+	})
+}
+
+func TestGrupoDeConvidados_RevisarComRSVP(t *testing.T) {
+	t.Run("deve recusar a omissão de convidado que já respondeu", func(t *testing.T) {
+		carlos := HydrateConvidado(uuid.New(), StatusRSVPPendente, DadosConvidado{Nome: "Carlos"}, nil)
+		ana := HydrateConvidado(uuid.New(), StatusRSVPRecusado, DadosConvidado{Nome: "Ana"}, nil)
+		grupo := novoGrupoDeTeste(uuid.New(), "familia", 2, nil, carlos, ana)
+
+		err := grupo.Revisar("familia", []ConvidadoParaRevisao{{ID: carlos.ID(), Nome: "Carlos Silva"}})
+
+		assert.Equal(t, ErrConvidadoComRSVPOmitido, err)
+		assert.Len(t, grupo.Convidados(), 2)
+		assert.Equal(t, "Carlos", carlos.Nome())
+	})
+
+	t.Run("deve continuar tirando convidados pendentes omitidos", func(t *testing.T) {
+		carlos := HydrateConvidado(uuid.New(), StatusRSVPConfirmado, DadosConvidado{Nome: "Carlos"}, nil)
+		ana := HydrateConvidado(uuid.New(), StatusRSVPPendente, DadosConvidado{Nome: "Ana"}, nil)
+		grupo := novoGrupoDeTeste(uuid.New(), "familia", 2, nil, carlos, ana)
+
+		err := grupo.Revisar("familia", []ConvidadoParaRevisao{{ID: carlos.ID(), Nome: "Carlos"}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*Convidado{carlos}, grupo.Convidados())
+	})
+}
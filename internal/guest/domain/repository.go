@@ -3,6 +3,7 @@ package domain
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -17,15 +18,65 @@ type RSVPStats struct {
 	PercentualConfirmado  float64
 	PercentualRecusado    float64
 	PercentualPendente    float64
+	// Acompanhantes não entram em TotalConvidados nem nos percentuais, que se referem
+	// aos convites nominais. TotalPresencasConfirmadas soma os dois.
+	LimiteAcompanhantes       int
+	AcompanhantesConfirmados  int
+	TotalPresencasConfirmadas int
+	// Perguntas agrega as respostas ao formulário do evento, na ordem do formulário.
+	Perguntas []EstatisticaPergunta
+	// Etiquetas traz o RSVP de cada etiqueta do evento, em ordem alfabética.
+	Etiquetas []EstatisticaEtiqueta
+}
+
+// EstatisticaPergunta resume as respostas de uma pergunta do formulário de RSVP.
+// Opcoes traz todas as opções das perguntas de escolha, inclusive as sem votos,
+// e Soma só é preenchida nas perguntas numéricas.
+type EstatisticaPergunta struct {
+	IDPergunta     uuid.UUID
+	Texto          string
+	Tipo           string
+	TotalRespostas int
+	Opcoes         []ContagemOpcao
+	Soma           int
+}
+
+type ContagemOpcao struct {
+	Opcao string
+	Total int
 }
 
 type GroupRepository interface {
 	Save(ctx context.Context, group *GrupoDeConvidados) error
+	SaveAll(ctx context.Context, groups []*GrupoDeConvidados) error
+	FindAccessKeysByEventID(ctx context.Context, userID, eventID uuid.UUID) ([]string, error)
+	UpdateAccessKeys(ctx context.Context, userID, eventID uuid.UUID, groups []*GrupoDeConvidados) error
 	FindByAccessKey(ctx context.Context, eventID uuid.UUID, accessKey string) (*GrupoDeConvidados, error)
+	// FindAccessKeyByID devolve a chave atual do grupo do evento, para conferir os tokens de convite.
+	FindAccessKeyByID(ctx context.Context, eventID, groupID uuid.UUID) (string, error)
 	Update(ctx context.Context, userID uuid.UUID, group *GrupoDeConvidados) error        // <-- userID adicionado
 	FindByID(ctx context.Context, userID, groupID uuid.UUID) (*GrupoDeConvidados, error) // <-- userID adicionado
-	UpdateRSVP(ctx context.Context, group *GrupoDeConvidados) error
-	FindAllByEventID(ctx context.Context, userID, eventID uuid.UUID, statusFilter string) ([]*GrupoDeConvidados, error)
+	// UpdateRSVP grava a confirmação e, na mesma transação, acrescenta as respostas ao histórico.
+	UpdateRSVP(ctx context.Context, group *GrupoDeConvidados, origem OrigemRSVP) error
+	// UpdatePerfil grava os dados corrigidos pelo grupo e, na mesma transação, as alterações para revisão.
+	UpdatePerfil(ctx context.Context, group *GrupoDeConvidados, origem OrigemRSVP) error
+	// FindAllByEventID devolve a página de grupos do evento; PaginacaoGrupos{} traz todos.
+	FindAllByEventID(ctx context.Context, userID, eventID uuid.UUID, filtro FiltroConvidados, paginacao PaginacaoGrupos) (*PaginaGrupos, error)
 	Delete(ctx context.Context, userID, groupID uuid.UUID) error
-	GetRSVPStats(ctx context.Context, userID, eventID uuid.UUID) (*RSVPStats, error)
+	// UpdateRemocoes grava as remoções e restaurações de convidados e, na mesma transação,
+	// acrescenta-as ao histórico de RSVP. Convidados removidos perdem o lugar à mesa.
+	UpdateRemocoes(ctx context.Context, userID uuid.UUID, group *GrupoDeConvidados, origem OrigemRSVP) error
+	// MergeGroups grava a junção feita por Absorver e remove o grupo absorvido, em uma transação.
+	MergeGroups(ctx context.Context, userID uuid.UUID, group *GrupoDeConvidados, absorbedID uuid.UUID) error
+	// SplitGroup grava a divisão feita por Dividir, em uma transação. Devolve
+	// ErrChaveDeAcessoEmUso se a chave do grupo novo já existir no evento.
+	SplitGroup(ctx context.Context, userID uuid.UUID, original, novo *GrupoDeConvidados) error
+	// GetRSVPStats considera apenas os grupos com ao menos uma das etiquetas; vazio considera todos.
+	GetRSVPStats(ctx context.Context, userID, eventID uuid.UUID, etiquetas []uuid.UUID) (*RSVPStats, error)
+	// GetRelatorioBuffet agrega os confirmados no banco, com o mesmo filtro de etiquetas de GetRSVPStats.
+	GetRelatorioBuffet(ctx context.Context, userID, eventID uuid.UUID, etiquetas []uuid.UUID) (*RelatorioBuffet, error)
+	// UpdateEtiquetas substitui as etiquetas do grupo, verificando a propriedade.
+	UpdateEtiquetas(ctx context.Context, userID uuid.UUID, group *GrupoDeConvidados) error
+	FindPrazoRSVPByEventID(ctx context.Context, eventID uuid.UUID) (*time.Time, error)
+	UpdatePrazoRSVP(ctx context.Context, userID uuid.UUID, group *GrupoDeConvidados) error
 }
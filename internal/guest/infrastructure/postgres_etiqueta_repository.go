@@ -0,0 +1,120 @@
+// file: internal/guest/infrastructure/postgres_etiqueta_repository.go
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/luiszkm/wedding_backend/internal/guest/domain"
+)
+
+type PostgresEtiquetaRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPostgresEtiquetaRepository(db *pgxpool.Pool) domain.EtiquetaRepository {
+	return &PostgresEtiquetaRepository{db: db}
+}
+
+func (r *PostgresEtiquetaRepository) Save(ctx context.Context, userID uuid.UUID, etiqueta *domain.Etiqueta) error {
+	sql := `
+		INSERT INTO etiquetas (id, id_evento, nome)
+		SELECT $1, $2, $3
+		WHERE EXISTS(SELECT 1 FROM eventos WHERE id = $2 AND id_usuario = $4)
+	`
+	cmdTag, err := r.db.Exec(ctx, sql, etiqueta.ID(), etiqueta.IDEvento(), etiqueta.Nome(), userID)
+	if err != nil {
+		return traduzirErroEtiqueta(err, "falha ao inserir etiqueta")
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return domain.ErrEventoNaoEncontrado
+	}
+	return nil
+}
+
+func (r *PostgresEtiquetaRepository) Update(ctx context.Context, userID uuid.UUID, etiqueta *domain.Etiqueta) error {
+	sql := `
+		UPDATE etiquetas SET nome = $1
+		WHERE id = $2 AND id_evento IN (SELECT id FROM eventos WHERE id_usuario = $3)
+	`
+	cmdTag, err := r.db.Exec(ctx, sql, etiqueta.Nome(), etiqueta.ID(), userID)
+	if err != nil {
+		return traduzirErroEtiqueta(err, "falha ao atualizar etiqueta")
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return domain.ErrEtiquetaNaoEncontrada
+	}
+	return nil
+}
+
+// Delete remove a etiqueta; as associações com grupos caem em cascata.
+func (r *PostgresEtiquetaRepository) Delete(ctx context.Context, userID, etiquetaID uuid.UUID) error {
+	sql := `
+		DELETE FROM etiquetas
+		WHERE id = $1 AND id_evento IN (SELECT id FROM eventos WHERE id_usuario = $2)
+	`
+	cmdTag, err := r.db.Exec(ctx, sql, etiquetaID, userID)
+	if err != nil {
+		return fmt.Errorf("falha ao remover etiqueta: %w", err)
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return domain.ErrEtiquetaNaoEncontrada
+	}
+	return nil
+}
+
+func (r *PostgresEtiquetaRepository) FindByID(ctx context.Context, userID, etiquetaID uuid.UUID) (*domain.Etiqueta, error) {
+	sql := `
+		SELECT t.id, t.id_evento, t.nome
+		FROM etiquetas t JOIN eventos e ON t.id_evento = e.id
+		WHERE t.id = $1 AND e.id_usuario = $2
+	`
+	var id, idEvento uuid.UUID
+	var nome string
+	if err := r.db.QueryRow(ctx, sql, etiquetaID, userID).Scan(&id, &idEvento, &nome); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrEtiquetaNaoEncontrada
+		}
+		return nil, fmt.Errorf("falha ao buscar etiqueta: %w", err)
+	}
+	return domain.HydrateEtiqueta(id, idEvento, nome), nil
+}
+
+func (r *PostgresEtiquetaRepository) FindAllByEventID(ctx context.Context, userID, eventID uuid.UUID) ([]*domain.Etiqueta, error) {
+	if err := verificarPropriedadeEvento(ctx, r.db, userID, eventID); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, "SELECT id, id_evento, nome FROM etiquetas WHERE id_evento = $1 ORDER BY nome", eventID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao consultar etiquetas do evento: %w", err)
+	}
+	defer rows.Close()
+
+	etiquetas := []*domain.Etiqueta{}
+	for rows.Next() {
+		var id, idEvento uuid.UUID
+		var nome string
+		if err := rows.Scan(&id, &idEvento, &nome); err != nil {
+			return nil, fmt.Errorf("falha ao escanear etiqueta: %w", err)
+		}
+		etiquetas = append(etiquetas, domain.HydrateEtiqueta(id, idEvento, nome))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("erro durante iteração das etiquetas: %w", err)
+	}
+	return etiquetas, nil
+}
+
+func traduzirErroEtiqueta(err error, contexto string) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == codigoViolacaoUnique {
+		return domain.ErrEtiquetaJaExiste
+	}
+	return fmt.Errorf("%s: %w", contexto, err)
+}
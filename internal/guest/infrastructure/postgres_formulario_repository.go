@@ -0,0 +1,151 @@
+// file: internal/guest/infrastructure/postgres_formulario_repository.go
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/luiszkm/wedding_backend/internal/guest/domain"
+)
+
+type PostgresFormularioRSVPRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPostgresFormularioRSVPRepository(db *pgxpool.Pool) domain.FormularioRSVPRepository {
+	return &PostgresFormularioRSVPRepository{db: db}
+}
+
+func (r *PostgresFormularioRSVPRepository) FindByEventID(ctx context.Context, eventID uuid.UUID) (*domain.FormularioRSVP, error) {
+	return r.carregar(ctx, eventID)
+}
+
+func (r *PostgresFormularioRSVPRepository) FindByEventIDForUser(ctx context.Context, userID, eventID uuid.UUID) (*domain.FormularioRSVP, error) {
+	if err := verificarPropriedadeEvento(ctx, r.db, userID, eventID); err != nil {
+		return nil, err
+	}
+	return r.carregar(ctx, eventID)
+}
+
+func (r *PostgresFormularioRSVPRepository) carregar(ctx context.Context, eventID uuid.UUID) (*domain.FormularioRSVP, error) {
+	sql := `
+		SELECT id, texto, tipo, opcoes, obrigatoria, minimo, maximo, COALESCE(finalidade, '')
+		FROM rsvp_perguntas
+		WHERE id_evento = $1
+		ORDER BY ordem
+	`
+	rows, err := r.db.Query(ctx, sql, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao consultar formulário de RSVP: %w", err)
+	}
+	defer rows.Close()
+
+	var perguntas []*domain.PerguntaRSVP
+	for rows.Next() {
+		var id uuid.UUID
+		var texto, tipo, finalidade string
+		var opcoes []string
+		var obrigatoria bool
+		var minimo, maximo *int
+		if err := rows.Scan(&id, &texto, &tipo, &opcoes, &obrigatoria, &minimo, &maximo, &finalidade); err != nil {
+			return nil, fmt.Errorf("falha ao escanear pergunta do formulário: %w", err)
+		}
+		perguntas = append(perguntas, domain.HydratePerguntaRSVP(id, texto, tipo, opcoes, obrigatoria, minimo, maximo, finalidade))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("erro durante iteração das perguntas: %w", err)
+	}
+	return domain.HydrateFormularioRSVP(eventID, perguntas), nil
+}
+
+func (r *PostgresFormularioRSVPRepository) Save(ctx context.Context, userID uuid.UUID, formulario *domain.FormularioRSVP) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("falha ao iniciar transação: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	eventID := formulario.IDEvento()
+	if err := verificarPropriedadeEvento(ctx, tx, userID, eventID); err != nil {
+		return err
+	}
+
+	ids := make([]string, len(formulario.Perguntas()))
+	tipos := make([]string, len(formulario.Perguntas()))
+	for i, p := range formulario.Perguntas() {
+		ids[i] = p.ID().String()
+		tipos[i] = p.Tipo()
+	}
+
+	// 1. Respostas de perguntas que mudaram de tipo não fazem mais sentido.
+	mudouDeTipoSQL := `
+		DELETE FROM convidados_respostas r
+		USING rsvp_perguntas p, unnest($1::uuid[], $2::text[]) AS n(id, tipo)
+		WHERE r.id_pergunta = p.id AND p.id = n.id AND p.tipo <> n.tipo AND p.id_evento = $3
+	`
+	if _, err := tx.Exec(ctx, mudouDeTipoSQL, ids, tipos, eventID); err != nil {
+		return fmt.Errorf("falha ao remover respostas de perguntas alteradas: %w", err)
+	}
+
+	// 2. Perguntas omitidas são removidas; as respostas saem em cascata.
+	if _, err := tx.Exec(ctx, "DELETE FROM rsvp_perguntas WHERE id_evento = $1 AND NOT (id = ANY($2::uuid[]))", eventID, ids); err != nil {
+		return fmt.Errorf("falha ao remover perguntas do formulário: %w", err)
+	}
+
+	// 3. Insere ou atualiza as perguntas na ordem do formulário.
+	upsertSQL := `
+		INSERT INTO rsvp_perguntas (id, id_evento, ordem, texto, tipo, opcoes, obrigatoria, minimo, maximo, finalidade)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (id) DO UPDATE SET
+			ordem = EXCLUDED.ordem, texto = EXCLUDED.texto, tipo = EXCLUDED.tipo, opcoes = EXCLUDED.opcoes,
+			obrigatoria = EXCLUDED.obrigatoria, minimo = EXCLUDED.minimo, maximo = EXCLUDED.maximo,
+			finalidade = EXCLUDED.finalidade, updated_at = NOW()
+		WHERE rsvp_perguntas.id_evento = EXCLUDED.id_evento
+	`
+	batch := &pgx.Batch{}
+	for i, p := range formulario.Perguntas() {
+		batch.Queue(upsertSQL, p.ID(), eventID, i, p.Texto(), p.Tipo(), p.Opcoes(), p.Obrigatoria(), p.Minimo(), p.Maximo(), textoOuNulo(p.Finalidade()))
+	}
+	br := tx.SendBatch(ctx, batch)
+	for range formulario.Perguntas() {
+		cmdTag, err := br.Exec()
+		if err != nil {
+			br.Close()
+			return fmt.Errorf("falha ao gravar pergunta do formulário: %w", err)
+		}
+		// O ID já pertence a uma pergunta de outro evento.
+		if cmdTag.RowsAffected() == 0 {
+			br.Close()
+			return domain.ErrPerguntaNaoEncontrada
+		}
+	}
+	if err := br.Close(); err != nil {
+		return fmt.Errorf("falha ao fechar batch reader: %w", err)
+	}
+
+	// 4. Opções removidas saem das respostas; respostas que ficaram vazias são apagadas.
+	podarOpcoesSQL := `
+		UPDATE convidados_respostas r
+		SET valores = ARRAY(SELECT v FROM unnest(r.valores) AS v WHERE v = ANY(p.opcoes))
+		FROM rsvp_perguntas p
+		WHERE r.id_pergunta = p.id AND p.id_evento = $1
+		  AND p.tipo IN ('ESCOLHA_UNICA', 'ESCOLHA_MULTIPLA')
+		  AND NOT (r.valores <@ p.opcoes)
+	`
+	if _, err := tx.Exec(ctx, podarOpcoesSQL, eventID); err != nil {
+		return fmt.Errorf("falha ao ajustar respostas às novas opções: %w", err)
+	}
+	vaziasSQL := `
+		DELETE FROM convidados_respostas r
+		USING rsvp_perguntas p
+		WHERE r.id_pergunta = p.id AND p.id_evento = $1 AND cardinality(r.valores) = 0
+	`
+	if _, err := tx.Exec(ctx, vaziasSQL, eventID); err != nil {
+		return fmt.Errorf("falha ao remover respostas vazias: %w", err)
+	}
+
+	return tx.Commit(ctx)
+}
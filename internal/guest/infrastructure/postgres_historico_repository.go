@@ -0,0 +1,125 @@
+// file: internal/guest/infrastructure/postgres_historico_repository.go
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/luiszkm/wedding_backend/internal/guest/domain"
+)
+
+type PostgresHistoricoRSVPRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPostgresHistoricoRSVPRepository(db *pgxpool.Pool) domain.HistoricoRSVPRepository {
+	return &PostgresHistoricoRSVPRepository{db: db}
+}
+
+const colunasHistorico = `
+	h.id, h.id_grupo, h.id_convidado, h.nome_convidado, h.status_anterior, h.status_novo,
+	h.canal, h.id_usuario, COALESCE(h.ip, ''), COALESCE(h.user_agent, ''), h.registrado_em
+`
+
+func (r *PostgresHistoricoRSVPRepository) FindByGroupID(ctx context.Context, userID, groupID uuid.UUID, filtro domain.FiltroHistoricoRSVP) ([]domain.RegistroRSVP, error) {
+	var exists bool
+	checkSQL := `
+		SELECT EXISTS(
+			SELECT 1 FROM convidados_grupos g JOIN eventos e ON g.id_evento = e.id
+			WHERE g.id = $1 AND e.id_usuario = $2
+		)
+	`
+	if err := r.db.QueryRow(ctx, checkSQL, groupID, userID).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("falha ao verificar propriedade do grupo: %w", err)
+	}
+	if !exists {
+		return nil, domain.ErrGrupoNaoEncontrado
+	}
+
+	sql := `SELECT ` + colunasHistorico + `
+		FROM rsvp_historico h
+		WHERE h.id_grupo = $1 AND ($2::uuid IS NULL OR h.id_convidado = $2)
+		ORDER BY h.registrado_em DESC, h.id
+	`
+	return r.consultar(ctx, sql, groupID, idConvidadoDoFiltro(filtro))
+}
+
+func (r *PostgresHistoricoRSVPRepository) FindByEventID(ctx context.Context, userID, eventID uuid.UUID, filtro domain.FiltroHistoricoRSVP) ([]domain.RegistroRSVP, error) {
+	if err := verificarPropriedadeEvento(ctx, r.db, userID, eventID); err != nil {
+		return nil, err
+	}
+
+	sql := `SELECT ` + colunasHistorico + `
+		FROM rsvp_historico h
+		JOIN convidados_grupos g ON h.id_grupo = g.id
+		WHERE g.id_evento = $1 AND ($2::uuid IS NULL OR h.id_convidado = $2)
+		ORDER BY h.registrado_em DESC, h.id
+	`
+	return r.consultar(ctx, sql, eventID, idConvidadoDoFiltro(filtro))
+}
+
+func (r *PostgresHistoricoRSVPRepository) consultar(ctx context.Context, sql string, args ...any) ([]domain.RegistroRSVP, error) {
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao consultar histórico de RSVP: %w", err)
+	}
+	defer rows.Close()
+
+	registros := []domain.RegistroRSVP{}
+	for rows.Next() {
+		var reg domain.RegistroRSVP
+		if err := rows.Scan(
+			&reg.ID, &reg.IDGrupo, &reg.IDConvidado, &reg.NomeConvidado, &reg.StatusAnterior, &reg.StatusNovo,
+			&reg.Origem.Canal, &reg.Origem.IDUsuario, &reg.Origem.IP, &reg.Origem.UserAgent, &reg.RegistradoEm,
+		); err != nil {
+			return nil, fmt.Errorf("falha ao escanear registro do histórico: %w", err)
+		}
+		registros = append(registros, reg)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("erro durante iteração do histórico: %w", err)
+	}
+	return registros, nil
+}
+
+func idConvidadoDoFiltro(filtro domain.FiltroHistoricoRSVP) *uuid.UUID {
+	if filtro.IDConvidado == uuid.Nil {
+		return nil
+	}
+	return &filtro.IDConvidado
+}
+
+// registrarHistorico acrescenta as respostas ao histórico dentro da transação da confirmação.
+func registrarHistorico(ctx context.Context, tx pgx.Tx, registros []domain.RegistroRSVP, origem domain.OrigemRSVP) error {
+	if len(registros) == 0 {
+		return nil
+	}
+	var ip, userAgent *string
+	if origem.IP != "" {
+		ip = &origem.IP
+	}
+	if origem.UserAgent != "" {
+		userAgent = &origem.UserAgent
+	}
+
+	rows := make([][]any, len(registros))
+	for i, reg := range registros {
+		rows[i] = []any{
+			reg.ID, reg.IDGrupo, reg.IDConvidado, reg.NomeConvidado, reg.StatusAnterior, reg.StatusNovo,
+			origem.Canal, origem.IDUsuario, ip, userAgent, reg.RegistradoEm,
+		}
+	}
+	_, err := tx.CopyFrom(
+		ctx,
+		pgx.Identifier{"rsvp_historico"},
+		[]string{"id", "id_grupo", "id_convidado", "nome_convidado", "status_anterior", "status_novo", "canal", "id_usuario", "ip", "user_agent", "registrado_em"},
+		pgx.CopyFromRows(rows),
+	)
+	if err != nil {
+		return fmt.Errorf("falha ao registrar histórico de RSVP: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+// file: internal/guest/infrastructure/postgres_perfil_repository.go
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/luiszkm/wedding_backend/internal/guest/domain"
+)
+
+type PostgresPerfilConvidadoRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPostgresPerfilConvidadoRepository(db *pgxpool.Pool) domain.PerfilConvidadoRepository {
+	return &PostgresPerfilConvidadoRepository{db: db}
+}
+
+func (r *PostgresPerfilConvidadoRepository) FindConfiguracaoByEventID(ctx context.Context, eventID uuid.UUID) (*domain.ConfiguracaoPerfil, error) {
+	var campos []string
+	err := r.db.QueryRow(ctx, "SELECT campos_editaveis FROM perfil_convidado_configuracoes WHERE id_evento = $1", eventID).Scan(&campos)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("falha ao consultar configuração de perfil: %w", err)
+	}
+	return domain.HydrateConfiguracaoPerfil(eventID, campos), nil
+}
+
+func (r *PostgresPerfilConvidadoRepository) FindConfiguracaoByEventIDForUser(ctx context.Context, userID, eventID uuid.UUID) (*domain.ConfiguracaoPerfil, error) {
+	if err := verificarPropriedadeEvento(ctx, r.db, userID, eventID); err != nil {
+		return nil, err
+	}
+	return r.FindConfiguracaoByEventID(ctx, eventID)
+}
+
+func (r *PostgresPerfilConvidadoRepository) SaveConfiguracao(ctx context.Context, userID uuid.UUID, config *domain.ConfiguracaoPerfil) error {
+	if err := verificarPropriedadeEvento(ctx, r.db, userID, config.IDEvento()); err != nil {
+		return err
+	}
+	sql := `
+		INSERT INTO perfil_convidado_configuracoes (id_evento, campos_editaveis, updated_at)
+		VALUES ($1, $2, NOW())
+		ON CONFLICT (id_evento) DO UPDATE SET campos_editaveis = EXCLUDED.campos_editaveis, updated_at = NOW()
+	`
+	if _, err := r.db.Exec(ctx, sql, config.IDEvento(), config.Campos()); err != nil {
+		return fmt.Errorf("falha ao salvar configuração de perfil: %w", err)
+	}
+	return nil
+}
+
+func (r *PostgresPerfilConvidadoRepository) FindAlteracoesByEventID(ctx context.Context, userID, eventID uuid.UUID, filtro domain.FiltroAlteracoesPerfil) ([]domain.RegistroAlteracaoPerfil, error) {
+	if err := verificarPropriedadeEvento(ctx, r.db, userID, eventID); err != nil {
+		return nil, err
+	}
+
+	sql := `
+		SELECT a.id, a.id_grupo, a.id_convidado, a.nome_convidado, a.campo, a.valor_anterior, a.valor_novo,
+			COALESCE(a.ip, ''), COALESCE(a.user_agent, ''), a.registrado_em, a.revisada_em
+		FROM convidados_alteracoes_perfil a
+		JOIN convidados_grupos g ON a.id_grupo = g.id
+		WHERE g.id_evento = $1 AND (NOT $2 OR a.revisada_em IS NULL)
+		ORDER BY a.registrado_em DESC, a.id
+	`
+	rows, err := r.db.Query(ctx, sql, eventID, filtro.SomentePendentes)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao consultar alterações de perfil: %w", err)
+	}
+	defer rows.Close()
+
+	alteracoes := []domain.RegistroAlteracaoPerfil{}
+	for rows.Next() {
+		var a domain.RegistroAlteracaoPerfil
+		if err := rows.Scan(
+			&a.ID, &a.IDGrupo, &a.IDConvidado, &a.NomeConvidado, &a.Campo, &a.ValorAnterior, &a.ValorNovo,
+			&a.Origem.IP, &a.Origem.UserAgent, &a.RegistradoEm, &a.RevisadaEm,
+		); err != nil {
+			return nil, fmt.Errorf("falha ao escanear alteração de perfil: %w", err)
+		}
+		a.Origem.Canal = domain.CanalRSVPChaveDeAcesso
+		alteracoes = append(alteracoes, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("erro durante iteração das alterações de perfil: %w", err)
+	}
+	return alteracoes, nil
+}
+
+// MarcarRevisada mantém a data da primeira revisão se a alteração já tiver sido revisada.
+func (r *PostgresPerfilConvidadoRepository) MarcarRevisada(ctx context.Context, userID, alteracaoID uuid.UUID, revisadaEm time.Time) error {
+	sql := `
+		UPDATE convidados_alteracoes_perfil a SET revisada_em = COALESCE(a.revisada_em, $1)
+		FROM convidados_grupos g JOIN eventos e ON g.id_evento = e.id
+		WHERE a.id = $2 AND a.id_grupo = g.id AND e.id_usuario = $3
+	`
+	cmdTag, err := r.db.Exec(ctx, sql, revisadaEm, alteracaoID, userID)
+	if err != nil {
+		return fmt.Errorf("falha ao marcar alteração de perfil como revisada: %w", err)
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return domain.ErrAlteracaoPerfilNaoEncontrada
+	}
+	return nil
+}
+
+// registrarAlteracoesPerfil acrescenta as alterações na transação que grava o perfil.
+func registrarAlteracoesPerfil(ctx context.Context, tx pgx.Tx, alteracoes []domain.RegistroAlteracaoPerfil, origem domain.OrigemRSVP) error {
+	if len(alteracoes) == 0 {
+		return nil
+	}
+	rows := make([][]any, len(alteracoes))
+	for i, a := range alteracoes {
+		rows[i] = []any{
+			a.ID, a.IDGrupo, a.IDConvidado, a.NomeConvidado, a.Campo, a.ValorAnterior, a.ValorNovo,
+			textoOuNulo(origem.IP), textoOuNulo(origem.UserAgent), a.RegistradoEm,
+		}
+	}
+	_, err := tx.CopyFrom(
+		ctx,
+		pgx.Identifier{"convidados_alteracoes_perfil"},
+		[]string{"id", "id_grupo", "id_convidado", "nome_convidado", "campo", "valor_anterior", "valor_novo", "ip", "user_agent", "registrado_em"},
+		pgx.CopyFromRows(rows),
+	)
+	if err != nil {
+		return fmt.Errorf("falha ao registrar alterações de perfil: %w", err)
+	}
+	return nil
+}
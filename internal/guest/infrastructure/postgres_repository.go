@@ -3,12 +3,15 @@ package infrastructure
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/luiszkm/wedding_backend/internal/guest/domain"
 )
@@ -29,42 +32,140 @@ func (r *PostgresGroupRepository) Save(ctx context.Context, group *domain.GrupoD
 	defer tx.Rollback(ctx)
 
 	sqlGrupo := `
-		INSERT INTO convidados_grupos (id, id_evento, chave_de_acesso)
-		VALUES ($1, $2, $3);
+		INSERT INTO convidados_grupos (id, id_evento, chave_de_acesso, limite_acompanhantes)
+		VALUES ($1, $2, $3, $4);
 	`
-	_, err = tx.Exec(ctx, sqlGrupo, group.ID(), group.IDCasamento(), group.ChaveDeAcesso())
+	_, err = tx.Exec(ctx, sqlGrupo, group.ID(), group.IDCasamento(), group.ChaveDeAcesso(), group.LimiteAcompanhantes())
 	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == codigoViolacaoUnique {
+			return domain.ErrChaveDeAcessoEmUso
+		}
 		log.Printf("!!! ERRO DO BANCO DE DADOS: %v", err)
 		return fmt.Errorf("falha ao inserir grupo de convidados: %w", err)
 	}
 
-	rows := make([][]any, len(group.Convidados()))
-	for i, c := range group.Convidados() {
-		rows[i] = []any{c.ID(), group.ID(), c.Nome()}
+	if err := inserirConvidados(ctx, tx, group); err != nil {
+		return err
 	}
 
+	return tx.Commit(ctx)
+}
+
+// SaveAll insere vários grupos em uma única transação: ou todos são gravados, ou nenhum.
+func (r *PostgresGroupRepository) SaveAll(ctx context.Context, groups []*domain.GrupoDeConvidados) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("falha ao iniciar transação: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	rowsGrupos := make([][]any, len(groups))
+	for i, g := range groups {
+		rowsGrupos[i] = []any{g.ID(), g.IDCasamento(), g.ChaveDeAcesso(), g.LimiteAcompanhantes()}
+	}
 	_, err = tx.CopyFrom(
 		ctx,
-		pgx.Identifier{"convidados"},
-		[]string{"id", "id_grupo", "nome"},
-		pgx.CopyFromRows(rows),
+		pgx.Identifier{"convidados_grupos"},
+		[]string{"id", "id_evento", "chave_de_acesso", "limite_acompanhantes"},
+		pgx.CopyFromRows(rowsGrupos),
 	)
 	if err != nil {
-		return fmt.Errorf("falha ao inserir convidados em lote: %w", err)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == codigoViolacaoUnique {
+			return domain.ErrChaveDeAcessoEmUso
+		}
+		return fmt.Errorf("falha ao inserir grupos de convidados em lote: %w", err)
+	}
+
+	for _, g := range groups {
+		if err := inserirConvidados(ctx, tx, g); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// FindAccessKeysByEventID retorna as chaves de acesso já usadas no evento, verificando a propriedade.
+func (r *PostgresGroupRepository) FindAccessKeysByEventID(ctx context.Context, userID, eventID uuid.UUID) ([]string, error) {
+	if err := verificarPropriedadeEvento(ctx, r.db, userID, eventID); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, "SELECT chave_de_acesso FROM convidados_grupos WHERE id_evento = $1", eventID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao consultar chaves de acesso do evento: %w", err)
+	}
+	chaves, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("falha ao ler chaves de acesso do evento: %w", err)
+	}
+	return chaves, nil
+}
+
+// UpdateAccessKeys grava as novas chaves de vários grupos do evento em uma transação:
+// ou todas as chaves mudam, ou nenhuma.
+func (r *PostgresGroupRepository) UpdateAccessKeys(ctx context.Context, userID, eventID uuid.UUID, groups []*domain.GrupoDeConvidados) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("falha ao iniciar transação: %w", err)
 	}
+	defer tx.Rollback(ctx)
 
+	ids := make([]string, len(groups))
+	chaves := make([]string, len(groups))
+	for i, g := range groups {
+		ids[i] = g.ID().String()
+		chaves[i] = g.ChaveDeAcesso()
+	}
+
+	sql := `
+		UPDATE convidados_grupos g
+		SET chave_de_acesso = n.chave, updated_at = NOW()
+		FROM unnest($1::uuid[], $2::text[]) AS n(id, chave)
+		WHERE g.id = n.id
+		  AND g.id_evento = $3
+		  AND EXISTS(SELECT 1 FROM eventos WHERE id = $3 AND id_usuario = $4)
+	`
+	cmdTag, err := tx.Exec(ctx, sql, ids, chaves, eventID, userID)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == codigoViolacaoUnique {
+			return domain.ErrChaveDeAcessoEmUso
+		}
+		return fmt.Errorf("falha ao atualizar chaves de acesso: %w", err)
+	}
+	// Um grupo removido no meio da operação desfaz o lote inteiro.
+	if cmdTag.RowsAffected() != int64(len(groups)) {
+		return domain.ErrGrupoNaoEncontrado
+	}
 	return tx.Commit(ctx)
 }
 
+func (r *PostgresGroupRepository) FindAccessKeyByID(ctx context.Context, eventID, groupID uuid.UUID) (string, error) {
+	var chave string
+	err := r.db.QueryRow(ctx, "SELECT chave_de_acesso FROM convidados_grupos WHERE id = $1 AND id_evento = $2", groupID, eventID).Scan(&chave)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", domain.ErrGrupoNaoEncontrado
+		}
+		return "", fmt.Errorf("falha ao consultar chave de acesso do grupo: %w", err)
+	}
+	return chave, nil
+}
+
 func (r *PostgresGroupRepository) FindByAccessKey(ctx context.Context, eventID uuid.UUID, accessKey string) (*domain.GrupoDeConvidados, error) {
 	// Usamos LEFT JOIN para garantir que mesmo um grupo sem convidados (caso raro) seja retornado.
 	// Filtramos por id_evento E chave_de_acesso para evitar ambiguidade
 	sql := `
 		SELECT
-			g.id, g.id_evento, g.chave_de_acesso, g.created_at, g.updated_at,
-			c.id, c.nome, c.status_rsvp
+			g.id, g.id_evento, g.chave_de_acesso, g.limite_acompanhantes, g.prazo_rsvp_estendido, g.ultima_resposta_em,
+			g.created_at, g.updated_at,
+			c.id, c.nome, c.status_rsvp, COALESCE(c.telefone, ''), COALESCE(c.email, ''),
+			COALESCE(c.faixa_etaria, ''), COALESCE(c.lado, ''), COALESCE(c.observacoes, '')
 		FROM convidados_grupos g
-		LEFT JOIN convidados c ON g.id = c.id_grupo
+		LEFT JOIN convidados c ON g.id = c.id_grupo AND c.removido_em IS NULL
 		WHERE g.id_evento = $1 AND g.chave_de_acesso = $2;
 	`
 
@@ -80,23 +181,26 @@ func (r *PostgresGroupRepository) FindByAccessKey(ctx context.Context, eventID u
 	for rows.Next() {
 		var grupoID, idCasamento, convidadoID uuid.UUID
 		var chaveDeAcesso, nomeConvidado, statusRSVP string
+		var limiteAcompanhantes int
+		var prazoRSVPEstendido, ultimaRespostaEm *time.Time
 		var createdAt, updatedAt time.Time
 
 		// Usamos ponteiros para os campos de convidados para detectar quando eles são NULL
 		// (no caso de um grupo sem convidados).
 		var pConvidadoID *uuid.UUID
 		var pNomeConvidado, pStatusRSVP *string
+		var telefone, email, faixaEtaria, lado, observacoes string
 
 		if err := rows.Scan(
-			&grupoID, &idCasamento, &chaveDeAcesso, &createdAt, &updatedAt,
-			&pConvidadoID, &pNomeConvidado, &pStatusRSVP,
+			&grupoID, &idCasamento, &chaveDeAcesso, &limiteAcompanhantes, &prazoRSVPEstendido, &ultimaRespostaEm, &createdAt, &updatedAt,
+			&pConvidadoID, &pNomeConvidado, &pStatusRSVP, &telefone, &email, &faixaEtaria, &lado, &observacoes,
 		); err != nil {
 			return nil, fmt.Errorf("falha ao escanear linha da consulta: %w", err)
 		}
 
 		// Se o grupo ainda não foi criado, criamo-lo com os dados da primeira linha.
 		if grupo == nil {
-			grupo = domain.HydrateGroup(grupoID, idCasamento, chaveDeAcesso, nil, createdAt, updatedAt)
+			grupo = domain.HydrateGroup(grupoID, idCasamento, chaveDeAcesso, nil, limiteAcompanhantes, nil, nil, prazoRSVPEstendido, ultimaRespostaEm, createdAt, updatedAt)
 		}
 
 		// Se houver dados de convidado na linha, criamos o objeto convidado.
@@ -104,7 +208,9 @@ func (r *PostgresGroupRepository) FindByAccessKey(ctx context.Context, eventID u
 			convidadoID = *pConvidadoID
 			nomeConvidado = *pNomeConvidado
 			statusRSVP = *pStatusRSVP
-			convidado := domain.HydrateConvidado(convidadoID, nomeConvidado, statusRSVP)
+			convidado := domain.HydrateConvidado(convidadoID, statusRSVP, domain.DadosConvidado{
+				Nome: nomeConvidado, Telefone: telefone, Email: email, FaixaEtaria: faixaEtaria, Lado: lado, Observacoes: observacoes,
+			}, nil)
 			convidados = append(convidados, convidado)
 		}
 	}
@@ -119,19 +225,25 @@ func (r *PostgresGroupRepository) FindByAccessKey(ctx context.Context, eventID u
 	}
 
 	// "Hidratamos" o agregado com sua lista de convidados.
-	grupo = domain.HydrateGroup(grupo.ID(), grupo.IDCasamento(), grupo.ChaveDeAcesso(), convidados, grupo.CreatedAt(), grupo.UpdatedAt())
+	grupo = domain.HydrateGroup(grupo.ID(), grupo.IDCasamento(), grupo.ChaveDeAcesso(), convidados, grupo.LimiteAcompanhantes(), nil, nil, grupo.PrazoRSVPEstendido(), grupo.UltimaRespostaEm(), grupo.CreatedAt(), grupo.UpdatedAt())
 
-	return grupo, nil
+	grupos, err := r.completarGrupos(ctx, []*domain.GrupoDeConvidados{grupo})
+	if err != nil {
+		return nil, err
+	}
+	return grupos[0], nil
 }
 
 func (r *PostgresGroupRepository) FindByID(ctx context.Context, userID, groupID uuid.UUID) (*domain.GrupoDeConvidados, error) {
 	sql := `
 		SELECT
-			g.id, g.id_evento, g.chave_de_acesso, g.created_at, g.updated_at,
-			c.id, c.nome, c.status_rsvp
+			g.id, g.id_evento, g.chave_de_acesso, g.limite_acompanhantes, g.prazo_rsvp_estendido, g.ultima_resposta_em,
+			g.created_at, g.updated_at,
+			c.id, c.nome, c.status_rsvp, COALESCE(c.telefone, ''), COALESCE(c.email, ''),
+			COALESCE(c.faixa_etaria, ''), COALESCE(c.lado, ''), COALESCE(c.observacoes, '')
 		FROM convidados_grupos g
 		JOIN eventos e ON g.id_evento = e.id
-		LEFT JOIN convidados c ON g.id = c.id_grupo
+		LEFT JOIN convidados c ON g.id = c.id_grupo AND c.removido_em IS NULL
 		WHERE g.id = $1 AND e.id_usuario = $2;
 	`
 	rows, err := r.db.Query(ctx, sql, groupID, userID)
@@ -146,26 +258,31 @@ func (r *PostgresGroupRepository) FindByID(ctx context.Context, userID, groupID
 	for rows.Next() {
 		var grupoID, idCasamento, convidadoID uuid.UUID
 		var chaveDeAcesso, nomeConvidado, statusRSVP string
+		var limiteAcompanhantes int
+		var prazoRSVPEstendido, ultimaRespostaEm *time.Time
 		var createdAt, updatedAt time.Time
 		var pConvidadoID *uuid.UUID
 		var pNomeConvidado, pStatusRSVP *string
+		var telefone, email, faixaEtaria, lado, observacoes string
 
 		if err := rows.Scan(
-			&grupoID, &idCasamento, &chaveDeAcesso, &createdAt, &updatedAt,
-			&pConvidadoID, &pNomeConvidado, &pStatusRSVP,
+			&grupoID, &idCasamento, &chaveDeAcesso, &limiteAcompanhantes, &prazoRSVPEstendido, &ultimaRespostaEm, &createdAt, &updatedAt,
+			&pConvidadoID, &pNomeConvidado, &pStatusRSVP, &telefone, &email, &faixaEtaria, &lado, &observacoes,
 		); err != nil {
 			return nil, fmt.Errorf("falha ao escanear linha da consulta de grupo por id: %w", err)
 		}
 
 		if grupo == nil {
-			grupo = domain.HydrateGroup(grupoID, idCasamento, chaveDeAcesso, nil, createdAt, updatedAt)
+			grupo = domain.HydrateGroup(grupoID, idCasamento, chaveDeAcesso, nil, limiteAcompanhantes, nil, nil, prazoRSVPEstendido, ultimaRespostaEm, createdAt, updatedAt)
 		}
 
 		if pConvidadoID != nil {
 			convidadoID = *pConvidadoID
 			nomeConvidado = *pNomeConvidado
 			statusRSVP = *pStatusRSVP
-			convidado := domain.HydrateConvidado(convidadoID, nomeConvidado, statusRSVP)
+			convidado := domain.HydrateConvidado(convidadoID, statusRSVP, domain.DadosConvidado{
+				Nome: nomeConvidado, Telefone: telefone, Email: email, FaixaEtaria: faixaEtaria, Lado: lado, Observacoes: observacoes,
+			}, nil)
 			convidados = append(convidados, convidado)
 		}
 	}
@@ -179,9 +296,17 @@ func (r *PostgresGroupRepository) FindByID(ctx context.Context, userID, groupID
 	}
 
 	// "Hidratamos" o agregado com sua lista de convidados
-	grupo = domain.HydrateGroup(grupo.ID(), grupo.IDCasamento(), grupo.ChaveDeAcesso(), convidados, grupo.CreatedAt(), grupo.UpdatedAt())
+	grupo = domain.HydrateGroup(grupo.ID(), grupo.IDCasamento(), grupo.ChaveDeAcesso(), convidados, grupo.LimiteAcompanhantes(), nil, nil, grupo.PrazoRSVPEstendido(), grupo.UltimaRespostaEm(), grupo.CreatedAt(), grupo.UpdatedAt())
 
-	return grupo, nil
+	grupos, err := r.completarGrupos(ctx, []*domain.GrupoDeConvidados{grupo})
+	if err != nil {
+		return nil, err
+	}
+	removidos, err := r.carregarRemovidos(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	return domain.HydrateRemovidos(grupos[0], removidos), nil
 }
 
 func (r *PostgresGroupRepository) Update(ctx context.Context, userID uuid.UUID, group *domain.GrupoDeConvidados) error {
@@ -193,10 +318,10 @@ func (r *PostgresGroupRepository) Update(ctx context.Context, userID uuid.UUID,
 
 	// 1. Atualiza os dados do grupo principal (chave de acesso e timestamp)
 	updateGroupSQL := `
-		UPDATE convidados_grupos SET chave_de_acesso = $1, updated_at = $2 
+		UPDATE convidados_grupos SET chave_de_acesso = $1, updated_at = $2, limite_acompanhantes = $5
 		WHERE id = $3 AND id_evento IN (SELECT id FROM eventos WHERE id_usuario = $4)
 	`
-	cmdTag, err := tx.Exec(ctx, updateGroupSQL, group.ChaveDeAcesso(), group.UpdatedAt(), group.ID(), userID)
+	cmdTag, err := tx.Exec(ctx, updateGroupSQL, group.ChaveDeAcesso(), group.UpdatedAt(), group.ID(), userID, group.LimiteAcompanhantes())
 	if err != nil {
 		return fmt.Errorf("falha ao atualizar dados do grupo: %w", err)
 	}
@@ -204,53 +329,126 @@ func (r *PostgresGroupRepository) Update(ctx context.Context, userID uuid.UUID,
 	if cmdTag.RowsAffected() == 0 {
 		return domain.ErrGrupoNaoEncontrado
 	}
-	// 2. Remove TODOS os convidados antigos associados a este grupo.
+	// 2. Remove TODOS os convidados antigos associados a este grupo, menos os removidos pelo anfitrião.
 	// Esta é a parte "delete" da estratégia "delete-then-insert".
-	if _, err := tx.Exec(ctx, "DELETE FROM convidados WHERE id_grupo = $1", group.ID()); err != nil {
+	if _, err := tx.Exec(ctx, "DELETE FROM convidados WHERE id_grupo = $1 AND removido_em IS NULL", group.ID()); err != nil {
 		return fmt.Errorf("falha ao deletar convidados antigos: %w", err)
 	}
 
 	// 3. Insere a NOVA lista de convidados em lote.
 	// Esta é a parte "insert" da estratégia.
 	if len(group.Convidados()) > 0 {
-		rows := make([][]any, len(group.Convidados()))
-		for i, c := range group.Convidados() {
-			rows[i] = []any{c.ID(), group.ID(), c.Nome(), c.StatusRSVP()}
+		if err := inserirConvidados(ctx, tx, group); err != nil {
+			return err
 		}
+	}
 
-		_, err = tx.CopyFrom(
-			ctx,
-			pgx.Identifier{"convidados"},
-			[]string{"id", "id_grupo", "nome", "status_rsvp"},
-			pgx.CopyFromRows(rows),
-		)
-		if err != nil {
-			return fmt.Errorf("falha ao inserir nova lista de convidados: %w", err)
-		}
+	// 4. As respostas ao formulário foram removidas em cascata com os convidados; regrava as que continuam.
+	if err := substituirRespostas(ctx, tx, group); err != nil {
+		return err
 	}
 
-	// 4. Confirma a transação.
+	// 5. Confirma a transação.
 	return tx.Commit(ctx)
 }
 
-func (r *PostgresGroupRepository) FindAllByEventID(ctx context.Context, userID, eventID uuid.UUID, statusFilter string) ([]*domain.GrupoDeConvidados, error) {
-	baseSQL := `
-		SELECT
-			g.id, g.id_evento, g.chave_de_acesso, g.created_at, g.updated_at,
-			c.id, c.nome, c.status_rsvp
+// ordenacoesGrupos associa cada campo de ordenação à expressão calculada por grupo e ao
+// tipo com que o valor guardado no cursor volta a ser comparado.
+var ordenacoesGrupos = map[string]struct{ expressao, tipo string }{
+	domain.OrdenarGruposPorCriacao:     {"g.created_at", "timestamptz"},
+	domain.OrdenarGruposPorAtualizacao: {"g.updated_at", "timestamptz"},
+	domain.OrdenarGruposPorNome:        {"COALESCE(MIN(lower(c.nome)), '')", "text"},
+	// Grupos com alguém sem resposta vêm antes dos que têm confirmados, e os que só recusaram por último.
+	domain.OrdenarGruposPorStatus: {"CASE WHEN bool_or(c.status_rsvp = 'PENDENTE') THEN 0 WHEN bool_or(c.status_rsvp = 'CONFIRMADO') THEN 1 ELSE 2 END", "integer"},
+}
+
+// escaparCuringasLike faz o texto buscado valer literalmente dentro de um padrão LIKE.
+var escaparCuringasLike = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func (r *PostgresGroupRepository) FindAllByEventID(ctx context.Context, userID, eventID uuid.UUID, filtro domain.FiltroConvidados, paginacao domain.PaginacaoGrupos) (*domain.PaginaGrupos, error) {
+	if err := paginacao.Validar(); err != nil {
+		return nil, err
+	}
+	posicao, _ := paginacao.Posicao()
+	ordenacao := ordenacoesGrupos[paginacao.OrdenarPor]
+
+	args := []interface{}{eventID, userID}
+	parametro := func(valor any) string {
+		args = append(args, valor)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	// Os filtros se aplicam aos convidados, no JOIN: o grupo traz só os convidados
+	// correspondentes, e grupos sem nenhum convidado correspondente ficam de fora.
+	var condicoesConvidado []string
+	if filtro.Status != "" {
+		condicoesConvidado = append(condicoesConvidado, "c.status_rsvp = "+parametro(filtro.Status))
+	}
+	if filtro.FaixaEtaria != "" {
+		condicoesConvidado = append(condicoesConvidado, "c.faixa_etaria = "+parametro(filtro.FaixaEtaria))
+	}
+	if filtro.Lado != "" {
+		condicoesConvidado = append(condicoesConvidado, "c.lado = "+parametro(filtro.Lado))
+	}
+	if filtro.ComTelefone != nil {
+		condicoesConvidado = append(condicoesConvidado, "(c.telefone IS NOT NULL) = "+parametro(*filtro.ComTelefone))
+	}
+	if filtro.ComEmail != nil {
+		condicoesConvidado = append(condicoesConvidado, "(c.email IS NOT NULL) = "+parametro(*filtro.ComEmail))
+	}
+	juncaoConvidados := "LEFT JOIN convidados c ON g.id = c.id_grupo AND c.removido_em IS NULL"
+	for _, condicao := range condicoesConvidado {
+		juncaoConvidados += " AND " + condicao
+	}
+
+	filtradosSQL := `
+		SELECT g.id, ` + ordenacao.expressao + ` AS ordem
 		FROM convidados_grupos g
 		JOIN eventos e ON g.id_evento = e.id
-		LEFT JOIN convidados c ON g.id = c.id_grupo
+		` + juncaoConvidados + `
 		WHERE g.id_evento = $1 AND e.id_usuario = $2`
+	if len(filtro.Etiquetas) > 0 {
+		filtradosSQL += " AND EXISTS (SELECT 1 FROM convidados_grupos_etiquetas ge WHERE ge.id_grupo = g.id AND ge.id_etiqueta = ANY(" + parametro(idsTexto(filtro.Etiquetas)) + "::uuid[]))"
+	}
+	if filtro.Busca != "" {
+		// Os índices trigram da chave e dos nomes atendem o ILIKE com curinga no início.
+		padrao := parametro("%" + escaparCuringasLike.Replace(filtro.Busca) + "%")
+		filtradosSQL += " AND (g.chave_de_acesso ILIKE " + padrao +
+			" OR EXISTS (SELECT 1 FROM convidados cb WHERE cb.id_grupo = g.id AND cb.removido_em IS NULL AND cb.nome ILIKE " + padrao + "))"
+	}
+	filtradosSQL += " GROUP BY g.id"
+	if len(condicoesConvidado) > 0 {
+		filtradosSQL += " HAVING COUNT(c.id) > 0"
+	}
+	argsFiltro := len(args)
 
-	args := []interface{}{eventID, userID}
-
-	if statusFilter != "" {
-		baseSQL += " AND c.status_rsvp = $3"
-		args = append(args, statusFilter)
+	direcao, comparacao := "ASC", ">"
+	if paginacao.Ordem == domain.OrdemDecrescente {
+		direcao, comparacao = "DESC", "<"
+	}
+	paginaSQL := "SELECT id, ordem FROM filtrados"
+	if posicao != nil {
+		paginaSQL += fmt.Sprintf(" WHERE (ordem, id) %s (%s::%s, %s::uuid)", comparacao, parametro(posicao.Valor), ordenacao.tipo, parametro(posicao.ID))
+	}
+	paginaSQL += " ORDER BY ordem " + direcao + ", id " + direcao
+	if paginacao.Limite > 0 {
+		// Um grupo a mais indica que existe a próxima página.
+		paginaSQL += " LIMIT " + parametro(paginacao.Limite+1)
 	}
 
-	baseSQL += " ORDER BY g.created_at DESC"
+	baseSQL := `
+		WITH filtrados AS (` + filtradosSQL + `),
+		pagina AS (` + paginaSQL + `)
+		SELECT
+			p.ordem::text,
+			g.id, g.id_evento, g.chave_de_acesso, g.limite_acompanhantes, g.prazo_rsvp_estendido, g.ultima_resposta_em,
+			g.created_at, g.updated_at,
+			c.id, c.nome, c.status_rsvp, COALESCE(c.telefone, ''), COALESCE(c.email, ''),
+			COALESCE(c.faixa_etaria, ''), COALESCE(c.lado, ''), COALESCE(c.observacoes, '')
+		FROM pagina p
+		JOIN convidados_grupos g ON g.id = p.id
+		` + juncaoConvidados + `
+		ORDER BY p.ordem ` + direcao + `, p.id ` + direcao
 
 	rows, err := r.db.Query(ctx, baseSQL, args...)
 	if err != nil {
@@ -259,26 +457,33 @@ func (r *PostgresGroupRepository) FindAllByEventID(ctx context.Context, userID,
 	defer rows.Close()
 
 	gruposMap := make(map[uuid.UUID]*domain.GrupoDeConvidados)
+	ordens := make(map[uuid.UUID]string)
 	var gruposOrdenados []*domain.GrupoDeConvidados
 
 	for rows.Next() {
+		var ordem string
 		var grupoID, idEvento, convidadoID uuid.UUID
 		var chaveDeAcesso, nomeConvidado, statusRSVP string
+		var limiteAcompanhantes int
+		var prazoRSVPEstendido, ultimaRespostaEm *time.Time
 		var createdAt, updatedAt time.Time
 		var pConvidadoID *uuid.UUID
 		var pNomeConvidado, pStatusRSVP *string
+		var telefone, email, faixaEtaria, lado, observacoes string
 
 		if err := rows.Scan(
-			&grupoID, &idEvento, &chaveDeAcesso, &createdAt, &updatedAt,
-			&pConvidadoID, &pNomeConvidado, &pStatusRSVP,
+			&ordem,
+			&grupoID, &idEvento, &chaveDeAcesso, &limiteAcompanhantes, &prazoRSVPEstendido, &ultimaRespostaEm, &createdAt, &updatedAt,
+			&pConvidadoID, &pNomeConvidado, &pStatusRSVP, &telefone, &email, &faixaEtaria, &lado, &observacoes,
 		); err != nil {
 			return nil, fmt.Errorf("falha ao escanear linha da consulta por evento: %w", err)
 		}
 
 		grupo, existe := gruposMap[grupoID]
 		if !existe {
-			grupo = domain.HydrateGroup(grupoID, idEvento, chaveDeAcesso, nil, createdAt, updatedAt)
+			grupo = domain.HydrateGroup(grupoID, idEvento, chaveDeAcesso, nil, limiteAcompanhantes, nil, nil, prazoRSVPEstendido, ultimaRespostaEm, createdAt, updatedAt)
 			gruposMap[grupoID] = grupo
+			ordens[grupoID] = ordem
 			gruposOrdenados = append(gruposOrdenados, grupo)
 		}
 
@@ -286,12 +491,14 @@ func (r *PostgresGroupRepository) FindAllByEventID(ctx context.Context, userID,
 			convidadoID = *pConvidadoID
 			nomeConvidado = *pNomeConvidado
 			statusRSVP = *pStatusRSVP
-			convidado := domain.HydrateConvidado(convidadoID, nomeConvidado, statusRSVP)
+			convidado := domain.HydrateConvidado(convidadoID, statusRSVP, domain.DadosConvidado{
+				Nome: nomeConvidado, Telefone: telefone, Email: email, FaixaEtaria: faixaEtaria, Lado: lado, Observacoes: observacoes,
+			}, nil)
 
 			// Precisa recriar o grupo com os convidados atualizados
 			convidadosAtuais := grupo.Convidados()
 			convidadosAtualizados := append(convidadosAtuais, convidado)
-			grupoAtualizado := domain.HydrateGroup(grupo.ID(), grupo.IDCasamento(), grupo.ChaveDeAcesso(), convidadosAtualizados, grupo.CreatedAt(), grupo.UpdatedAt())
+			grupoAtualizado := domain.HydrateGroup(grupo.ID(), grupo.IDCasamento(), grupo.ChaveDeAcesso(), convidadosAtualizados, grupo.LimiteAcompanhantes(), nil, nil, grupo.PrazoRSVPEstendido(), grupo.UltimaRespostaEm(), grupo.CreatedAt(), grupo.UpdatedAt())
 			gruposMap[grupoID] = grupoAtualizado
 
 			// Atualizar na lista ordenada também
@@ -308,7 +515,53 @@ func (r *PostgresGroupRepository) FindAllByEventID(ctx context.Context, userID,
 		return nil, fmt.Errorf("erro durante iteração das linhas: %w", err)
 	}
 
-	return gruposOrdenados, nil
+	pagina := &domain.PaginaGrupos{Total: len(gruposOrdenados)}
+	if paginacao.Limite > 0 && len(gruposOrdenados) > paginacao.Limite {
+		gruposOrdenados = gruposOrdenados[:paginacao.Limite]
+		ultimo := gruposOrdenados[len(gruposOrdenados)-1].ID()
+		pagina.ProximoCursor = paginacao.CursorApos(domain.PosicaoGrupo{Valor: ordens[ultimo], ID: ultimo})
+	}
+	// Sem cursor e sem próxima página, os grupos lidos já são todos os do filtro.
+	if posicao != nil || pagina.ProximoCursor != "" {
+		totalSQL := "WITH filtrados AS (" + filtradosSQL + ") SELECT COUNT(*) FROM filtrados"
+		if err := r.db.QueryRow(ctx, totalSQL, args[:argsFiltro]...).Scan(&pagina.Total); err != nil {
+			return nil, fmt.Errorf("falha ao contar grupos por evento: %w", err)
+		}
+	}
+
+	if pagina.Grupos, err = r.completarGrupos(ctx, gruposOrdenados); err != nil {
+		return nil, err
+	}
+	return pagina, nil
+}
+
+// FindPrazoRSVPByEventID devolve o prazo de RSVP do evento, ou nil quando não há prazo.
+func (r *PostgresGroupRepository) FindPrazoRSVPByEventID(ctx context.Context, eventID uuid.UUID) (*time.Time, error) {
+	var prazo *time.Time
+	err := r.db.QueryRow(ctx, "SELECT prazo_rsvp FROM eventos WHERE id = $1", eventID).Scan(&prazo)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrEventoNaoEncontrado
+		}
+		return nil, fmt.Errorf("falha ao consultar prazo de RSVP do evento: %w", err)
+	}
+	return prazo, nil
+}
+
+// UpdatePrazoRSVP grava apenas a prorrogação de prazo do grupo, verificando a propriedade.
+func (r *PostgresGroupRepository) UpdatePrazoRSVP(ctx context.Context, userID uuid.UUID, group *domain.GrupoDeConvidados) error {
+	sql := `
+		UPDATE convidados_grupos SET prazo_rsvp_estendido = $1, updated_at = $2
+		WHERE id = $3 AND id_evento IN (SELECT id FROM eventos WHERE id_usuario = $4)
+	`
+	cmdTag, err := r.db.Exec(ctx, sql, group.PrazoRSVPEstendido(), group.UpdatedAt(), group.ID(), userID)
+	if err != nil {
+		return fmt.Errorf("falha ao atualizar prazo de RSVP do grupo: %w", err)
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return domain.ErrGrupoNaoEncontrado
+	}
+	return nil
 }
 
 func (r *PostgresGroupRepository) Delete(ctx context.Context, userID, groupID uuid.UUID) error {
@@ -353,35 +606,50 @@ func (r *PostgresGroupRepository) Delete(ctx context.Context, userID, groupID uu
 	return tx.Commit(ctx)
 }
 
-func (r *PostgresGroupRepository) GetRSVPStats(ctx context.Context, userID, eventID uuid.UUID) (*domain.RSVPStats, error) {
+func (r *PostgresGroupRepository) GetRSVPStats(ctx context.Context, userID, eventID uuid.UUID, etiquetas []uuid.UUID) (*domain.RSVPStats, error) {
+	// Os acompanhantes vêm de subconsultas para não multiplicar as linhas do JOIN com convidados.
 	sql := `
 		SELECT 
 			COUNT(DISTINCT g.id) as total_grupos,
 			COUNT(c.id) as total_convidados,
 			COUNT(CASE WHEN c.status_rsvp = 'CONFIRMADO' THEN 1 END) as confirmados,
 			COUNT(CASE WHEN c.status_rsvp = 'RECUSADO' THEN 1 END) as recusados,
-			COUNT(CASE WHEN c.status_rsvp = 'PENDENTE' THEN 1 END) as pendentes
+			COUNT(CASE WHEN c.status_rsvp = 'PENDENTE' THEN 1 END) as pendentes,
+			(SELECT COALESCE(SUM(gl.limite_acompanhantes), 0)
+				FROM convidados_grupos gl JOIN eventos el ON gl.id_evento = el.id
+				WHERE gl.id_evento = $1 AND el.id_usuario = $2 AND ` + condicaoEtiquetas("gl.id", 3) + `) as limite_acompanhantes,
+			(SELECT COUNT(*)
+				FROM convidados_acompanhantes a
+				JOIN convidados_grupos ga ON a.id_grupo = ga.id
+				JOIN eventos ea ON ga.id_evento = ea.id
+				WHERE ga.id_evento = $1 AND ea.id_usuario = $2 AND ` + condicaoEtiquetas("ga.id", 3) + `) as acompanhantes
 		FROM convidados_grupos g
 		JOIN eventos e ON g.id_evento = e.id
-		LEFT JOIN convidados c ON g.id = c.id_grupo
-		WHERE g.id_evento = $1 AND e.id_usuario = $2
+		LEFT JOIN convidados c ON g.id = c.id_grupo AND c.removido_em IS NULL
+		WHERE g.id_evento = $1 AND e.id_usuario = $2 AND ` + condicaoEtiquetas("g.id", 3) + `
 	`
 
 	var totalGrupos, totalConvidados, confirmados, recusados, pendentes int
+	var limiteAcompanhantes, acompanhantes int
 
-	err := r.db.QueryRow(ctx, sql, eventID, userID).Scan(
+	filtroEtiquetas := idsTexto(etiquetas)
+	err := r.db.QueryRow(ctx, sql, eventID, userID, filtroEtiquetas).Scan(
 		&totalGrupos, &totalConvidados, &confirmados, &recusados, &pendentes,
+		&limiteAcompanhantes, &acompanhantes,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("falha ao obter estatísticas RSVP: %w", err)
 	}
 
 	stats := &domain.RSVPStats{
-		TotalGrupos:           totalGrupos,
-		TotalConvidados:       totalConvidados,
-		ConvidadosConfirmados: confirmados,
-		ConvidadosRecusados:   recusados,
-		ConvidadosPendentes:   pendentes,
+		TotalGrupos:               totalGrupos,
+		TotalConvidados:           totalConvidados,
+		ConvidadosConfirmados:     confirmados,
+		ConvidadosRecusados:       recusados,
+		ConvidadosPendentes:       pendentes,
+		LimiteAcompanhantes:       limiteAcompanhantes,
+		AcompanhantesConfirmados:  acompanhantes,
+		TotalPresencasConfirmadas: confirmados + acompanhantes,
 	}
 
 	// Calcular percentuais
@@ -391,19 +659,362 @@ func (r *PostgresGroupRepository) GetRSVPStats(ctx context.Context, userID, even
 		stats.PercentualPendente = float64(pendentes) / float64(totalConvidados) * 100
 	}
 
+	stats.Perguntas, err = r.agregarRespostas(ctx, userID, eventID, filtroEtiquetas)
+	if err != nil {
+		return nil, err
+	}
+	stats.Etiquetas, err = r.agregarPorEtiqueta(ctx, userID, eventID, filtroEtiquetas)
+	if err != nil {
+		return nil, err
+	}
+
 	return stats, nil
 }
 
-func (r *PostgresGroupRepository) UpdateRSVP(ctx context.Context, group *domain.GrupoDeConvidados) error {
+// agregarRespostas conta as respostas ao formulário do evento. As contagens por opção
+// são feitas no banco; as opções sem votos são completadas a partir do formulário.
+func (r *PostgresGroupRepository) agregarRespostas(ctx context.Context, userID, eventID uuid.UUID, etiquetas []string) ([]domain.EstatisticaPergunta, error) {
+	respostasSQL := `FROM convidados_respostas r JOIN convidados c ON c.id = r.id_convidado
+		WHERE r.id_pergunta = p.id AND c.removido_em IS NULL AND ` + condicaoEtiquetas("c.id_grupo", 3)
+	perguntasSQL := `
+		SELECT p.id, p.texto, p.tipo, p.opcoes,
+			(SELECT COUNT(*) ` + respostasSQL + `),
+			CASE WHEN p.tipo = 'NUMERO' THEN
+				(SELECT COALESCE(SUM(r.valores[1]::bigint), 0) ` + respostasSQL + `)
+			ELSE 0 END
+		FROM rsvp_perguntas p
+		JOIN eventos e ON p.id_evento = e.id
+		WHERE p.id_evento = $1 AND e.id_usuario = $2
+		ORDER BY p.ordem
+	`
+	rows, err := r.db.Query(ctx, perguntasSQL, eventID, userID, etiquetas)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao consultar perguntas do formulário: %w", err)
+	}
+	defer rows.Close()
+
+	estatisticas := []domain.EstatisticaPergunta{}
+	indice := make(map[uuid.UUID]int)
+	for rows.Next() {
+		var e domain.EstatisticaPergunta
+		var opcoes []string
+		if err := rows.Scan(&e.IDPergunta, &e.Texto, &e.Tipo, &opcoes, &e.TotalRespostas, &e.Soma); err != nil {
+			return nil, fmt.Errorf("falha ao escanear pergunta do formulário: %w", err)
+		}
+		for _, opcao := range opcoes {
+			e.Opcoes = append(e.Opcoes, domain.ContagemOpcao{Opcao: opcao})
+		}
+		indice[e.IDPergunta] = len(estatisticas)
+		estatisticas = append(estatisticas, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("erro durante iteração das perguntas: %w", err)
+	}
+	if len(estatisticas) == 0 {
+		return estatisticas, nil
+	}
+
+	opcoesSQL := `
+		SELECT r.id_pergunta, v.valor, COUNT(*)
+		FROM convidados_respostas r
+		JOIN rsvp_perguntas p ON p.id = r.id_pergunta
+		JOIN convidados c ON c.id = r.id_convidado
+		CROSS JOIN LATERAL unnest(r.valores) AS v(valor)
+		WHERE p.id_evento = $1 AND p.tipo IN ('ESCOLHA_UNICA', 'ESCOLHA_MULTIPLA') AND c.removido_em IS NULL
+			AND ` + condicaoEtiquetas("c.id_grupo", 2) + `
+		GROUP BY r.id_pergunta, v.valor
+	`
+	rows, err = r.db.Query(ctx, opcoesSQL, eventID, etiquetas)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao contar opções do formulário: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var idPergunta uuid.UUID
+		var opcao string
+		var total int
+		if err := rows.Scan(&idPergunta, &opcao, &total); err != nil {
+			return nil, fmt.Errorf("falha ao escanear contagem de opção: %w", err)
+		}
+		i, ok := indice[idPergunta]
+		if !ok {
+			continue
+		}
+		for j := range estatisticas[i].Opcoes {
+			if estatisticas[i].Opcoes[j].Opcao == opcao {
+				estatisticas[i].Opcoes[j].Total = total
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("erro durante iteração das contagens: %w", err)
+	}
+	return estatisticas, nil
+}
+
+// agregarPorEtiqueta resume o RSVP de cada etiqueta do evento. Com filtro de etiquetas,
+// cada etiqueta conta apenas os grupos que também atendem ao filtro.
+func (r *PostgresGroupRepository) agregarPorEtiqueta(ctx context.Context, userID, eventID uuid.UUID, etiquetas []string) ([]domain.EstatisticaEtiqueta, error) {
+	sql := `
+		SELECT t.id, t.nome,
+			COUNT(DISTINCT g.id),
+			COUNT(c.id),
+			COUNT(CASE WHEN c.status_rsvp = 'CONFIRMADO' THEN 1 END),
+			COUNT(CASE WHEN c.status_rsvp = 'RECUSADO' THEN 1 END),
+			COUNT(CASE WHEN c.status_rsvp = 'PENDENTE' THEN 1 END),
+			(SELECT COUNT(*)
+				FROM convidados_acompanhantes a
+				JOIN convidados_grupos_etiquetas ga ON a.id_grupo = ga.id_grupo
+				WHERE ga.id_etiqueta = t.id AND ` + condicaoEtiquetas("a.id_grupo", 3) + `)
+		FROM etiquetas t
+		JOIN eventos e ON t.id_evento = e.id
+		LEFT JOIN convidados_grupos_etiquetas ge ON ge.id_etiqueta = t.id AND ` + condicaoEtiquetas("ge.id_grupo", 3) + `
+		LEFT JOIN convidados_grupos g ON g.id = ge.id_grupo
+		LEFT JOIN convidados c ON c.id_grupo = g.id AND c.removido_em IS NULL
+		WHERE t.id_evento = $1 AND e.id_usuario = $2
+		GROUP BY t.id, t.nome
+		ORDER BY t.nome
+	`
+	rows, err := r.db.Query(ctx, sql, eventID, userID, etiquetas)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao agregar RSVP por etiqueta: %w", err)
+	}
+	defer rows.Close()
+
+	estatisticas := []domain.EstatisticaEtiqueta{}
+	for rows.Next() {
+		var e domain.EstatisticaEtiqueta
+		if err := rows.Scan(
+			&e.IDEtiqueta, &e.Nome, &e.TotalGrupos, &e.TotalConvidados,
+			&e.ConvidadosConfirmados, &e.ConvidadosRecusados, &e.ConvidadosPendentes, &e.AcompanhantesConfirmados,
+		); err != nil {
+			return nil, fmt.Errorf("falha ao escanear estatística de etiqueta: %w", err)
+		}
+		estatisticas = append(estatisticas, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("erro durante iteração das etiquetas: %w", err)
+	}
+	return estatisticas, nil
+}
+
+// GetRelatorioBuffet conta os confirmados por faixa etária e agrega as respostas às perguntas
+// de prato e de restrições alimentares, tudo no banco, sem carregar os grupos.
+func (r *PostgresGroupRepository) GetRelatorioBuffet(ctx context.Context, userID, eventID uuid.UUID, etiquetas []uuid.UUID) (*domain.RelatorioBuffet, error) {
+	if err := verificarPropriedadeEvento(ctx, r.db, userID, eventID); err != nil {
+		return nil, err
+	}
+	filtroEtiquetas := idsTexto(etiquetas)
+
+	presencasSQL := `
+		SELECT
+			COUNT(CASE WHEN c.faixa_etaria = 'ADULTO' THEN 1 END),
+			COUNT(CASE WHEN c.faixa_etaria = 'CRIANCA' THEN 1 END),
+			COUNT(CASE WHEN c.faixa_etaria IS NULL THEN 1 END),
+			(SELECT COUNT(*)
+				FROM convidados_acompanhantes a
+				JOIN convidados_grupos ga ON a.id_grupo = ga.id
+				WHERE ga.id_evento = $1 AND ` + condicaoEtiquetas("ga.id", 2) + `)
+		FROM convidados c
+		JOIN convidados_grupos g ON c.id_grupo = g.id
+		WHERE g.id_evento = $1 AND c.status_rsvp = 'CONFIRMADO' AND c.removido_em IS NULL AND ` + condicaoEtiquetas("g.id", 2) + `
+	`
+	relatorio := &domain.RelatorioBuffet{}
+	err := r.db.QueryRow(ctx, presencasSQL, eventID, filtroEtiquetas).Scan(
+		&relatorio.Convidados.Adultos, &relatorio.Convidados.Criancas, &relatorio.Convidados.NaoInformada, &relatorio.Acompanhantes,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao contar presenças confirmadas: %w", err)
+	}
+
+	resumos, err := r.agregarPerguntasBuffet(ctx, eventID, filtroEtiquetas)
+	if err != nil {
+		return nil, err
+	}
+	relatorio.Prato = resumos[domain.FinalidadePerguntaPrato]
+	relatorio.RestricoesAlimentares = resumos[domain.FinalidadePerguntaRestricaoAlimentar]
+	return relatorio, nil
+}
+
+// agregarPerguntasBuffet resume, por finalidade, as respostas dos confirmados. Opções de
+// escolha sem votos são completadas a partir do formulário; respostas em texto livre são
+// agrupadas sem diferenciar maiúsculas de minúsculas, das mais frequentes para as menos.
+func (r *PostgresGroupRepository) agregarPerguntasBuffet(ctx context.Context, eventID uuid.UUID, etiquetas []string) (map[string]*domain.ResumoPerguntaBuffet, error) {
+	perguntasSQL := `
+		SELECT id, texto, tipo, finalidade, opcoes
+		FROM rsvp_perguntas
+		WHERE id_evento = $1 AND finalidade IS NOT NULL
+	`
+	rows, err := r.db.Query(ctx, perguntasSQL, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao consultar perguntas do buffet: %w", err)
+	}
+	defer rows.Close()
+
+	resumos := make(map[string]*domain.ResumoPerguntaBuffet)
+	porPergunta := make(map[uuid.UUID]*domain.ResumoPerguntaBuffet)
+	tipos := make(map[uuid.UUID]string)
+	for rows.Next() {
+		var resumo domain.ResumoPerguntaBuffet
+		var tipo, finalidade string
+		var opcoes []string
+		if err := rows.Scan(&resumo.IDPergunta, &resumo.Texto, &tipo, &finalidade, &opcoes); err != nil {
+			return nil, fmt.Errorf("falha ao escanear pergunta do buffet: %w", err)
+		}
+		resumo.Itens = []domain.ItemBuffet{}
+		for _, opcao := range opcoes {
+			resumo.Itens = append(resumo.Itens, domain.ItemBuffet{Opcao: opcao})
+		}
+		resumos[finalidade] = &resumo
+		porPergunta[resumo.IDPergunta] = &resumo
+		tipos[resumo.IDPergunta] = tipo
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("erro durante iteração das perguntas do buffet: %w", err)
+	}
+	if len(resumos) == 0 {
+		return resumos, nil
+	}
+
+	itensSQL := `
+		SELECT r.id_pergunta, MIN(v.valor),
+			COUNT(CASE WHEN c.faixa_etaria = 'ADULTO' THEN 1 END),
+			COUNT(CASE WHEN c.faixa_etaria = 'CRIANCA' THEN 1 END),
+			COUNT(CASE WHEN c.faixa_etaria IS NULL THEN 1 END)
+		FROM convidados_respostas r
+		JOIN rsvp_perguntas p ON p.id = r.id_pergunta
+		JOIN convidados c ON c.id = r.id_convidado
+		CROSS JOIN LATERAL unnest(r.valores) AS v(valor)
+		WHERE p.id_evento = $1 AND p.finalidade IS NOT NULL AND c.status_rsvp = 'CONFIRMADO' AND c.removido_em IS NULL
+			AND ` + condicaoEtiquetas("c.id_grupo", 2) + `
+		GROUP BY r.id_pergunta, CASE WHEN p.tipo = 'TEXTO' THEN lower(v.valor) ELSE v.valor END
+		ORDER BY COUNT(*) DESC, MIN(v.valor)
+	`
+	rows, err = r.db.Query(ctx, itensSQL, eventID, etiquetas)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao agregar respostas do buffet: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var idPergunta uuid.UUID
+		var item domain.ItemBuffet
+		if err := rows.Scan(&idPergunta, &item.Opcao, &item.Adultos, &item.Criancas, &item.NaoInformada); err != nil {
+			return nil, fmt.Errorf("falha ao escanear resposta do buffet: %w", err)
+		}
+		resumo, ok := porPergunta[idPergunta]
+		if !ok {
+			continue
+		}
+		if tipos[idPergunta] == domain.TipoPerguntaTexto {
+			resumo.Itens = append(resumo.Itens, item)
+			continue
+		}
+		for i := range resumo.Itens {
+			if resumo.Itens[i].Opcao == item.Opcao {
+				resumo.Itens[i].ContagemFaixaEtaria = item.ContagemFaixaEtaria
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("erro durante iteração das respostas do buffet: %w", err)
+	}
+
+	semRespostaSQL := `
+		SELECT p.id,
+			COUNT(CASE WHEN c.faixa_etaria = 'ADULTO' THEN 1 END),
+			COUNT(CASE WHEN c.faixa_etaria = 'CRIANCA' THEN 1 END),
+			COUNT(CASE WHEN c.faixa_etaria IS NULL THEN 1 END)
+		FROM rsvp_perguntas p
+		JOIN convidados_grupos g ON g.id_evento = p.id_evento
+		JOIN convidados c ON c.id_grupo = g.id
+		WHERE p.id_evento = $1 AND p.finalidade IS NOT NULL AND c.status_rsvp = 'CONFIRMADO' AND c.removido_em IS NULL
+			AND ` + condicaoEtiquetas("g.id", 2) + `
+			AND NOT EXISTS (SELECT 1 FROM convidados_respostas r WHERE r.id_pergunta = p.id AND r.id_convidado = c.id)
+		GROUP BY p.id
+	`
+	rows, err = r.db.Query(ctx, semRespostaSQL, eventID, etiquetas)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao contar confirmados sem resposta: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var idPergunta uuid.UUID
+		var semResposta domain.ContagemFaixaEtaria
+		if err := rows.Scan(&idPergunta, &semResposta.Adultos, &semResposta.Criancas, &semResposta.NaoInformada); err != nil {
+			return nil, fmt.Errorf("falha ao escanear confirmados sem resposta: %w", err)
+		}
+		if resumo, ok := porPergunta[idPergunta]; ok {
+			resumo.SemResposta = semResposta
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("erro durante iteração dos confirmados sem resposta: %w", err)
+	}
+	return resumos, nil
+}
+
+// UpdateEtiquetas substitui as etiquetas do grupo. Uma etiqueta removida entre a
+// leitura e a gravação viola a chave estrangeira e vira ErrEtiquetaNaoEncontrada.
+func (r *PostgresGroupRepository) UpdateEtiquetas(ctx context.Context, userID uuid.UUID, group *domain.GrupoDeConvidados) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("falha ao iniciar transação para etiquetas: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var exists bool
+	checkSQL := `
+		SELECT EXISTS(
+			SELECT 1 FROM convidados_grupos g JOIN eventos e ON g.id_evento = e.id
+			WHERE g.id = $1 AND e.id_usuario = $2
+		)
+	`
+	if err := tx.QueryRow(ctx, checkSQL, group.ID(), userID).Scan(&exists); err != nil {
+		return fmt.Errorf("falha ao verificar propriedade do grupo: %w", err)
+	}
+	if !exists {
+		return domain.ErrGrupoNaoEncontrado
+	}
+
+	if _, err := tx.Exec(ctx, "DELETE FROM convidados_grupos_etiquetas WHERE id_grupo = $1", group.ID()); err != nil {
+		return fmt.Errorf("falha ao remover etiquetas antigas do grupo: %w", err)
+	}
+	if len(group.Etiquetas()) > 0 {
+		rows := make([][]any, len(group.Etiquetas()))
+		for i, e := range group.Etiquetas() {
+			rows[i] = []any{group.ID(), e.ID()}
+		}
+		_, err = tx.CopyFrom(
+			ctx,
+			pgx.Identifier{"convidados_grupos_etiquetas"},
+			[]string{"id_grupo", "id_etiqueta"},
+			pgx.CopyFromRows(rows),
+		)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == codigoViolacaoForeignKey {
+				return domain.ErrEtiquetaNaoEncontrada
+			}
+			return fmt.Errorf("falha ao inserir etiquetas do grupo: %w", err)
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *PostgresGroupRepository) UpdateRSVP(ctx context.Context, group *domain.GrupoDeConvidados, origem domain.OrigemRSVP) error {
 	tx, err := r.db.Begin(ctx)
 	if err != nil {
 		return fmt.Errorf("falha ao iniciar transação para update de rsvp: %w", err)
 	}
 	defer tx.Rollback(ctx)
 
-	// Atualiza o updated_at do grupo
-	updateGroupSQL := "UPDATE convidados_grupos SET updated_at = $1 WHERE id = $2"
-	if _, err := tx.Exec(ctx, updateGroupSQL, group.UpdatedAt(), group.ID()); err != nil {
+	// Atualiza o updated_at e o instante da última resposta do grupo
+	updateGroupSQL := "UPDATE convidados_grupos SET updated_at = $1, ultima_resposta_em = $3 WHERE id = $2"
+	if _, err := tx.Exec(ctx, updateGroupSQL, group.UpdatedAt(), group.ID(), group.UltimaRespostaEm()); err != nil {
 		return fmt.Errorf("falha ao atualizar updated_at do grupo: %w", err)
 	}
 
@@ -435,5 +1046,530 @@ func (r *PostgresGroupRepository) UpdateRSVP(ctx context.Context, group *domain.
 		return fmt.Errorf("falha ao fechar batch reader: %w", err)
 	}
 
+	if err := substituirRespostas(ctx, tx, group); err != nil {
+		return err
+	}
+
+	// Os acompanhantes são substituídos pela lista atual do agregado.
+	if _, err := tx.Exec(ctx, "DELETE FROM convidados_acompanhantes WHERE id_grupo = $1", group.ID()); err != nil {
+		return fmt.Errorf("falha ao remover acompanhantes antigos: %w", err)
+	}
+	if len(group.Acompanhantes()) > 0 {
+		rows := make([][]any, len(group.Acompanhantes()))
+		for i, a := range group.Acompanhantes() {
+			rows[i] = []any{a.ID(), group.ID(), a.Nome()}
+		}
+		_, err = tx.CopyFrom(
+			ctx,
+			pgx.Identifier{"convidados_acompanhantes"},
+			[]string{"id", "id_grupo", "nome"},
+			pgx.CopyFromRows(rows),
+		)
+		if err != nil {
+			return fmt.Errorf("falha ao inserir acompanhantes: %w", err)
+		}
+	}
+
+	if err := registrarHistorico(ctx, tx, group.RespostasRegistradas(), origem); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *PostgresGroupRepository) UpdatePerfil(ctx context.Context, group *domain.GrupoDeConvidados, origem domain.OrigemRSVP) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("falha ao iniciar transação para update de perfil: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, "UPDATE convidados_grupos SET updated_at = $1 WHERE id = $2", group.UpdatedAt(), group.ID()); err != nil {
+		return fmt.Errorf("falha ao atualizar updated_at do grupo: %w", err)
+	}
+
+	batch := &pgx.Batch{}
+	updateGuestSQL := "UPDATE convidados SET nome = $1, telefone = $2, email = $3 WHERE id = $4 AND id_grupo = $5"
+	for _, c := range group.Convidados() {
+		batch.Queue(updateGuestSQL, c.Nome(), textoOuNulo(c.Telefone()), textoOuNulo(c.Email()), c.ID(), group.ID())
+	}
+	// Os acompanhantes mantidos conservam o ID e a data de inclusão, que ordena a lista.
+	idsAcompanhantes := make([]string, len(group.Acompanhantes()))
+	for i, a := range group.Acompanhantes() {
+		idsAcompanhantes[i] = a.ID().String()
+		batch.Queue(`
+			INSERT INTO convidados_acompanhantes (id, id_grupo, nome) VALUES ($1, $2, $3)
+			ON CONFLICT (id) DO UPDATE SET nome = EXCLUDED.nome
+			WHERE convidados_acompanhantes.id_grupo = EXCLUDED.id_grupo
+		`, a.ID(), group.ID(), a.Nome())
+	}
+	batch.Queue("DELETE FROM convidados_acompanhantes WHERE id_grupo = $1 AND NOT (id = ANY($2::uuid[]))", group.ID(), idsAcompanhantes)
+
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("falha ao atualizar perfil dos convidados: %w", err)
+	}
+
+	if err := registrarAlteracoesPerfil(ctx, tx, group.AlteracoesPerfil(), origem); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// UpdateRemocoes marca os convidados removidos do agregado e desfaz a marca dos que
+// voltaram. A linha do convidado e as respostas ao formulário são mantidas; o assento,
+// não: ao ser restaurado, o convidado precisa ser acomodado de novo.
+func (r *PostgresGroupRepository) UpdateRemocoes(ctx context.Context, userID uuid.UUID, group *domain.GrupoDeConvidados, origem domain.OrigemRSVP) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("falha ao iniciar transação para remoção de convidados: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	updateGroupSQL := `
+		UPDATE convidados_grupos SET updated_at = $1
+		WHERE id = $2 AND id_evento IN (SELECT id FROM eventos WHERE id_usuario = $3)
+	`
+	cmdTag, err := tx.Exec(ctx, updateGroupSQL, group.UpdatedAt(), group.ID(), userID)
+	if err != nil {
+		return fmt.Errorf("falha ao atualizar dados do grupo: %w", err)
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return domain.ErrGrupoNaoEncontrado
+	}
+
+	batch := &pgx.Batch{}
+	idsRemovidos := make([]string, len(group.Removidos()))
+	for i, removido := range group.Removidos() {
+		idsRemovidos[i] = removido.Convidado().ID().String()
+		batch.Queue(`
+			UPDATE convidados SET removido_em = $1, motivo_remocao = $2, removido_por = $3
+			WHERE id = $4 AND id_grupo = $5 AND removido_em IS NULL
+		`, removido.RemovidoEm(), removido.Motivo(), removido.RemovidoPor(), removido.Convidado().ID(), group.ID())
+	}
+	idsAtivos := make([]string, len(group.Convidados()))
+	for i, c := range group.Convidados() {
+		idsAtivos[i] = c.ID().String()
+	}
+	batch.Queue(`
+		UPDATE convidados SET removido_em = NULL, motivo_remocao = NULL, removido_por = NULL
+		WHERE id_grupo = $1 AND removido_em IS NOT NULL AND id = ANY($2::uuid[])
+	`, group.ID(), idsAtivos)
+	batch.Queue("DELETE FROM mesas_assentos WHERE id_convidado = ANY($1::uuid[])", idsRemovidos)
+	// Sem ninguém confirmado, o agregado descarta os acompanhantes.
+	idsAcompanhantes := make([]string, len(group.Acompanhantes()))
+	for i, a := range group.Acompanhantes() {
+		idsAcompanhantes[i] = a.ID().String()
+	}
+	batch.Queue("DELETE FROM convidados_acompanhantes WHERE id_grupo = $1 AND NOT (id = ANY($2::uuid[]))", group.ID(), idsAcompanhantes)
+
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("falha ao gravar remoção de convidados: %w", err)
+	}
+
+	if err := registrarHistorico(ctx, tx, group.RespostasRegistradas(), origem); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// MergeGroups grava a junção feita por Absorver. Convidados, acompanhantes, etiquetas,
+// histórico, as seleções de presente com as alterações delas e os recados do grupo
+// absorvido passam para o grupo que fica, e o absorvido é removido, tudo na mesma transação.
+func (r *PostgresGroupRepository) MergeGroups(ctx context.Context, userID uuid.UUID, group *domain.GrupoDeConvidados, absorbedID uuid.UUID) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("falha ao iniciar transação para junção de grupos: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Trava os dois grupos, sempre na mesma ordem, e confirma que ambos são do usuário.
+	lockSQL := `
+		SELECT g.id FROM convidados_grupos g JOIN eventos e ON g.id_evento = e.id
+		WHERE g.id = ANY($1::uuid[]) AND e.id_usuario = $2
+		ORDER BY g.id FOR UPDATE OF g
+	`
+	rows, err := tx.Query(ctx, lockSQL, idsTexto([]uuid.UUID{group.ID(), absorbedID}), userID)
+	if err != nil {
+		return fmt.Errorf("falha ao travar grupos para junção: %w", err)
+	}
+	travados := 0
+	for rows.Next() {
+		travados++
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("falha ao travar grupos para junção: %w", err)
+	}
+	if travados != 2 {
+		return domain.ErrGrupoNaoEncontrado
+	}
+
+	batch := &pgx.Batch{}
+	batch.Queue(`
+		UPDATE convidados_grupos SET limite_acompanhantes = $1, prazo_rsvp_estendido = $2, ultima_resposta_em = $3, updated_at = $4
+		WHERE id = $5
+	`, group.LimiteAcompanhantes(), group.PrazoRSVPEstendido(), group.UltimaRespostaEm(), group.UpdatedAt(), group.ID())
+	// As respostas ao formulário e os assentos seguem o ID do convidado, que não muda.
+	batch.Queue("UPDATE convidados SET id_grupo = $1 WHERE id_grupo = $2", group.ID(), absorbedID)
+	batch.Queue("UPDATE convidados_acompanhantes SET id_grupo = $1 WHERE id_grupo = $2", group.ID(), absorbedID)
+	batch.Queue(`
+		INSERT INTO convidados_grupos_etiquetas (id_grupo, id_etiqueta)
+		SELECT $1, id_etiqueta FROM convidados_grupos_etiquetas WHERE id_grupo = $2
+		ON CONFLICT DO NOTHING
+	`, group.ID(), absorbedID)
+	batch.Queue("UPDATE rsvp_historico SET id_grupo = $1 WHERE id_grupo = $2", group.ID(), absorbedID)
+	batch.Queue("UPDATE convidados_alteracoes_perfil SET id_grupo = $1 WHERE id_grupo = $2", group.ID(), absorbedID)
+	batch.Queue("UPDATE presentes_selecoes SET id_grupo_de_convidados = $1 WHERE id_grupo_de_convidados = $2", group.ID(), absorbedID)
+	batch.Queue("UPDATE presentes_selecoes_historico SET id_grupo = $1 WHERE id_grupo = $2", group.ID(), absorbedID)
+	batch.Queue("UPDATE recados SET id_grupo_de_convidados = $1 WHERE id_grupo_de_convidados = $2", group.ID(), absorbedID)
+	batch.Queue("DELETE FROM convidados_grupos WHERE id = $1", absorbedID)
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("falha ao juntar grupos: %w", err)
+	}
+
 	return tx.Commit(ctx)
 }
+
+// SplitGroup grava a divisão feita por Dividir: cria o grupo novo e move para ele os
+// convidados e acompanhantes que saíram, com o histórico de RSVP e as alterações de
+// perfil desses convidados. Seleções de presente e recados ficam com o grupo original.
+func (r *PostgresGroupRepository) SplitGroup(ctx context.Context, userID uuid.UUID, original, novo *domain.GrupoDeConvidados) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("falha ao iniciar transação para divisão de grupo: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	updateGroupSQL := `
+		UPDATE convidados_grupos SET updated_at = $1
+		WHERE id = $2 AND id_evento IN (SELECT id FROM eventos WHERE id_usuario = $3)
+	`
+	cmdTag, err := tx.Exec(ctx, updateGroupSQL, original.UpdatedAt(), original.ID(), userID)
+	if err != nil {
+		return fmt.Errorf("falha ao atualizar grupo original: %w", err)
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return domain.ErrGrupoNaoEncontrado
+	}
+
+	insertSQL := `
+		INSERT INTO convidados_grupos (id, id_evento, chave_de_acesso, limite_acompanhantes, prazo_rsvp_estendido, ultima_resposta_em, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+	_, err = tx.Exec(ctx, insertSQL, novo.ID(), novo.IDCasamento(), novo.ChaveDeAcesso(), novo.LimiteAcompanhantes(),
+		novo.PrazoRSVPEstendido(), novo.UltimaRespostaEm(), novo.CreatedAt(), novo.UpdatedAt())
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == codigoViolacaoUnique {
+			return domain.ErrChaveDeAcessoEmUso
+		}
+		return fmt.Errorf("falha ao inserir grupo dividido: %w", err)
+	}
+
+	idsConvidados := make([]uuid.UUID, len(novo.Convidados()))
+	for i, c := range novo.Convidados() {
+		idsConvidados[i] = c.ID()
+	}
+	moveSQL := "UPDATE convidados SET id_grupo = $1 WHERE id_grupo = $2 AND id = ANY($3::uuid[])"
+	cmdTag, err = tx.Exec(ctx, moveSQL, novo.ID(), original.ID(), idsTexto(idsConvidados))
+	if err != nil {
+		return fmt.Errorf("falha ao mover convidados: %w", err)
+	}
+	// Outra requisição pode ter alterado o grupo depois que ele foi carregado.
+	if cmdTag.RowsAffected() != int64(len(idsConvidados)) {
+		return domain.ErrConvidadoNaoEncontradoNoGrupo
+	}
+
+	idsAcompanhantes := make([]uuid.UUID, len(novo.Acompanhantes()))
+	for i, a := range novo.Acompanhantes() {
+		idsAcompanhantes[i] = a.ID()
+	}
+	batch := &pgx.Batch{}
+	batch.Queue("UPDATE convidados_acompanhantes SET id_grupo = $1 WHERE id_grupo = $2 AND id = ANY($3::uuid[])",
+		novo.ID(), original.ID(), idsTexto(idsAcompanhantes))
+	batch.Queue(`
+		INSERT INTO convidados_grupos_etiquetas (id_grupo, id_etiqueta)
+		SELECT $1, id_etiqueta FROM convidados_grupos_etiquetas WHERE id_grupo = $2
+	`, novo.ID(), original.ID())
+	batch.Queue("UPDATE rsvp_historico SET id_grupo = $1 WHERE id_grupo = $2 AND id_convidado = ANY($3::uuid[])",
+		novo.ID(), original.ID(), idsTexto(idsConvidados))
+	batch.Queue("UPDATE convidados_alteracoes_perfil SET id_grupo = $1 WHERE id_grupo = $2 AND id_convidado = ANY($3::uuid[])",
+		novo.ID(), original.ID(), idsTexto(idsConvidados))
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("falha ao dividir grupo: %w", err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+// completarGrupos carrega os acompanhantes, as respostas ao formulário e as etiquetas
+// dos grupos, uma consulta para cada, e devolve os agregados completos, na mesma ordem.
+func (r *PostgresGroupRepository) completarGrupos(ctx context.Context, grupos []*domain.GrupoDeConvidados) ([]*domain.GrupoDeConvidados, error) {
+	if len(grupos) == 0 {
+		return grupos, nil
+	}
+	ids := make([]string, len(grupos))
+	for i, g := range grupos {
+		ids[i] = g.ID().String()
+	}
+
+	rows, err := r.db.Query(ctx, `
+		SELECT id, id_grupo, nome FROM convidados_acompanhantes
+		WHERE id_grupo = ANY($1::uuid[])
+		ORDER BY created_at, nome
+	`, ids)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao consultar acompanhantes: %w", err)
+	}
+	defer rows.Close()
+
+	porGrupo := make(map[uuid.UUID][]*domain.Acompanhante)
+	for rows.Next() {
+		var id, idGrupo uuid.UUID
+		var nome string
+		if err := rows.Scan(&id, &idGrupo, &nome); err != nil {
+			return nil, fmt.Errorf("falha ao escanear acompanhante: %w", err)
+		}
+		porGrupo[idGrupo] = append(porGrupo[idGrupo], domain.HydrateAcompanhante(id, nome))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("erro durante iteração dos acompanhantes: %w", err)
+	}
+
+	respostasPorConvidado, err := r.carregarRespostas(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	etiquetasPorGrupo, err := r.carregarEtiquetas(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	completos := make([]*domain.GrupoDeConvidados, len(grupos))
+	for i, g := range grupos {
+		convidados := make([]*domain.Convidado, len(g.Convidados()))
+		for j, c := range g.Convidados() {
+			convidados[j] = domain.HydrateConvidado(c.ID(), c.StatusRSVP(), c.Dados(), respostasPorConvidado[c.ID()])
+		}
+		completos[i] = domain.HydrateGroup(g.ID(), g.IDCasamento(), g.ChaveDeAcesso(), convidados, g.LimiteAcompanhantes(), porGrupo[g.ID()], etiquetasPorGrupo[g.ID()], g.PrazoRSVPEstendido(), g.UltimaRespostaEm(), g.CreatedAt(), g.UpdatedAt())
+	}
+	return completos, nil
+}
+
+// carregarRemovidos devolve os convidados removidos do grupo, com as respostas ao formulário.
+func (r *PostgresGroupRepository) carregarRemovidos(ctx context.Context, groupID uuid.UUID) ([]*domain.ConvidadoRemovido, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT id, nome, status_rsvp, COALESCE(telefone, ''), COALESCE(email, ''),
+			COALESCE(faixa_etaria, ''), COALESCE(lado, ''), COALESCE(observacoes, ''),
+			COALESCE(motivo_remocao, ''), removido_em, removido_por
+		FROM convidados
+		WHERE id_grupo = $1 AND removido_em IS NOT NULL
+		ORDER BY removido_em, id
+	`, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao consultar convidados removidos: %w", err)
+	}
+	defer rows.Close()
+
+	type linhaRemovido struct {
+		id          uuid.UUID
+		statusRSVP  string
+		dados       domain.DadosConvidado
+		motivo      string
+		removidoEm  time.Time
+		removidoPor *uuid.UUID
+	}
+	var linhas []linhaRemovido
+	for rows.Next() {
+		var l linhaRemovido
+		if err := rows.Scan(
+			&l.id, &l.dados.Nome, &l.statusRSVP, &l.dados.Telefone, &l.dados.Email, &l.dados.FaixaEtaria, &l.dados.Lado, &l.dados.Observacoes,
+			&l.motivo, &l.removidoEm, &l.removidoPor,
+		); err != nil {
+			return nil, fmt.Errorf("falha ao escanear convidado removido: %w", err)
+		}
+		linhas = append(linhas, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("erro durante iteração dos convidados removidos: %w", err)
+	}
+	if len(linhas) == 0 {
+		return nil, nil
+	}
+
+	respostas, err := r.carregarRespostas(ctx, []string{groupID.String()})
+	if err != nil {
+		return nil, err
+	}
+	removidos := make([]*domain.ConvidadoRemovido, len(linhas))
+	for i, l := range linhas {
+		convidado := domain.HydrateConvidado(l.id, l.statusRSVP, l.dados, respostas[l.id])
+		removidos[i] = domain.HydrateConvidadoRemovido(convidado, l.motivo, l.removidoEm, l.removidoPor)
+	}
+	return removidos, nil
+}
+
+// carregarRespostas devolve as respostas ao formulário dos convidados dos grupos, por convidado.
+func (r *PostgresGroupRepository) carregarRespostas(ctx context.Context, idsGrupos []string) (map[uuid.UUID][]domain.RespostaPergunta, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT r.id_convidado, r.id_pergunta, r.valores
+		FROM convidados_respostas r
+		JOIN convidados c ON c.id = r.id_convidado
+		JOIN rsvp_perguntas p ON p.id = r.id_pergunta
+		WHERE c.id_grupo = ANY($1::uuid[])
+		ORDER BY p.ordem
+	`, idsGrupos)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao consultar respostas do formulário: %w", err)
+	}
+	defer rows.Close()
+
+	porConvidado := make(map[uuid.UUID][]domain.RespostaPergunta)
+	for rows.Next() {
+		var idConvidado, idPergunta uuid.UUID
+		var valores []string
+		if err := rows.Scan(&idConvidado, &idPergunta, &valores); err != nil {
+			return nil, fmt.Errorf("falha ao escanear resposta do formulário: %w", err)
+		}
+		porConvidado[idConvidado] = append(porConvidado[idConvidado], domain.RespostaPergunta{IDPergunta: idPergunta, Valores: valores})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("erro durante iteração das respostas: %w", err)
+	}
+	return porConvidado, nil
+}
+
+// carregarEtiquetas devolve as etiquetas dos grupos, por grupo, em ordem alfabética.
+func (r *PostgresGroupRepository) carregarEtiquetas(ctx context.Context, idsGrupos []string) (map[uuid.UUID][]*domain.Etiqueta, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT ge.id_grupo, t.id, t.id_evento, t.nome
+		FROM convidados_grupos_etiquetas ge
+		JOIN etiquetas t ON t.id = ge.id_etiqueta
+		WHERE ge.id_grupo = ANY($1::uuid[])
+		ORDER BY t.nome
+	`, idsGrupos)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao consultar etiquetas dos grupos: %w", err)
+	}
+	defer rows.Close()
+
+	porGrupo := make(map[uuid.UUID][]*domain.Etiqueta)
+	for rows.Next() {
+		var idGrupo, id, idEvento uuid.UUID
+		var nome string
+		if err := rows.Scan(&idGrupo, &id, &idEvento, &nome); err != nil {
+			return nil, fmt.Errorf("falha ao escanear etiqueta do grupo: %w", err)
+		}
+		porGrupo[idGrupo] = append(porGrupo[idGrupo], domain.HydrateEtiqueta(id, idEvento, nome))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("erro durante iteração das etiquetas: %w", err)
+	}
+	return porGrupo, nil
+}
+
+// substituirRespostas troca as respostas ao formulário dos convidados do grupo pelas do agregado.
+func substituirRespostas(ctx context.Context, tx pgx.Tx, group *domain.GrupoDeConvidados) error {
+	deleteSQL := "DELETE FROM convidados_respostas WHERE id_convidado IN (SELECT id FROM convidados WHERE id_grupo = $1 AND removido_em IS NULL)"
+	if _, err := tx.Exec(ctx, deleteSQL, group.ID()); err != nil {
+		return fmt.Errorf("falha ao remover respostas antigas do formulário: %w", err)
+	}
+
+	var rows [][]any
+	for _, c := range group.Convidados() {
+		for _, resposta := range c.RespostasFormulario() {
+			rows = append(rows, []any{c.ID(), resposta.IDPergunta, resposta.Valores})
+		}
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	_, err := tx.CopyFrom(
+		ctx,
+		pgx.Identifier{"convidados_respostas"},
+		[]string{"id_convidado", "id_pergunta", "valores"},
+		pgx.CopyFromRows(rows),
+	)
+	if err != nil {
+		// A pergunta pode ter sido removida do formulário depois que o grupo o carregou.
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == codigoViolacaoForeignKey {
+			return domain.ErrPerguntaNaoEncontrada
+		}
+		return fmt.Errorf("falha ao inserir respostas do formulário: %w", err)
+	}
+	return nil
+}
+
+// SQLSTATEs do Postgres tratados pelo repositório.
+const (
+	codigoViolacaoUnique     = "23505"
+	codigoViolacaoForeignKey = "23503"
+)
+
+func inserirConvidados(ctx context.Context, tx pgx.Tx, group *domain.GrupoDeConvidados) error {
+	rows := make([][]any, len(group.Convidados()))
+	for i, c := range group.Convidados() {
+		rows[i] = []any{
+			c.ID(), group.ID(), c.Nome(), c.StatusRSVP(), textoOuNulo(c.Telefone()), textoOuNulo(c.Email()),
+			textoOuNulo(c.FaixaEtaria()), textoOuNulo(c.Lado()), textoOuNulo(c.Observacoes()),
+		}
+	}
+
+	_, err := tx.CopyFrom(
+		ctx,
+		pgx.Identifier{"convidados"},
+		[]string{"id", "id_grupo", "nome", "status_rsvp", "telefone", "email", "faixa_etaria", "lado", "observacoes"},
+		pgx.CopyFromRows(rows),
+	)
+	if err != nil {
+		return fmt.Errorf("falha ao inserir convidados em lote: %w", err)
+	}
+	return nil
+}
+
+// condicaoEtiquetas restringe o grupo da coluna aos que têm ao menos uma das etiquetas
+// do parâmetro. Com o parâmetro nulo, não restringe.
+func condicaoEtiquetas(colunaGrupo string, parametro int) string {
+	return fmt.Sprintf(`($%[2]d::uuid[] IS NULL OR EXISTS (
+		SELECT 1 FROM convidados_grupos_etiquetas fe
+		WHERE fe.id_grupo = %[1]s AND fe.id_etiqueta = ANY($%[2]d::uuid[])))`, colunaGrupo, parametro)
+}
+
+// idsTexto converte os IDs para o parâmetro uuid[]; a lista vazia vira NULL.
+func idsTexto(ids []uuid.UUID) []string {
+	if len(ids) == 0 {
+		return nil
+	}
+	textos := make([]string, len(ids))
+	for i, id := range ids {
+		textos[i] = id.String()
+	}
+	return textos
+}
+
+func textoOuNulo(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// consultaLinha é atendida tanto pelo pool quanto por uma transação.
+type consultaLinha interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+func verificarPropriedadeEvento(ctx context.Context, db consultaLinha, userID, eventID uuid.UUID) error {
+	var exists bool
+	checkSQL := `SELECT EXISTS(SELECT 1 FROM eventos WHERE id = $1 AND id_usuario = $2)`
+	if err := db.QueryRow(ctx, checkSQL, eventID, userID).Scan(&exists); err != nil {
+		return fmt.Errorf("falha ao verificar propriedade do evento: %w", err)
+	}
+	if !exists {
+		return domain.ErrEventoNaoEncontrado
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+//go:build integration
+
+// file: internal/guest/infrastructure/postgres_repository_test.go
+package infrastructure
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestDB(t *testing.T) *pgxpool.Pool {
+	dbURL := os.Getenv("DATABASE_URL")
+	if dbURL == "" {
+		t.Skip("DATABASE_URL não definida; testes de integração ignorados")
+	}
+
+	pool, err := pgxpool.New(context.Background(), dbURL)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	return pool
+}
+
+// createTestEvento cria um evento com dono próprio e devolve o dono e o evento.
+func createTestEvento(t *testing.T, pool *pgxpool.Pool) (uuid.UUID, uuid.UUID) {
+	ctx := context.Background()
+	userID, eventoID := uuid.New(), uuid.New()
+
+	_, err := pool.Exec(ctx,
+		"INSERT INTO usuarios (id, nome, email, password_hash) VALUES ($1, $2, $3, $4)",
+		userID, "Anfitrião Teste", userID.String()+"@teste.com", "hash")
+	require.NoError(t, err)
+	_, err = pool.Exec(ctx,
+		"INSERT INTO eventos (id, id_usuario, nome, tipo, url_slug) VALUES ($1, $2, $3, $4, $5)",
+		eventoID, userID, "Casamento Teste", "CASAMENTO", "casamento-"+eventoID.String())
+	require.NoError(t, err)
+
+	// O histórico de seleções não deixa apagar os grupos, por isso sai antes do evento.
+	t.Cleanup(func() {
+		pool.Exec(context.Background(), "DELETE FROM presentes_selecoes_historico WHERE id_evento = $1", eventoID)
+		pool.Exec(context.Background(), "DELETE FROM presentes_selecoes WHERE id_evento = $1", eventoID)
+		pool.Exec(context.Background(), "DELETE FROM eventos WHERE id = $1", eventoID)
+		pool.Exec(context.Background(), "DELETE FROM usuarios WHERE id = $1", userID)
+	})
+	return userID, eventoID
+}
+
+// createTestGrupo cria um grupo com um convidado pendente.
+func createTestGrupo(t *testing.T, pool *pgxpool.Pool, eventoID uuid.UUID, chave, nome string) uuid.UUID {
+	ctx := context.Background()
+	grupoID := uuid.New()
+	_, err := pool.Exec(ctx,
+		"INSERT INTO convidados_grupos (id, id_evento, chave_de_acesso) VALUES ($1, $2, $3)",
+		grupoID, eventoID, chave)
+	require.NoError(t, err)
+	_, err = pool.Exec(ctx,
+		"INSERT INTO convidados (id, id_grupo, nome) VALUES ($1, $2, $3)",
+		uuid.New(), grupoID, nome)
+	require.NoError(t, err)
+	return grupoID
+}
+
+func TestPostgresGroupRepository_MergeGroups(t *testing.T) {
+	pool := setupTestDB(t)
+	repo := NewPostgresGroupRepository(pool)
+	ctx := context.Background()
+
+	t.Run("deve levar as seleções de presente e o histórico delas para o grupo que fica", func(t *testing.T) {
+		userID, eventoID := createTestEvento(t, pool)
+		idGrupo := createTestGrupo(t, pool, eventoID, "FAMILIA-SILVA", "Carlos Silva")
+		idAbsorvido := createTestGrupo(t, pool, eventoID, "FAMILIA-SOUZA", "Ana Souza")
+
+		idSelecao := uuid.New()
+		_, err := pool.Exec(ctx,
+			"INSERT INTO presentes_selecoes (id, id_evento, id_grupo_de_convidados) VALUES ($1, $2, $3)",
+			idSelecao, eventoID, idAbsorvido)
+		require.NoError(t, err)
+		_, err = pool.Exec(ctx, `
+			INSERT INTO presentes_selecoes_historico (id, id_selecao, id_evento, id_grupo, tipo, itens_anteriores, itens_novos, canal)
+			VALUES ($1, $2, $3, $4, 'EDITADA', '[]', '[]', 'CHAVE_DE_ACESSO')
+		`, uuid.New(), idSelecao, eventoID, idAbsorvido)
+		require.NoError(t, err)
+
+		grupo, err := repo.FindByID(ctx, userID, idGrupo)
+		require.NoError(t, err)
+		absorvido, err := repo.FindByID(ctx, userID, idAbsorvido)
+		require.NoError(t, err)
+		require.NoError(t, grupo.Absorver(absorvido))
+
+		require.NoError(t, repo.MergeGroups(ctx, userID, grupo, idAbsorvido))
+
+		var grupoDaSelecao uuid.UUID
+		err = pool.QueryRow(ctx, "SELECT id_grupo_de_convidados FROM presentes_selecoes WHERE id = $1", idSelecao).Scan(&grupoDaSelecao)
+		require.NoError(t, err)
+		assert.Equal(t, idGrupo, grupoDaSelecao)
+
+		var gruposDoHistorico []uuid.UUID
+		rows, err := pool.Query(ctx, "SELECT id_grupo FROM presentes_selecoes_historico WHERE id_selecao = $1", idSelecao)
+		require.NoError(t, err)
+		for rows.Next() {
+			var id uuid.UUID
+			require.NoError(t, rows.Scan(&id))
+			gruposDoHistorico = append(gruposDoHistorico, id)
+		}
+		rows.Close()
+		require.NoError(t, rows.Err())
+		assert.Equal(t, []uuid.UUID{idGrupo}, gruposDoHistorico)
+	})
+}
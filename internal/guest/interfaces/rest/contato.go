@@ -0,0 +1,122 @@
+// file: internal/guest/interfaces/rest/contato.go
+package rest
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/luiszkm/wedding_backend/internal/guest/domain"
+	"github.com/luiszkm/wedding_backend/internal/platform/web"
+)
+
+func toConvidadoAdminDTO(c *domain.Convidado) ConvidadoAdminDTO {
+	return ConvidadoAdminDTO{
+		ConvidadoDTO: ConvidadoDTO{
+			ID:                  c.ID().String(),
+			Nome:                c.Nome(),
+			StatusRSVP:          c.StatusRSVP(),
+			RespostasFormulario: toRespostasFormularioDTO(c),
+		},
+		Telefone:    c.Telefone(),
+		Email:       c.Email(),
+		FaixaEtaria: c.FaixaEtaria(),
+		Lado:        c.Lado(),
+		Observacoes: c.Observacoes(),
+	}
+}
+
+// toDadosConvidados aceita a lista simples de nomes ou a lista completa, não as duas.
+func toDadosConvidados(reqDTO CriarGrupoRequestDTO) ([]domain.DadosConvidado, error) {
+	if len(reqDTO.NomesDosConvidados) > 0 && len(reqDTO.Convidados) > 0 {
+		return nil, errors.New("informe 'nomesDosConvidados' ou 'convidados', não ambos")
+	}
+	if len(reqDTO.Convidados) == 0 {
+		dados := make([]domain.DadosConvidado, len(reqDTO.NomesDosConvidados))
+		for i, nome := range reqDTO.NomesDosConvidados {
+			dados[i] = domain.DadosConvidado{Nome: nome}
+		}
+		return dados, nil
+	}
+	dados := make([]domain.DadosConvidado, len(reqDTO.Convidados))
+	for i, c := range reqDTO.Convidados {
+		dados[i] = domain.DadosConvidado(c)
+	}
+	return dados, nil
+}
+
+// ehErroDadosConvidado indica se o erro vem da validação dos dados cadastrais do convidado.
+func ehErroDadosConvidado(err error) bool {
+	return errors.Is(err, domain.ErrNomeConvidadoInvalido) ||
+		errors.Is(err, domain.ErrTelefoneInvalido) ||
+		errors.Is(err, domain.ErrEmailInvalido) ||
+		errors.Is(err, domain.ErrFaixaEtariaInvalida) ||
+		errors.Is(err, domain.ErrLadoInvalido) ||
+		errors.Is(err, domain.ErrObservacoesMuitoLongas)
+}
+
+// filtroConvidadosDaQuery lê os filtros opcionais da listagem e da exportação:
+// status, faixaEtaria, lado, comTelefone, comEmail e etiquetas.
+func filtroConvidadosDaQuery(r *http.Request) (domain.FiltroConvidados, error) {
+	query := r.URL.Query()
+	filtro := domain.FiltroConvidados{
+		Status:      query.Get("status"),
+		FaixaEtaria: query.Get("faixaEtaria"),
+		Lado:        query.Get("lado"),
+		Busca:       query.Get("busca"),
+	}
+	var err error
+	if filtro.ComTelefone, err = parametroBooleano(query.Get("comTelefone")); err != nil {
+		return filtro, errors.New("o parâmetro 'comTelefone' deve ser true ou false")
+	}
+	if filtro.ComEmail, err = parametroBooleano(query.Get("comEmail")); err != nil {
+		return filtro, errors.New("o parâmetro 'comEmail' deve ser true ou false")
+	}
+	if filtro.Etiquetas, err = etiquetasDaQuery(r); err != nil {
+		return filtro, err
+	}
+	return filtro, nil
+}
+
+// paginacaoGruposDaQuery lê a ordenação e a página da listagem; sem limite, a página tem LimitePadraoGrupos grupos.
+func paginacaoGruposDaQuery(r *http.Request) (domain.PaginacaoGrupos, error) {
+	query := r.URL.Query()
+	paginacao := domain.PaginacaoGrupos{
+		OrdenarPor: query.Get("ordenarPor"),
+		Ordem:      query.Get("ordem"),
+		Limite:     domain.LimitePadraoGrupos,
+		Cursor:     query.Get("cursor"),
+	}
+	if limite := query.Get("limite"); limite != "" {
+		valor, err := strconv.Atoi(limite)
+		if err != nil || valor < 1 {
+			return paginacao, domain.ErrLimitePaginaInvalido
+		}
+		paginacao.Limite = valor
+	}
+	return paginacao, nil
+}
+
+func parametroBooleano(valor string) (*bool, error) {
+	if valor == "" {
+		return nil, nil
+	}
+	b, err := strconv.ParseBool(valor)
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// responderErroFiltroConvidados traduz os valores de filtro recusados pelo domínio.
+func responderErroFiltroConvidados(w http.ResponseWriter, r *http.Request, err error) bool {
+	switch {
+	case errors.Is(err, domain.ErrStatusRSVPInvalido):
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "Status inválido. Use CONFIRMADO, RECUSADO ou PENDENTE.", http.StatusBadRequest)
+	case errors.Is(err, domain.ErrFaixaEtariaInvalida), errors.Is(err, domain.ErrLadoInvalido), errors.Is(err, domain.ErrBuscaInvalida):
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", err.Error(), http.StatusBadRequest)
+	default:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,262 @@
+// file: internal/guest/interfaces/rest/convite.go
+package rest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-pdf/fpdf"
+	"github.com/google/uuid"
+	eventDomain "github.com/luiszkm/wedding_backend/internal/event/domain"
+	"github.com/luiszkm/wedding_backend/internal/guest/application"
+	"github.com/luiszkm/wedding_backend/internal/guest/domain"
+	"github.com/luiszkm/wedding_backend/internal/platform/auth"
+	"github.com/luiszkm/wedding_backend/internal/platform/qr"
+	"github.com/luiszkm/wedding_backend/internal/platform/relatorio"
+	"github.com/luiszkm/wedding_backend/internal/platform/web"
+	"github.com/skip2/go-qrcode"
+)
+
+// tamanhoQRNoConvite é a resolução, em pixels, do QR code impresso no cartão.
+const tamanhoQRNoConvite = 512
+
+type ConviteHandler struct {
+	service *application.ConviteService
+}
+
+func NewConviteHandler(service *application.ConviteService) *ConviteHandler {
+	return &ConviteHandler{service: service}
+}
+
+func (h *ConviteHandler) HandleObterQRCodeGrupo(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	grupoID, err := uuid.Parse(chi.URLParam(r, "idGrupo"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do grupo é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	formato, tamanho, err := qr.ParametrosDaQuery(r)
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	convite, err := h.service.GerarConvite(r.Context(), userID, grupoID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrGrupoNaoEncontrado), errors.Is(err, eventDomain.ErrEventoNaoEncontrado):
+			web.RespondError(w, r, "NAO_ENCONTRADO", "Grupo não encontrado.", http.StatusNotFound)
+		default:
+			log.Printf("ERRO: %v\n", err)
+			web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	imagem, contentType, err := qr.Gerar(convite.Link, formato, tamanho)
+	if err != nil {
+		log.Printf("ERRO ao gerar QR code %s: %v\n", formato, err)
+		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao gerar o QR code.", http.StatusInternalServerError)
+		return
+	}
+
+	nomeArquivo := fmt.Sprintf("qrcode-%s.%s", convite.Grupo.ChaveDeAcesso(), formato)
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", nomeArquivo))
+	w.WriteHeader(http.StatusOK)
+	w.Write(imagem)
+}
+
+func (h *ConviteHandler) HandleGerarConvitesPDF(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	eventID, err := uuid.Parse(chi.URLParam(r, "idEvento"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do evento é inválido.", http.StatusBadRequest)
+		return
+	}
+	filtro, err := filtroConvidadosDaQuery(r)
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	convites, err := h.service.GerarConvitesEvento(r.Context(), userID, eventID, filtro)
+	if err != nil {
+		if responderErroFiltroConvidados(w, r, err) {
+			return
+		}
+		if errors.Is(err, eventDomain.ErrEventoNaoEncontrado) || errors.Is(err, domain.ErrEventoNaoEncontrado) {
+			web.RespondError(w, r, "NAO_ENCONTRADO", "Evento não encontrado.", http.StatusNotFound)
+			return
+		}
+		log.Printf("ERRO: %v\n", err)
+		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
+		return
+	}
+
+	nomeArquivo := fmt.Sprintf("convites-%s.pdf", convites.Evento.UrlSlug())
+	relatorio.EnviarArquivo(w, r, nomeArquivo, "application/pdf", func(saida io.Writer) error {
+		return escreverPDFConvites(saida, convites)
+	})
+}
+
+// Dimensões do cartão, em mm: quatro por página A4, em duas colunas.
+const (
+	larguraConvite  = 92.0
+	alturaConvite   = 135.0
+	margemConvites  = 10.0
+	espacoConvites  = 6.0
+	ladoQRNoConvite = 52.0
+)
+
+// coresConvite são as cores da paleta do evento já convertidas para o fpdf.
+type coresConvite struct {
+	fundo, primaria, texto, destaque [3]int
+}
+
+// coresDaPaleta completa a paleta do evento com a padrão; uma cor inválida gravada antes
+// da validação de paletas também cai na padrão.
+func coresDaPaleta(paleta eventDomain.PaletaCores) coresConvite {
+	padrao := eventDomain.PaletaCoresPadrao()
+	completa := paleta.ComFallback(padrao)
+	cor := func(chave string) [3]int {
+		r, g, b, err := eventDomain.ComponentesRGB(completa[chave])
+		if err != nil {
+			r, g, b, _ = eventDomain.ComponentesRGB(padrao[chave])
+		}
+		return [3]int{r, g, b}
+	}
+	return coresConvite{
+		fundo:    cor(eventDomain.CorBackground),
+		primaria: cor(eventDomain.CorPrimary),
+		texto:    cor(eventDomain.CorText),
+		destaque: cor(eventDomain.CorAccent),
+	}
+}
+
+// escreverPDFConvites gera um cartão por grupo, com o nome e a data do evento, os nomes
+// dos convidados e o QR code do link de acesso, nas cores da paleta do evento.
+func escreverPDFConvites(w io.Writer, convites *application.ConvitesEvento) error {
+	pdf, tr := relatorio.NovoPDF("P", "Convites - "+convites.Evento.Nome())
+	pdf.SetAutoPageBreak(false, 0)
+	cores := coresDaPaleta(convites.Evento.PaletaCores())
+
+	if len(convites.Convites) == 0 {
+		pdf.AddPage()
+		pdf.SetFont("Helvetica", "", 12)
+		pdf.CellFormat(0, 10, tr("Nenhum grupo de convidados para este filtro."), "", 1, "L", false, 0, "")
+	}
+	for i, convite := range convites.Convites {
+		posicao := i % 4
+		if posicao == 0 {
+			pdf.AddPage()
+		}
+		x := margemConvites + float64(posicao%2)*(larguraConvite+espacoConvites)
+		y := margemConvites + float64(posicao/2)*(alturaConvite+espacoConvites)
+		if err := desenharConvite(pdf, tr, cores, convites.Evento, convite, x, y); err != nil {
+			return err
+		}
+	}
+
+	return pdf.Output(w)
+}
+
+func desenharConvite(pdf *fpdf.Fpdf, tr func(string) string, cores coresConvite, evento *eventDomain.Evento, convite application.Convite, x, y float64) error {
+	png, err := qrcode.Encode(convite.Link, qr.NivelCorrecao, tamanhoQRNoConvite)
+	if err != nil {
+		return err
+	}
+	nomeImagem := "qr-" + convite.Grupo.ID().String()
+	pdf.RegisterImageOptionsReader(nomeImagem, fpdf.ImageOptions{ImageType: "PNG"}, bytes.NewReader(png))
+
+	pdf.SetFillColor(cores.fundo[0], cores.fundo[1], cores.fundo[2])
+	pdf.SetDrawColor(cores.primaria[0], cores.primaria[1], cores.primaria[2])
+	pdf.SetLineWidth(0.8)
+	pdf.Rect(x, y, larguraConvite, alturaConvite, "FD")
+	pdf.SetDrawColor(cores.destaque[0], cores.destaque[1], cores.destaque[2])
+	pdf.SetLineWidth(0.2)
+	pdf.Rect(x+3, y+3, larguraConvite-6, alturaConvite-6, "D")
+
+	largura := larguraConvite - 16
+	escrever := func(topo float64, estilo string, tamanho float64, cor [3]int, altura float64, texto string, maxLinhas int) {
+		pdf.SetFont("Helvetica", estilo, tamanho)
+		pdf.SetTextColor(cor[0], cor[1], cor[2])
+		for i, linha := range linhasLimitadas(pdf, tr, texto, largura, maxLinhas) {
+			pdf.SetXY(x+8, topo+float64(i)*altura)
+			pdf.CellFormat(largura, altura, linha, "", 0, "C", false, 0, "")
+		}
+	}
+
+	escrever(y+10, "B", 16, cores.primaria, 7, evento.Nome(), 2)
+	escrever(y+26, "", 11, cores.texto, 6, evento.Data().Format("02/01/2006"), 1)
+	escrever(y+36, "I", 11, cores.texto, 5, nomesDoConvite(convite.Grupo), 3)
+
+	topoQR := y + 55
+	pdf.ImageOptions(nomeImagem, x+(larguraConvite-ladoQRNoConvite)/2, topoQR, ladoQRNoConvite, ladoQRNoConvite, false, fpdf.ImageOptions{ImageType: "PNG"}, 0, "")
+
+	escrever(topoQR+ladoQRNoConvite+4, "B", 10, cores.texto, 5, "Chave de acesso: "+convite.Grupo.ChaveDeAcesso(), 1)
+	escrever(topoQR+ladoQRNoConvite+11, "", 8, cores.texto, 4, convite.Pagina, 2)
+	return pdf.Error()
+}
+
+// nomesDoConvite junta os nomes dos convidados do grupo como no convite: "Ana, Pedro e Carlos".
+func nomesDoConvite(grupo *domain.GrupoDeConvidados) string {
+	nomes := make([]string, len(grupo.Convidados()))
+	for i, c := range grupo.Convidados() {
+		nomes[i] = c.Nome()
+	}
+	if len(nomes) <= 1 {
+		return strings.Join(nomes, "")
+	}
+	return strings.Join(nomes[:len(nomes)-1], ", ") + " e " + nomes[len(nomes)-1]
+}
+
+// linhasLimitadas quebra o texto por palavras na largura do cartão e devolve as linhas já
+// traduzidas. O que passar de maxLinhas é cortado com reticências na última linha.
+func linhasLimitadas(pdf *fpdf.Fpdf, tr func(string) string, texto string, largura float64, maxLinhas int) []string {
+	var linhas []string
+	atual := ""
+	for _, palavra := range strings.Fields(texto) {
+		candidata := strings.TrimSpace(atual + " " + palavra)
+		if atual != "" && pdf.GetStringWidth(tr(candidata)) > largura {
+			linhas = append(linhas, atual)
+			candidata = palavra
+		}
+		atual = candidata
+	}
+	if atual != "" {
+		linhas = append(linhas, atual)
+	}
+
+	cortado := len(linhas) > maxLinhas
+	if cortado {
+		linhas = linhas[:maxLinhas]
+	}
+	for i, linha := range linhas {
+		if cortado && i == len(linhas)-1 {
+			runas := []rune(linha)
+			for len(runas) > 0 && pdf.GetStringWidth(tr(string(runas)+"...")) > largura {
+				runas = runas[:len(runas)-1]
+			}
+			linhas[i] = tr(string(runas) + "...")
+			continue
+		}
+		linhas[i] = relatorio.TruncarParaLargura(pdf, tr, linha, largura)
+	}
+	return linhas
+}
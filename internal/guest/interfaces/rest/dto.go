@@ -1,89 +1,453 @@
 // file: internal/guest/interfaces/rest/dto.go
 package rest
 
+import "time"
+
 // CriarGrupoRequestDTO é o contrato de entrada da API.
+// Quando GerarChave é informado, a chave é gerada pelo servidor e ChaveDeAcesso deve ficar vazia.
+// Os convidados vêm em NomesDosConvidados (só nomes) ou em Convidados (com contato), não em ambos.
 type CriarGrupoRequestDTO struct {
-	ChaveDeAcesso      string   `json:"chaveDeAcesso"`
-	NomesDosConvidados []string `json:"nomesDosConvidados"`
+	ChaveDeAcesso       string                `json:"chaveDeAcesso"`
+	GerarChave          *ConfiguracaoChaveDTO `json:"gerarChave,omitempty"`
+	NomesDosConvidados  []string              `json:"nomesDosConvidados"`
+	Convidados          []DadosConvidadoDTO   `json:"convidados"`
+	LimiteAcompanhantes int                   `json:"limiteAcompanhantes"`
+}
+
+// DadosConvidadoDTO são os dados cadastrais de um convidado novo. Só o nome é obrigatório.
+type DadosConvidadoDTO struct {
+	Nome        string `json:"nome"`
+	Telefone    string `json:"telefone"`
+	Email       string `json:"email"`
+	FaixaEtaria string `json:"faixaEtaria"`
+	Lado        string `json:"lado"`
+	Observacoes string `json:"observacoes"`
+}
+
+// ConfiguracaoChaveDTO escolhe o modo (PALAVRAS ou ALFANUMERICA) e o tamanho da chave gerada.
+// Campos omitidos usam os padrões do domínio.
+type ConfiguracaoChaveDTO struct {
+	Modo    string `json:"modo"`
+	Tamanho int    `json:"tamanho"`
 }
 
 // CriarGrupoResponseDTO é o contrato de saída da API.
 type CriarGrupoResponseDTO struct {
-	IDGrupo string `json:"idGrupo"`
+	IDGrupo       string `json:"idGrupo"`
+	ChaveDeAcesso string `json:"chaveDeAcesso"`
 }
 
 // GrupoParaConfirmacaoDTO representa os dados do grupo para o convidado confirmar presença.
 type GrupoParaConfirmacaoDTO struct {
-	IDGrupo    string         `json:"idGrupo"`
-	Convidados []ConvidadoDTO `json:"convidados"`
+	IDGrupo             string               `json:"idGrupo"`
+	Convidados          []ConvidadoAcessoDTO `json:"convidados"`
+	LimiteAcompanhantes int                  `json:"limiteAcompanhantes"`
+	Acompanhantes       []AcompanhanteDTO    `json:"acompanhantes"`
+	Perguntas           []PerguntaRSVPDTO    `json:"perguntas"`
+	PrazoRSVP           *time.Time           `json:"prazoRSVP"`
+	RSVPEncerrado       bool                 `json:"rsvpEncerrado"`
+	CamposEditaveis     []string             `json:"camposEditaveis"`
 }
 
 // ConvidadoDTO representa um único convidado dentro do grupo.
 type ConvidadoDTO struct {
-	ID         string `json:"id"`
-	Nome       string `json:"nome"`
-	StatusRSVP string `json:"statusRSVP"` // e.g., "PENDENTE"
+	ID                  string                `json:"id"`
+	Nome                string                `json:"nome"`
+	StatusRSVP          string                `json:"statusRSVP"` // e.g., "PENDENTE"
+	RespostasFormulario []RespostaPerguntaDTO `json:"respostasFormulario"`
+}
+
+// ConvidadoAcessoDTO é o convidado no link público. Telefone e e-mail só aparecem
+// quando o anfitrião libera a edição do campo.
+type ConvidadoAcessoDTO struct {
+	ConvidadoDTO
+	Telefone *string `json:"telefone,omitempty"`
+	Email    *string `json:"email,omitempty"`
+}
+
+// ConvidadoAdminDTO acrescenta os dados de contato, visíveis apenas para o anfitrião.
+type ConvidadoAdminDTO struct {
+	ConvidadoDTO
+	Telefone    string `json:"telefone"`
+	Email       string `json:"email"`
+	FaixaEtaria string `json:"faixaEtaria"`
+	Lado        string `json:"lado"`
+	Observacoes string `json:"observacoes"`
+}
+
+// RespostaPerguntaDTO é a resposta de um convidado a uma pergunta do formulário.
+type RespostaPerguntaDTO struct {
+	IDPergunta string   `json:"idPergunta"`
+	Valores    []string `json:"valores"`
+}
+
+// PerguntaRSVPDTO é uma pergunta do formulário de RSVP. Na definição do formulário,
+// uma pergunta sem id é criada e uma com id é alterada.
+type PerguntaRSVPDTO struct {
+	ID          string   `json:"id,omitempty"`
+	Texto       string   `json:"texto"`
+	Tipo        string   `json:"tipo"`
+	Opcoes      []string `json:"opcoes"`
+	Obrigatoria bool     `json:"obrigatoria"`
+	Minimo      *int     `json:"minimo,omitempty"`
+	Maximo      *int     `json:"maximo,omitempty"`
+	Finalidade  string   `json:"finalidade,omitempty"`
+}
+
+// FormularioRSVPDTO é o corpo e a resposta dos endpoints do formulário de RSVP.
+type FormularioRSVPDTO struct {
+	Perguntas []PerguntaRSVPDTO `json:"perguntas"`
+}
+
+// AcompanhanteDTO é uma pessoa extra trazida pelo grupo, sem convite nominal.
+type AcompanhanteDTO struct {
+	ID   string `json:"id"`
+	Nome string `json:"nome"`
 }
 
 // ConfirmarPresencaRequestDTO é o corpo da requisição para o novo endpoint.
+// Acompanhantes omitido mantém a lista atual; uma lista vazia remove todos.
+// Token, o do link de convite, substitui chave de acesso e evento.
 type ConfirmarPresencaRequestDTO struct {
 	IDEvento      string            `json:"idEvento"`
 	ChaveDeAcesso string            `json:"chaveDeAcesso"`
+	Token         string            `json:"token"`
 	Respostas     []RespostaRSVPDTO `json:"respostas"`
+	Acompanhantes []string          `json:"acompanhantes"`
 }
 
 // RespostaRSVPDTO representa a resposta de um único convidado.
 type RespostaRSVPDTO struct {
 	IDConvidado string `json:"idConvidado"`
 	Status      string `json:"status"`
+	// RespostasFormulario omitido mantém as respostas atuais do convidado.
+	RespostasFormulario []RespostaPerguntaDTO `json:"respostasFormulario"`
 }
 
 // RevisarGrupoRequestDTO é o corpo da requisição para editar um grupo.
 type RevisarGrupoRequestDTO struct {
-	ChaveDeAcesso string                `json:"chaveDeAcesso"`
-	Convidados    []ConvidadoRevisaoDTO `json:"convidados"`
+	ChaveDeAcesso       string                `json:"chaveDeAcesso"`
+	Convidados          []ConvidadoRevisaoDTO `json:"convidados"`
+	LimiteAcompanhantes *int                  `json:"limiteAcompanhantes"` // Omitido mantém o limite atual
 }
 
+// ConvidadoRevisaoDTO edita ou inclui um convidado. Campos de contato omitidos mantêm o valor atual;
+// uma string vazia apaga o valor.
 type ConvidadoRevisaoDTO struct {
-	ID   *string `json:"id"` // Ponteiro para string para poder ser nulo
-	Nome string  `json:"nome"`
+	ID          *string `json:"id"` // Ponteiro para string para poder ser nulo
+	Nome        string  `json:"nome"`
+	Telefone    *string `json:"telefone"`
+	Email       *string `json:"email"`
+	FaixaEtaria *string `json:"faixaEtaria"`
+	Lado        *string `json:"lado"`
+	Observacoes *string `json:"observacoes"`
 }
 
 // ListarGruposResponseDTO é o contrato de saída para listar grupos
+// ListarGruposResponseDTO traz uma página; Total conta todos os grupos do filtro e
+// ProximoCursor é null na última página.
 type ListarGruposResponseDTO struct {
-	Grupos []GrupoResumoDTO `json:"grupos"`
-	Total  int              `json:"total"`
+	Grupos        []GrupoResumoDTO `json:"grupos"`
+	Total         int              `json:"total"`
+	ProximoCursor *string          `json:"proximoCursor"`
 }
 
 // GrupoResumoDTO representa um grupo resumido na listagem
 type GrupoResumoDTO struct {
-	ID                    string         `json:"id"`
-	ChaveDeAcesso         string         `json:"chaveDeAcesso"`
-	TotalConvidados       int            `json:"totalConvidados"`
-	ConvidadosConfirmados int            `json:"convidadosConfirmados"`
-	ConvidadosRecusados   int            `json:"convidadosRecusados"`
-	ConvidadosPendentes   int            `json:"convidadosPendentes"`
-	Convidados            []ConvidadoDTO `json:"convidados"`
-	DataConfirmacao       *string        `json:"dataConfirmacao,omitempty"`
+	ID                    string              `json:"id"`
+	ChaveDeAcesso         string              `json:"chaveDeAcesso"`
+	TotalConvidados       int                 `json:"totalConvidados"`
+	ConvidadosConfirmados int                 `json:"convidadosConfirmados"`
+	ConvidadosRecusados   int                 `json:"convidadosRecusados"`
+	ConvidadosPendentes   int                 `json:"convidadosPendentes"`
+	Convidados            []ConvidadoAdminDTO `json:"convidados"`
+	LimiteAcompanhantes   int                 `json:"limiteAcompanhantes"`
+	Acompanhantes         []AcompanhanteDTO   `json:"acompanhantes"`
+	Etiquetas             []EtiquetaDTO       `json:"etiquetas"`
+	DataConfirmacao       *string             `json:"dataConfirmacao,omitempty"`
+	PrazoRSVPEstendido    *time.Time          `json:"prazoRSVPEstendido"`
+	UltimaRespostaEm      *time.Time          `json:"ultimaRespostaEm"`
 }
 
 // GrupoDetalhadoDTO representa um grupo com todos os detalhes
 type GrupoDetalhadoDTO struct {
-	ID              string         `json:"id"`
-	IDEvento        string         `json:"idEvento"`
-	ChaveDeAcesso   string         `json:"chaveDeAcesso"`
-	Convidados      []ConvidadoDTO `json:"convidados"`
-	DataConfirmacao *string        `json:"dataConfirmacao,omitempty"`
+	ID                  string                 `json:"id"`
+	IDEvento            string                 `json:"idEvento"`
+	ChaveDeAcesso       string                 `json:"chaveDeAcesso"`
+	Convidados          []ConvidadoAdminDTO    `json:"convidados"`
+	ConvidadosRemovidos []ConvidadoRemovidoDTO `json:"convidadosRemovidos"`
+	LimiteAcompanhantes int                    `json:"limiteAcompanhantes"`
+	Acompanhantes       []AcompanhanteDTO      `json:"acompanhantes"`
+	Etiquetas           []EtiquetaDTO          `json:"etiquetas"`
+	DataConfirmacao     *string                `json:"dataConfirmacao,omitempty"`
+	PrazoRSVPEstendido  *time.Time             `json:"prazoRSVPEstendido"`
+	UltimaRespostaEm    *time.Time             `json:"ultimaRespostaEm"`
+}
+
+// ConvidadoRemovidoDTO é um convidado retirado do grupo, com o RSVP que terá ao ser restaurado.
+type ConvidadoRemovidoDTO struct {
+	ConvidadoAdminDTO
+	Motivo      string    `json:"motivo"`
+	RemovidoEm  time.Time `json:"removidoEm"`
+	RemovidoPor *string   `json:"removidoPor"`
+}
+
+// RemoverConvidadoRequestDTO traz o motivo da remoção, obrigatório.
+type RemoverConvidadoRequestDTO struct {
+	Motivo string `json:"motivo"`
+}
+
+// JuntarGruposRequestDTO indica o grupo que será absorvido pelo grupo da URL.
+type JuntarGruposRequestDTO struct {
+	IDGrupoAbsorvido string `json:"idGrupoAbsorvido"`
+}
+
+// DividirGrupoRequestDTO indica quem sai do grupo da URL para formar um grupo novo.
+// Sem chaveDeAcesso, o grupo novo recebe uma chave gerada.
+type DividirGrupoRequestDTO struct {
+	Convidados          []string `json:"convidados"`
+	Acompanhantes       []string `json:"acompanhantes"`
+	LimiteAcompanhantes int      `json:"limiteAcompanhantes"`
+	ChaveDeAcesso       string   `json:"chaveDeAcesso"`
+}
+
+type DividirGrupoResponseDTO struct {
+	GrupoOriginal GrupoDetalhadoDTO `json:"grupoOriginal"`
+	NovoGrupo     GrupoDetalhadoDTO `json:"novoGrupo"`
 }
 
 // EstatisticasRSVPDTO representa as estatísticas de RSVP
 type EstatisticasRSVPDTO struct {
-	TotalGrupos           int     `json:"totalGrupos"`
-	TotalConvidados       int     `json:"totalConvidados"`
-	ConvidadosConfirmados int     `json:"convidadosConfirmados"`
-	ConvidadosRecusados   int     `json:"convidadosRecusados"`
-	ConvidadosPendentes   int     `json:"convidadosPendentes"`
-	PercentualConfirmado  float64 `json:"percentualConfirmado"`
-	PercentualRecusado    float64 `json:"percentualRecusado"`
-	PercentualPendente    float64 `json:"percentualPendente"`
+	TotalGrupos               int                      `json:"totalGrupos"`
+	TotalConvidados           int                      `json:"totalConvidados"`
+	ConvidadosConfirmados     int                      `json:"convidadosConfirmados"`
+	ConvidadosRecusados       int                      `json:"convidadosRecusados"`
+	ConvidadosPendentes       int                      `json:"convidadosPendentes"`
+	PercentualConfirmado      float64                  `json:"percentualConfirmado"`
+	PercentualRecusado        float64                  `json:"percentualRecusado"`
+	PercentualPendente        float64                  `json:"percentualPendente"`
+	LimiteAcompanhantes       int                      `json:"limiteAcompanhantes"`
+	AcompanhantesConfirmados  int                      `json:"acompanhantesConfirmados"`
+	TotalPresencasConfirmadas int                      `json:"totalPresencasConfirmadas"`
+	Perguntas                 []EstatisticaPerguntaDTO `json:"perguntas"`
+	Etiquetas                 []EstatisticaEtiquetaDTO `json:"etiquetas"`
+}
+
+// EstatisticaEtiquetaDTO resume o RSVP dos grupos com a etiqueta.
+type EstatisticaEtiquetaDTO struct {
+	IDEtiqueta               string `json:"idEtiqueta"`
+	Nome                     string `json:"nome"`
+	TotalGrupos              int    `json:"totalGrupos"`
+	TotalConvidados          int    `json:"totalConvidados"`
+	ConvidadosConfirmados    int    `json:"convidadosConfirmados"`
+	ConvidadosRecusados      int    `json:"convidadosRecusados"`
+	ConvidadosPendentes      int    `json:"convidadosPendentes"`
+	AcompanhantesConfirmados int    `json:"acompanhantesConfirmados"`
+}
+
+// EstatisticaPerguntaDTO resume as respostas a uma pergunta do formulário.
+type EstatisticaPerguntaDTO struct {
+	IDPergunta     string             `json:"idPergunta"`
+	Texto          string             `json:"texto"`
+	Tipo           string             `json:"tipo"`
+	TotalRespostas int                `json:"totalRespostas"`
+	Opcoes         []ContagemOpcaoDTO `json:"opcoes,omitempty"`
+	Soma           *int               `json:"soma,omitempty"` // Apenas perguntas NUMERO
+}
+
+type ContagemOpcaoDTO struct {
+	Opcao string `json:"opcao"`
+	Total int    `json:"total"`
+}
+
+// RelatorioImportacaoDTO é o relatório devolvido pela importação de planilha (dry-run ou efetiva).
+type RelatorioImportacaoDTO struct {
+	DryRun          bool                    `json:"dryRun"`
+	Importado       bool                    `json:"importado"`
+	TotalLinhas     int                     `json:"totalLinhas"`
+	TotalGrupos     int                     `json:"totalGrupos"`
+	TotalConvidados int                     `json:"totalConvidados"`
+	Grupos          []GrupoImportadoDTO     `json:"grupos"`
+	Problemas       []ProblemaImportacaoDTO `json:"problemas"`
+}
+
+type GrupoImportadoDTO struct {
+	ID            string   `json:"id"`
+	ChaveDeAcesso string   `json:"chaveDeAcesso"`
+	Convidados    []string `json:"convidados"`
+}
+
+type ProblemaImportacaoDTO struct {
+	Linha         int    `json:"linha"`
+	Codigo        string `json:"codigo"`
+	ChaveDeAcesso string `json:"chaveDeAcesso,omitempty"`
+	Mensagem      string `json:"mensagem"`
+}
+
+// RegenerarChavesResponseDTO lista as novas chaves para que o anfitrião possa redistribuí-las.
+type RegenerarChavesResponseDTO struct {
+	Grupos []ChaveDeAcessoGrupoDTO `json:"grupos"`
+	Total  int                     `json:"total"`
+}
+
+type ChaveDeAcessoGrupoDTO struct {
+	IDGrupo       string `json:"idGrupo"`
+	ChaveDeAcesso string `json:"chaveDeAcesso"`
+}
+
+// RegistrarRSVPAnfitriaoRequestDTO é o corpo do RSVP registrado pelo anfitrião em nome do grupo.
+type RegistrarRSVPAnfitriaoRequestDTO struct {
+	Respostas     []RespostaRSVPDTO `json:"respostas"`
+	Acompanhantes []string          `json:"acompanhantes"`
+}
+
+// EstenderPrazoRSVPRequestDTO define o prazo próprio do grupo; null remove a prorrogação.
+type EstenderPrazoRSVPRequestDTO struct {
+	PrazoRSVP *time.Time `json:"prazoRSVP"`
+}
+
+type RSVPsAtrasadosResponseDTO struct {
+	PrazoRSVP *time.Time         `json:"prazoRSVP"`
+	GeradoEm  time.Time          `json:"geradoEm"`
+	Grupos    []GrupoAtrasadoDTO `json:"grupos"`
+	Total     int                `json:"total"`
+}
+
+type GrupoAtrasadoDTO struct {
+	ID                  string              `json:"id"`
+	ChaveDeAcesso       string              `json:"chaveDeAcesso"`
+	PrazoEfetivo        *time.Time          `json:"prazoEfetivo"`
+	PrazoRSVPEstendido  *time.Time          `json:"prazoRSVPEstendido"`
+	ConvidadosPendentes []ConvidadoAdminDTO `json:"convidadosPendentes"`
+	UltimaRespostaEm    *time.Time          `json:"ultimaRespostaEm"`
+	RespondeuAposPrazo  bool                `json:"respondeuAposPrazo"`
+}
+
+// RegistroRSVPDTO é uma entrada da linha do tempo de RSVP.
+type RegistroRSVPDTO struct {
+	ID             string    `json:"id"`
+	IDGrupo        string    `json:"idGrupo"`
+	IDConvidado    string    `json:"idConvidado"`
+	NomeConvidado  string    `json:"nomeConvidado"`
+	StatusAnterior string    `json:"statusAnterior"`
+	StatusNovo     string    `json:"statusNovo"`
+	Canal          string    `json:"canal"`
+	IDUsuario      *string   `json:"idUsuario,omitempty"`
+	IP             string    `json:"ip,omitempty"`
+	UserAgent      string    `json:"userAgent,omitempty"`
+	RegistradoEm   time.Time `json:"registradoEm"`
+}
+
+type HistoricoRSVPResponseDTO struct {
+	Registros []RegistroRSVPDTO `json:"registros"`
+	Total     int               `json:"total"`
+}
+
+// EtiquetaDTO é uma etiqueta do catálogo do evento.
+type EtiquetaDTO struct {
+	ID   string `json:"id"`
+	Nome string `json:"nome"`
+}
+
+// EtiquetaRequestDTO é o corpo da criação e da renomeação de etiquetas.
+type EtiquetaRequestDTO struct {
+	Nome string `json:"nome"`
+}
+
+type ListarEtiquetasResponseDTO struct {
+	Etiquetas []EtiquetaDTO `json:"etiquetas"`
+	Total     int           `json:"total"`
+}
+
+// DefinirEtiquetasGrupoRequestDTO traz os IDs das etiquetas do grupo; a lista substitui a atual.
+type DefinirEtiquetasGrupoRequestDTO struct {
+	Etiquetas []string `json:"etiquetas"`
+}
+
+type DefinirEtiquetasGrupoResponseDTO struct {
+	Etiquetas []EtiquetaDTO `json:"etiquetas"`
+}
+
+type ContagemFaixaEtariaDTO struct {
+	Adultos      int `json:"adultos"`
+	Criancas     int `json:"criancas"`
+	NaoInformada int `json:"naoInformada"`
+	Total        int `json:"total"`
+}
+
+type ItemBuffetDTO struct {
+	Opcao string `json:"opcao"`
+	ContagemFaixaEtariaDTO
+}
+
+type ResumoPerguntaBuffetDTO struct {
+	IDPergunta  string                 `json:"idPergunta"`
+	Texto       string                 `json:"texto"`
+	Itens       []ItemBuffetDTO        `json:"itens"`
+	SemResposta ContagemFaixaEtariaDTO `json:"semResposta"`
+}
+
+// RelatorioBuffetDTO traz prato e restricoesAlimentares como null quando o formulário não
+// tem pergunta com a finalidade correspondente.
+type RelatorioBuffetDTO struct {
+	Convidados            ContagemFaixaEtariaDTO   `json:"convidados"`
+	Acompanhantes         int                      `json:"acompanhantes"`
+	TotalPessoas          int                      `json:"totalPessoas"`
+	Prato                 *ResumoPerguntaBuffetDTO `json:"prato"`
+	RestricoesAlimentares *ResumoPerguntaBuffetDTO `json:"restricoesAlimentares"`
+}
+
+// AtualizarPerfilRequestDTO é o corpo da edição do cadastro pelo link. Campos omitidos
+// ficam como estão; acompanhantes omitido mantém a lista atual. Token, o do link de
+// convite, substitui chave de acesso e evento.
+type AtualizarPerfilRequestDTO struct {
+	IDEvento      string                  `json:"idEvento"`
+	ChaveDeAcesso string                  `json:"chaveDeAcesso"`
+	Token         string                  `json:"token"`
+	Convidados    []AlteracaoConvidadoDTO `json:"convidados"`
+	Acompanhantes []AcompanhantePerfilDTO `json:"acompanhantes"`
+}
+
+type AlteracaoConvidadoDTO struct {
+	ID       string  `json:"id"`
+	Nome     *string `json:"nome"`
+	Telefone *string `json:"telefone"`
+	Email    *string `json:"email"`
+}
+
+// AcompanhantePerfilDTO sem id inclui um acompanhante; com id, mantém o existente com o novo nome.
+type AcompanhantePerfilDTO struct {
+	ID   string `json:"id"`
+	Nome string `json:"nome"`
+}
+
+type ConfiguracaoPerfilDTO struct {
+	CamposEditaveis []string `json:"camposEditaveis"`
+}
+
+type AlteracaoPerfilDTO struct {
+	ID            string     `json:"id"`
+	IDGrupo       string     `json:"idGrupo"`
+	IDConvidado   *string    `json:"idConvidado"`
+	NomeConvidado string     `json:"nomeConvidado"`
+	Campo         string     `json:"campo"`
+	ValorAnterior string     `json:"valorAnterior"`
+	ValorNovo     string     `json:"valorNovo"`
+	IP            string     `json:"ip"`
+	UserAgent     string     `json:"userAgent"`
+	RegistradoEm  time.Time  `json:"registradoEm"`
+	RevisadaEm    *time.Time `json:"revisadaEm"`
+}
+
+type ListarAlteracoesPerfilResponseDTO struct {
+	Alteracoes []AlteracaoPerfilDTO `json:"alteracoes"`
+	Total      int                  `json:"total"`
+}
+
+// LinkDeAcessoDTO é o link de convite do grupo, com o token que ele leva.
+type LinkDeAcessoDTO struct {
+	IDGrupo  string    `json:"idGrupo"`
+	Link     string    `json:"link"`
+	Token    string    `json:"token"`
+	ExpiraEm time.Time `json:"expiraEm"`
 }
@@ -0,0 +1,220 @@
+// file: internal/guest/interfaces/rest/etiqueta.go
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/luiszkm/wedding_backend/internal/guest/domain"
+	"github.com/luiszkm/wedding_backend/internal/platform/auth"
+	"github.com/luiszkm/wedding_backend/internal/platform/web"
+)
+
+func (h *GuestHandler) HandleListarEtiquetas(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	eventID, err := uuid.Parse(chi.URLParam(r, "idEvento"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do evento é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	etiquetas, err := h.service.ListarEtiquetas(r.Context(), userID, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrEventoNaoEncontrado) {
+			web.RespondError(w, r, "EVENTO_NAO_ENCONTRADO", "Evento não encontrado.", http.StatusNotFound)
+			return
+		}
+		log.Printf("ERRO: %v\n", err)
+		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
+		return
+	}
+
+	etiquetasDTO := toEtiquetasDTO(etiquetas)
+	web.Respond(w, r, ListarEtiquetasResponseDTO{Etiquetas: etiquetasDTO, Total: len(etiquetasDTO)}, http.StatusOK)
+}
+
+func (h *GuestHandler) HandleCriarEtiqueta(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	eventID, err := uuid.Parse(chi.URLParam(r, "idEvento"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do evento é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	var reqDTO EtiquetaRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&reqDTO); err != nil {
+		web.RespondError(w, r, "CORPO_INVALIDO", "O corpo da requisição está malformado.", http.StatusBadRequest)
+		return
+	}
+
+	etiqueta, err := h.service.CriarEtiqueta(r.Context(), userID, eventID, reqDTO.Nome)
+	if err != nil {
+		if errors.Is(err, domain.ErrEventoNaoEncontrado) {
+			web.RespondError(w, r, "EVENTO_NAO_ENCONTRADO", "Evento não encontrado.", http.StatusNotFound)
+			return
+		}
+		responderErroEtiqueta(w, r, err)
+		return
+	}
+
+	web.Respond(w, r, EtiquetaDTO{ID: etiqueta.ID().String(), Nome: etiqueta.Nome()}, http.StatusCreated)
+}
+
+func (h *GuestHandler) HandleRenomearEtiqueta(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	etiquetaID, err := uuid.Parse(chi.URLParam(r, "idEtiqueta"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID da etiqueta é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	var reqDTO EtiquetaRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&reqDTO); err != nil {
+		web.RespondError(w, r, "CORPO_INVALIDO", "O corpo da requisição está malformado.", http.StatusBadRequest)
+		return
+	}
+
+	etiqueta, err := h.service.RenomearEtiqueta(r.Context(), userID, etiquetaID, reqDTO.Nome)
+	if err != nil {
+		responderErroEtiqueta(w, r, err)
+		return
+	}
+
+	web.Respond(w, r, EtiquetaDTO{ID: etiqueta.ID().String(), Nome: etiqueta.Nome()}, http.StatusOK)
+}
+
+func (h *GuestHandler) HandleRemoverEtiqueta(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	etiquetaID, err := uuid.Parse(chi.URLParam(r, "idEtiqueta"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID da etiqueta é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.RemoverEtiqueta(r.Context(), userID, etiquetaID); err != nil {
+		responderErroEtiqueta(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *GuestHandler) HandleDefinirEtiquetasGrupo(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	grupoID, err := uuid.Parse(chi.URLParam(r, "idGrupo"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do grupo é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	var reqDTO DefinirEtiquetasGrupoRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&reqDTO); err != nil {
+		web.RespondError(w, r, "CORPO_INVALIDO", "O corpo da requisição está malformado.", http.StatusBadRequest)
+		return
+	}
+	ids := make([]uuid.UUID, len(reqDTO.Etiquetas))
+	for i, idStr := range reqDTO.Etiquetas {
+		if ids[i], err = uuid.Parse(idStr); err != nil {
+			web.RespondError(w, r, "DADOS_INVALIDOS", "ID de etiqueta inválido: "+idStr, http.StatusBadRequest)
+			return
+		}
+	}
+
+	grupo, err := h.service.DefinirEtiquetasGrupo(r.Context(), userID, grupoID, ids)
+	if err != nil {
+		if errors.Is(err, domain.ErrGrupoNaoEncontrado) {
+			web.RespondError(w, r, "NAO_ENCONTRADO", "Grupo não encontrado.", http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, domain.ErrEtiquetaNaoEncontrada) {
+			web.RespondError(w, r, "DADOS_INVALIDOS", "Uma ou mais etiquetas não existem no evento do grupo.", http.StatusBadRequest)
+			return
+		}
+		log.Printf("ERRO: %v\n", err)
+		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
+		return
+	}
+
+	web.Respond(w, r, DefinirEtiquetasGrupoResponseDTO{Etiquetas: toEtiquetasDTO(grupo.Etiquetas())}, http.StatusOK)
+}
+
+func responderErroEtiqueta(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, domain.ErrNomeEtiquetaInvalido):
+		web.RespondError(w, r, "DADOS_INVALIDOS", err.Error(), http.StatusBadRequest)
+	case errors.Is(err, domain.ErrEtiquetaNaoEncontrada):
+		web.RespondError(w, r, "NAO_ENCONTRADO", "Etiqueta não encontrada.", http.StatusNotFound)
+	case errors.Is(err, domain.ErrEtiquetaJaExiste):
+		web.RespondError(w, r, "ETIQUETA_EXISTENTE", err.Error(), http.StatusConflict)
+	default:
+		log.Printf("ERRO: %v\n", err)
+		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
+	}
+}
+
+func toEtiquetasDTO(etiquetas []*domain.Etiqueta) []EtiquetaDTO {
+	etiquetasDTO := make([]EtiquetaDTO, len(etiquetas))
+	for i, e := range etiquetas {
+		etiquetasDTO[i] = EtiquetaDTO{ID: e.ID().String(), Nome: e.Nome()}
+	}
+	return etiquetasDTO
+}
+
+func toEstatisticasEtiquetasDTO(estatisticas []domain.EstatisticaEtiqueta) []EstatisticaEtiquetaDTO {
+	estatisticasDTO := make([]EstatisticaEtiquetaDTO, len(estatisticas))
+	for i, e := range estatisticas {
+		estatisticasDTO[i] = EstatisticaEtiquetaDTO{
+			IDEtiqueta:               e.IDEtiqueta.String(),
+			Nome:                     e.Nome,
+			TotalGrupos:              e.TotalGrupos,
+			TotalConvidados:          e.TotalConvidados,
+			ConvidadosConfirmados:    e.ConvidadosConfirmados,
+			ConvidadosRecusados:      e.ConvidadosRecusados,
+			ConvidadosPendentes:      e.ConvidadosPendentes,
+			AcompanhantesConfirmados: e.AcompanhantesConfirmados,
+		}
+	}
+	return estatisticasDTO
+}
+
+// etiquetasDaQuery lê o parâmetro opcional etiquetas, com IDs separados por vírgula.
+func etiquetasDaQuery(r *http.Request) ([]uuid.UUID, error) {
+	valor := r.URL.Query().Get("etiquetas")
+	if valor == "" {
+		return nil, nil
+	}
+	var ids []uuid.UUID
+	for _, idStr := range strings.Split(valor, ",") {
+		id, err := uuid.Parse(strings.TrimSpace(idStr))
+		if err != nil {
+			return nil, errors.New("o parâmetro 'etiquetas' deve ser uma lista de IDs separados por vírgula")
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
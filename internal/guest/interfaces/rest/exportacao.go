@@ -0,0 +1,217 @@
+// file: internal/guest/interfaces/rest/exportacao.go
+package rest
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/luiszkm/wedding_backend/internal/guest/application"
+	"github.com/luiszkm/wedding_backend/internal/guest/domain"
+	"github.com/luiszkm/wedding_backend/internal/platform/relatorio"
+	"github.com/xuri/excelize/v2"
+)
+
+// Formatos aceitos pelo endpoint de exportação.
+const (
+	formatoCSV  = "csv"
+	formatoXLSX = "xlsx"
+	formatoPDF  = "pdf"
+)
+
+var contentTypesExportacao = map[string]string{
+	formatoCSV:  "text/csv; charset=utf-8",
+	formatoXLSX: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	formatoPDF:  "application/pdf",
+}
+
+var cabecalhoExportacao = []string{"Chave de Acesso", "Convidado", "Status RSVP", "Telefone", "E-mail", "Faixa Etária", "Lado", "Observações", "Etiquetas"}
+
+// linhasExportacao achata os grupos em uma linha por convidado, na ordem da listagem.
+func linhasExportacao(exportacao *application.ExportacaoConvidados) [][]string {
+	var linhas [][]string
+	for _, grupo := range exportacao.Grupos {
+		nomesEtiquetas := make([]string, len(grupo.Etiquetas()))
+		for i, e := range grupo.Etiquetas() {
+			nomesEtiquetas[i] = e.Nome()
+		}
+		etiquetas := strings.Join(nomesEtiquetas, ", ")
+		for _, c := range grupo.Convidados() {
+			linhas = append(linhas, []string{
+				grupo.ChaveDeAcesso(), c.Nome(), c.StatusRSVP(), c.Telefone(), c.Email(), c.FaixaEtaria(), c.Lado(), c.Observacoes(), etiquetas,
+			})
+		}
+	}
+	return linhas
+}
+
+// resumoExportacao são os pares rótulo/valor da página de resumo, vindos de GetRSVPStats.
+func resumoExportacao(exportacao *application.ExportacaoConvidados) [][2]string {
+	stats := exportacao.Estatisticas
+	percentual := func(v float64) string { return strconv.FormatFloat(v, 'f', 1, 64) + "%" }
+	return [][2]string{
+		{"Gerado em", exportacao.GeradoEm.Format("02/01/2006 15:04")},
+		{"Filtros", descricaoFiltro(exportacao.Filtro)},
+		{"Total de grupos", strconv.Itoa(stats.TotalGrupos)},
+		{"Total de convidados", strconv.Itoa(stats.TotalConvidados)},
+		{"Confirmados", fmt.Sprintf("%d (%s)", stats.ConvidadosConfirmados, percentual(stats.PercentualConfirmado))},
+		{"Recusados", fmt.Sprintf("%d (%s)", stats.ConvidadosRecusados, percentual(stats.PercentualRecusado))},
+		{"Pendentes", fmt.Sprintf("%d (%s)", stats.ConvidadosPendentes, percentual(stats.PercentualPendente))},
+	}
+}
+
+// descricaoFiltro resume os filtros aplicados, ou "Todos" quando não há nenhum.
+func descricaoFiltro(filtro domain.FiltroConvidados) string {
+	simNao := func(b bool) string {
+		if b {
+			return "sim"
+		}
+		return "não"
+	}
+	var partes []string
+	if filtro.Status != "" {
+		partes = append(partes, "status "+filtro.Status)
+	}
+	if filtro.FaixaEtaria != "" {
+		partes = append(partes, "faixa etária "+filtro.FaixaEtaria)
+	}
+	if filtro.Lado != "" {
+		partes = append(partes, "lado "+filtro.Lado)
+	}
+	if filtro.ComTelefone != nil {
+		partes = append(partes, "com telefone: "+simNao(*filtro.ComTelefone))
+	}
+	if filtro.ComEmail != nil {
+		partes = append(partes, "com e-mail: "+simNao(*filtro.ComEmail))
+	}
+	if len(filtro.Etiquetas) > 0 {
+		partes = append(partes, fmt.Sprintf("%d etiqueta(s)", len(filtro.Etiquetas)))
+	}
+	if len(partes) == 0 {
+		return "Todos"
+	}
+	return strings.Join(partes, ", ")
+}
+
+// escreverCSVConvidados usa ponto e vírgula e BOM UTF-8 para abrir direto no Excel em português,
+// no mesmo formato aceito pela importação. Valores que a planilha leria como fórmula saem
+// neutralizados.
+func escreverCSVConvidados(w io.Writer, exportacao *application.ExportacaoConvidados) error {
+	if _, err := io.WriteString(w, "\xef\xbb\xbf"); err != nil {
+		return err
+	}
+	escritor := csv.NewWriter(w)
+	escritor.Comma = ';'
+	if err := escritor.Write(cabecalhoExportacao); err != nil {
+		return err
+	}
+	for _, linha := range linhasExportacao(exportacao) {
+		for i, valor := range linha {
+			linha[i] = relatorio.NeutralizarFormula(valor)
+		}
+		if err := escritor.Write(linha); err != nil {
+			return err
+		}
+	}
+	escritor.Flush()
+	return escritor.Error()
+}
+
+func escreverXLSXConvidados(w io.Writer, exportacao *application.ExportacaoConvidados) error {
+	planilha := excelize.NewFile()
+	defer planilha.Close()
+
+	const abaConvidados, abaResumo = "Convidados", "Resumo"
+	if err := planilha.SetSheetName("Sheet1", abaConvidados); err != nil {
+		return err
+	}
+	if _, err := planilha.NewSheet(abaResumo); err != nil {
+		return err
+	}
+
+	if err := escreverLinhaXLSX(planilha, abaConvidados, 1, cabecalhoExportacao); err != nil {
+		return err
+	}
+	for i, linha := range linhasExportacao(exportacao) {
+		if err := escreverLinhaXLSX(planilha, abaConvidados, i+2, linha); err != nil {
+			return err
+		}
+	}
+	if err := planilha.SetColWidth(abaConvidados, "A", "I", 25); err != nil {
+		return err
+	}
+
+	for i, par := range resumoExportacao(exportacao) {
+		if err := escreverLinhaXLSX(planilha, abaResumo, i+1, par[:]); err != nil {
+			return err
+		}
+	}
+	if err := planilha.SetColWidth(abaResumo, "A", "B", 25); err != nil {
+		return err
+	}
+
+	return planilha.Write(w)
+}
+
+// escreverLinhaXLSX grava cada valor como texto, para que nomes como =HYPERLINK(...)
+// nunca virem fórmula.
+func escreverLinhaXLSX(planilha *excelize.File, aba string, linha int, valores []string) error {
+	for i, v := range valores {
+		celula, err := excelize.CoordinatesToCellName(i+1, linha)
+		if err != nil {
+			return err
+		}
+		if err := planilha.SetCellStr(aba, celula, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// escreverPDFConvidados gera uma página de resumo seguida da lista para impressão.
+func escreverPDFConvidados(w io.Writer, exportacao *application.ExportacaoConvidados) error {
+	// Paisagem para caber as colunas de contato e observações.
+	pdf, tr := relatorio.NovoPDF("L", "Lista de Convidados")
+	pdf.SetAutoPageBreak(true, 15)
+
+	pdf.AddPage()
+	pdf.SetFont("Helvetica", "B", 18)
+	pdf.CellFormat(0, 12, tr("Lista de Convidados - Resumo"), "", 1, "L", false, 0, "")
+	pdf.Ln(4)
+	for _, par := range resumoExportacao(exportacao) {
+		pdf.SetFont("Helvetica", "B", 12)
+		pdf.CellFormat(60, 9, tr(par[0]), "B", 0, "L", false, 0, "")
+		pdf.SetFont("Helvetica", "", 12)
+		pdf.CellFormat(0, 9, tr(par[1]), "B", 1, "L", false, 0, "")
+	}
+
+	larguras := []float64{28, 42, 22, 28, 40, 20, 15, 40, 42}
+	cabecalho := func() {
+		pdf.SetFont("Helvetica", "B", 9)
+		pdf.SetFillColor(230, 230, 230)
+		for i, titulo := range cabecalhoExportacao {
+			pdf.CellFormat(larguras[i], 7, tr(titulo), "1", 0, "L", true, 0, "")
+		}
+		pdf.Ln(-1)
+		pdf.SetFont("Helvetica", "", 9)
+	}
+
+	pdf.AddPage()
+	cabecalho()
+	_, alturaPagina := pdf.GetPageSize()
+	_, _, _, margemInferior := pdf.GetMargins()
+	for _, linha := range linhasExportacao(exportacao) {
+		if pdf.GetY()+7 > alturaPagina-margemInferior {
+			pdf.AddPage()
+			cabecalho()
+		}
+		for i, valor := range linha {
+			pdf.CellFormat(larguras[i], 7, relatorio.TruncarParaLargura(pdf, tr, valor, larguras[i]-2), "1", 0, "L", false, 0, "")
+		}
+		pdf.Ln(-1)
+	}
+
+	return pdf.Output(w)
+}
@@ -0,0 +1,75 @@
+// file: internal/guest/interfaces/rest/exportacao_test.go
+package rest
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/luiszkm/wedding_backend/internal/guest/application"
+	"github.com/luiszkm/wedding_backend/internal/guest/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+// exportacaoComFormulas tem um convidado que tentou pôr fórmulas no próprio cadastro.
+func exportacaoComFormulas() *application.ExportacaoConvidados {
+	idEvento, agora := uuid.New(), time.Now()
+	convidado := domain.HydrateConvidado(uuid.New(), "PENDENTE", domain.DadosConvidado{
+		Nome:        `=HYPERLINK("http://exemplo.com","clique")`,
+		Telefone:    "+55 11 99999-0000",
+		Observacoes: "@SUM(1+1)",
+	}, nil)
+	etiqueta := domain.HydrateEtiqueta(uuid.New(), idEvento, "-Padrinhos")
+	grupo := domain.HydrateGroup(uuid.New(), idEvento, "FAMILIA-SILVA", []*domain.Convidado{convidado}, 0, nil, []*domain.Etiqueta{etiqueta}, nil, nil, agora, agora)
+	return &application.ExportacaoConvidados{
+		Grupos:       []*domain.GrupoDeConvidados{grupo},
+		Estatisticas: &domain.RSVPStats{},
+		GeradoEm:     agora,
+	}
+}
+
+func TestEscreverCSVConvidados(t *testing.T) {
+	t.Run("deve neutralizar valores que a planilha leria como fórmula", func(t *testing.T) {
+		var saida bytes.Buffer
+		require.NoError(t, escreverCSVConvidados(&saida, exportacaoComFormulas()))
+
+		leitor := csv.NewReader(strings.NewReader(strings.TrimPrefix(saida.String(), "\xef\xbb\xbf")))
+		leitor.Comma = ';'
+		registros, err := leitor.ReadAll()
+		require.NoError(t, err)
+		require.Len(t, registros, 2)
+
+		linha := registros[1]
+		assert.Equal(t, "FAMILIA-SILVA", linha[0])
+		assert.Equal(t, `'=HYPERLINK("http://exemplo.com","clique")`, linha[1])
+		assert.Equal(t, "'+55 11 99999-0000", linha[3])
+		assert.Equal(t, "'@SUM(1+1)", linha[7])
+		assert.Equal(t, "'-Padrinhos", linha[8])
+	})
+}
+
+func TestEscreverXLSXConvidados(t *testing.T) {
+	t.Run("deve gravar os valores como texto, sem fórmulas", func(t *testing.T) {
+		var saida bytes.Buffer
+		require.NoError(t, escreverXLSXConvidados(&saida, exportacaoComFormulas()))
+
+		planilha, err := excelize.OpenReader(&saida)
+		require.NoError(t, err)
+		defer planilha.Close()
+
+		formula, err := planilha.GetCellFormula("Convidados", "B2")
+		require.NoError(t, err)
+		assert.Empty(t, formula)
+		tipo, err := planilha.GetCellType("Convidados", "B2")
+		require.NoError(t, err)
+		assert.Equal(t, excelize.CellTypeSharedString, tipo)
+		valor, err := planilha.GetCellValue("Convidados", "B2")
+		require.NoError(t, err)
+		assert.Equal(t, `=HYPERLINK("http://exemplo.com","clique")`, valor)
+	})
+}
@@ -0,0 +1,163 @@
+// file: internal/guest/interfaces/rest/formulario.go
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/luiszkm/wedding_backend/internal/guest/domain"
+	"github.com/luiszkm/wedding_backend/internal/platform/auth"
+	"github.com/luiszkm/wedding_backend/internal/platform/web"
+)
+
+func (h *GuestHandler) HandleObterFormularioRSVP(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	eventID, err := uuid.Parse(chi.URLParam(r, "idEvento"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do evento é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	formulario, err := h.service.ObterFormularioRSVP(r.Context(), userID, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrEventoNaoEncontrado) {
+			web.RespondError(w, r, "EVENTO_NAO_ENCONTRADO", "Evento não encontrado.", http.StatusNotFound)
+			return
+		}
+		log.Printf("ERRO: %v\n", err)
+		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
+		return
+	}
+
+	web.Respond(w, r, FormularioRSVPDTO{Perguntas: toPerguntasDTO(formulario)}, http.StatusOK)
+}
+
+func (h *GuestHandler) HandleDefinirFormularioRSVP(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	eventID, err := uuid.Parse(chi.URLParam(r, "idEvento"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do evento é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	var reqDTO FormularioRSVPDTO
+	if err := json.NewDecoder(r.Body).Decode(&reqDTO); err != nil {
+		web.RespondError(w, r, "CORPO_INVALIDO", "O corpo da requisição está malformado.", http.StatusBadRequest)
+		return
+	}
+
+	perguntas := make([]domain.DadosPergunta, len(reqDTO.Perguntas))
+	for i, pDTO := range reqDTO.Perguntas {
+		perguntaID := uuid.Nil
+		if pDTO.ID != "" {
+			perguntaID, err = uuid.Parse(pDTO.ID)
+			if err != nil {
+				web.RespondError(w, r, "DADOS_INVALIDOS", "ID de pergunta inválido: "+pDTO.ID, http.StatusBadRequest)
+				return
+			}
+		}
+		perguntas[i] = domain.DadosPergunta{
+			ID:          perguntaID,
+			Texto:       pDTO.Texto,
+			Tipo:        pDTO.Tipo,
+			Opcoes:      pDTO.Opcoes,
+			Obrigatoria: pDTO.Obrigatoria,
+			Minimo:      pDTO.Minimo,
+			Maximo:      pDTO.Maximo,
+			Finalidade:  pDTO.Finalidade,
+		}
+	}
+
+	formulario, err := h.service.DefinirFormularioRSVP(r.Context(), userID, eventID, perguntas)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrEventoNaoEncontrado):
+			web.RespondError(w, r, "EVENTO_NAO_ENCONTRADO", "Evento não encontrado.", http.StatusNotFound)
+		case errors.Is(err, domain.ErrFormularioMuitoGrande), errors.Is(err, domain.ErrTextoPerguntaInvalido),
+			errors.Is(err, domain.ErrTipoPerguntaInvalido), errors.Is(err, domain.ErrOpcoesPerguntaInvalidas),
+			errors.Is(err, domain.ErrFaixaPerguntaInvalida), errors.Is(err, domain.ErrPerguntaNaoEncontrada),
+			errors.Is(err, domain.ErrFinalidadePerguntaInvalida), errors.Is(err, domain.ErrFinalidadeRepetida):
+			web.RespondError(w, r, "DADOS_INVALIDOS", err.Error(), http.StatusBadRequest)
+		default:
+			log.Printf("ERRO: %v\n", err)
+			web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	web.Respond(w, r, FormularioRSVPDTO{Perguntas: toPerguntasDTO(formulario)}, http.StatusOK)
+}
+
+func toPerguntasDTO(formulario *domain.FormularioRSVP) []PerguntaRSVPDTO {
+	perguntasDTO := make([]PerguntaRSVPDTO, len(formulario.Perguntas()))
+	for i, p := range formulario.Perguntas() {
+		perguntasDTO[i] = PerguntaRSVPDTO{
+			ID:          p.ID().String(),
+			Texto:       p.Texto(),
+			Tipo:        p.Tipo(),
+			Opcoes:      p.Opcoes(),
+			Obrigatoria: p.Obrigatoria(),
+			Minimo:      p.Minimo(),
+			Maximo:      p.Maximo(),
+			Finalidade:  p.Finalidade(),
+		}
+	}
+	return perguntasDTO
+}
+
+func toRespostasFormularioDTO(convidado *domain.Convidado) []RespostaPerguntaDTO {
+	respostasDTO := make([]RespostaPerguntaDTO, len(convidado.RespostasFormulario()))
+	for i, resposta := range convidado.RespostasFormulario() {
+		respostasDTO[i] = RespostaPerguntaDTO{IDPergunta: resposta.IDPergunta.String(), Valores: resposta.Valores}
+	}
+	return respostasDTO
+}
+
+// toRespostasPergunta preserva o nil: respostas omitidas mantêm as atuais do convidado.
+func toRespostasPergunta(respostasDTO []RespostaPerguntaDTO) ([]domain.RespostaPergunta, error) {
+	if respostasDTO == nil {
+		return nil, nil
+	}
+	respostas := make([]domain.RespostaPergunta, len(respostasDTO))
+	for i, rDTO := range respostasDTO {
+		perguntaID, err := uuid.Parse(rDTO.IDPergunta)
+		if err != nil {
+			return nil, errors.New("ID de pergunta inválido: " + rDTO.IDPergunta)
+		}
+		respostas[i] = domain.RespostaPergunta{IDPergunta: perguntaID, Valores: rDTO.Valores}
+	}
+	return respostas, nil
+}
+
+func toEstatisticasPerguntasDTO(estatisticas []domain.EstatisticaPergunta) []EstatisticaPerguntaDTO {
+	estatisticasDTO := make([]EstatisticaPerguntaDTO, len(estatisticas))
+	for i, e := range estatisticas {
+		dto := EstatisticaPerguntaDTO{
+			IDPergunta:     e.IDPergunta.String(),
+			Texto:          e.Texto,
+			Tipo:           e.Tipo,
+			TotalRespostas: e.TotalRespostas,
+		}
+		for _, o := range e.Opcoes {
+			dto.Opcoes = append(dto.Opcoes, ContagemOpcaoDTO{Opcao: o.Opcao, Total: o.Total})
+		}
+		if e.Tipo == domain.TipoPerguntaNumero {
+			soma := e.Soma
+			dto.Soma = &soma
+		}
+		estatisticasDTO[i] = dto
+	}
+	return estatisticasDTO
+}
@@ -0,0 +1,123 @@
+// file: internal/guest/interfaces/rest/fusao_grupos.go
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/luiszkm/wedding_backend/internal/guest/domain"
+	"github.com/luiszkm/wedding_backend/internal/platform/auth"
+	"github.com/luiszkm/wedding_backend/internal/platform/web"
+)
+
+func (h *GuestHandler) HandleJuntarGrupos(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	grupoID, err := uuid.Parse(chi.URLParam(r, "idGrupo"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do grupo é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	var reqDTO JuntarGruposRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&reqDTO); err != nil {
+		web.RespondError(w, r, "CORPO_INVALIDO", "O corpo da requisição está malformado.", http.StatusBadRequest)
+		return
+	}
+	absorvidoID, err := uuid.Parse(reqDTO.IDGrupoAbsorvido)
+	if err != nil {
+		web.RespondError(w, r, "DADOS_INVALIDOS", "ID do grupo absorvido inválido: "+reqDTO.IDGrupoAbsorvido, http.StatusBadRequest)
+		return
+	}
+
+	grupo, err := h.service.JuntarGrupos(r.Context(), userID, grupoID, absorvidoID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrGrupoNaoEncontrado):
+			web.RespondError(w, r, "NAO_ENCONTRADO", "Grupo não encontrado.", http.StatusNotFound)
+		case errors.Is(err, domain.ErrFusaoComOMesmoGrupo), errors.Is(err, domain.ErrGruposDeEventosDiferentes):
+			web.RespondError(w, r, "DADOS_INVALIDOS", err.Error(), http.StatusBadRequest)
+		case errors.Is(err, domain.ErrLimiteAcompanhantesExcedido):
+			web.RespondError(w, r, "ACOMPANHANTES_INVALIDOS", err.Error(), http.StatusBadRequest)
+		default:
+			log.Printf("ERRO: %v\n", err)
+			web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	web.Respond(w, r, toGrupoDetalhadoDTO(grupo), http.StatusOK)
+}
+
+func (h *GuestHandler) HandleDividirGrupo(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	grupoID, err := uuid.Parse(chi.URLParam(r, "idGrupo"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do grupo é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	var reqDTO DividirGrupoRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&reqDTO); err != nil {
+		web.RespondError(w, r, "CORPO_INVALIDO", "O corpo da requisição está malformado.", http.StatusBadRequest)
+		return
+	}
+	convidados, err := parseUUIDs(reqDTO.Convidados)
+	if err != nil {
+		web.RespondError(w, r, "DADOS_INVALIDOS", "ID de convidado inválido: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	acompanhantes, err := parseUUIDs(reqDTO.Acompanhantes)
+	if err != nil {
+		web.RespondError(w, r, "DADOS_INVALIDOS", "ID de acompanhante inválido: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	divisao := domain.DivisaoGrupo{Convidados: convidados, Acompanhantes: acompanhantes, LimiteAcompanhantes: reqDTO.LimiteAcompanhantes}
+
+	original, novo, err := h.service.DividirGrupo(r.Context(), userID, grupoID, divisao, reqDTO.ChaveDeAcesso)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrGrupoNaoEncontrado):
+			web.RespondError(w, r, "NAO_ENCONTRADO", "Grupo não encontrado.", http.StatusNotFound)
+		case errors.Is(err, domain.ErrConvidadoNaoEncontradoNoGrupo), errors.Is(err, domain.ErrDivisaoSemConvidados),
+			errors.Is(err, domain.ErrLimiteAcompanhantesInvalido):
+			web.RespondError(w, r, "DADOS_INVALIDOS", err.Error(), http.StatusBadRequest)
+		case errors.Is(err, domain.ErrAcompanhanteNaoEncontrado), errors.Is(err, domain.ErrLimiteAcompanhantesExcedido),
+			errors.Is(err, domain.ErrAcompanhantesSemConfirmacao):
+			web.RespondError(w, r, "ACOMPANHANTES_INVALIDOS", err.Error(), http.StatusBadRequest)
+		case errors.Is(err, domain.ErrChaveDeAcessoEmUso):
+			web.RespondError(w, r, "CHAVE_EM_USO", err.Error(), http.StatusConflict)
+		default:
+			log.Printf("ERRO: %v\n", err)
+			web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	respDTO := DividirGrupoResponseDTO{GrupoOriginal: toGrupoDetalhadoDTO(original), NovoGrupo: toGrupoDetalhadoDTO(novo)}
+	web.Respond(w, r, respDTO, http.StatusCreated)
+}
+
+// parseUUIDs converte a lista de IDs; o erro traz o primeiro ID inválido.
+func parseUUIDs(textos []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, len(textos))
+	for i, texto := range textos {
+		id, err := uuid.Parse(texto)
+		if err != nil {
+			return nil, errors.New(texto)
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
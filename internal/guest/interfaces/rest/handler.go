@@ -4,6 +4,8 @@ package rest
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"log"
 	"net/http"
 
@@ -12,19 +14,21 @@ import (
 	"github.com/luiszkm/wedding_backend/internal/guest/application"
 	"github.com/luiszkm/wedding_backend/internal/guest/domain"
 	"github.com/luiszkm/wedding_backend/internal/platform/auth"
+	"github.com/luiszkm/wedding_backend/internal/platform/relatorio"
 	"github.com/luiszkm/wedding_backend/internal/platform/web"
 )
 
 type GuestHandler struct {
-	service *application.GuestService
+	service  *application.GuestService
+	convites *application.ConviteService // Troca o token dos links pela chave de acesso
 }
 
-func NewGuestHandler(service *application.GuestService) *GuestHandler {
-	return &GuestHandler{service: service}
+func NewGuestHandler(service *application.GuestService, convites *application.ConviteService) *GuestHandler {
+	return &GuestHandler{service: service, convites: convites}
 }
 
 func (h *GuestHandler) HandleCriarGrupoDeConvidados(w http.ResponseWriter, r *http.Request) {
-	_, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
 	if !ok {
 		// Este erro não deveria acontecer se o middleware estiver funcionando.
 		web.RespondError(w, r, "ERRO_CONTEXTO", "Não foi possível obter o ID do usuário.", http.StatusInternalServerError)
@@ -42,50 +46,145 @@ func (h *GuestHandler) HandleCriarGrupoDeConvidados(w http.ResponseWriter, r *ht
 		web.RespondError(w, r, "CORPO_INVALIDO", "O corpo da requisição está malformado.", http.StatusBadRequest)
 		return
 	}
+	if reqDTO.GerarChave != nil && reqDTO.ChaveDeAcesso != "" {
+		web.RespondError(w, r, "DADOS_INVALIDOS", "Informe 'chaveDeAcesso' ou 'gerarChave', não ambos.", http.StatusBadRequest)
+		return
+	}
+	convidados, err := toDadosConvidados(reqDTO)
+	if err != nil {
+		web.RespondError(w, r, "DADOS_INVALIDOS", err.Error(), http.StatusBadRequest)
+		return
+	}
 
-	idGrupo, err := h.service.CriarNovoGrupo(
-		r.Context(),
-		idCasamento,
-		reqDTO.ChaveDeAcesso,
-		reqDTO.NomesDosConvidados,
-	)
+	var idGrupo uuid.UUID
+	chaveDeAcesso := reqDTO.ChaveDeAcesso
+	if reqDTO.GerarChave != nil {
+		idGrupo, chaveDeAcesso, err = h.service.CriarNovoGrupoComChaveGerada(
+			r.Context(),
+			userID,
+			idCasamento,
+			toConfiguracaoChave(reqDTO.GerarChave),
+			convidados,
+			reqDTO.LimiteAcompanhantes,
+		)
+	} else {
+		idGrupo, err = h.service.CriarNovoGrupo(
+			r.Context(),
+			idCasamento,
+			reqDTO.ChaveDeAcesso,
+			convidados,
+			reqDTO.LimiteAcompanhantes,
+		)
+	}
 	if err != nil {
 		// Mapeia erros do domínio para respostas HTTP apropriadas
-		if errors.Is(err, domain.ErrChaveDeAcessoObrigatoria) || errors.Is(err, domain.ErrPeloMenosUmConvidado) {
+		switch {
+		case errors.Is(err, domain.ErrChaveDeAcessoObrigatoria), errors.Is(err, domain.ErrPeloMenosUmConvidado),
+			errors.Is(err, domain.ErrModoChaveInvalido), errors.Is(err, domain.ErrTamanhoChaveInvalido),
+			errors.Is(err, domain.ErrLimiteAcompanhantesInvalido), ehErroDadosConvidado(err):
 			web.RespondError(w, r, "DADOS_INVALIDOS", err.Error(), http.StatusBadRequest)
-			return
+		case errors.Is(err, domain.ErrEventoNaoEncontrado):
+			web.RespondError(w, r, "EVENTO_NAO_ENCONTRADO", "Evento não encontrado.", http.StatusNotFound)
+		case errors.Is(err, domain.ErrChaveDeAcessoEmUso):
+			web.RespondError(w, r, "CHAVE_EM_USO", err.Error(), http.StatusConflict)
+		default:
+			// Outros erros são tratados como internos
+			log.Printf("ERRO: %v\n", err)
+			web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
 		}
-		// Outros erros são tratados como internos
-		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
 		return
 	}
 
-	respDTO := CriarGrupoResponseDTO{IDGrupo: idGrupo.String()}
+	respDTO := CriarGrupoResponseDTO{IDGrupo: idGrupo.String(), ChaveDeAcesso: chaveDeAcesso}
 	web.Respond(w, r, respDTO, http.StatusCreated)
 }
 
+// HandleRegenerarChavesDeAcesso troca a chave de todos os grupos do evento.
+// O corpo é opcional; sem ele, usa a configuração padrão do gerador.
+func (h *GuestHandler) HandleRegenerarChavesDeAcesso(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+
+	eventID, err := uuid.Parse(chi.URLParam(r, "idEvento"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do evento é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	var reqDTO ConfiguracaoChaveDTO
+	if err := json.NewDecoder(r.Body).Decode(&reqDTO); err != nil && !errors.Is(err, io.EOF) {
+		web.RespondError(w, r, "CORPO_INVALIDO", "O corpo da requisição está malformado.", http.StatusBadRequest)
+		return
+	}
+
+	grupos, err := h.service.RegenerarChavesDeAcesso(r.Context(), userID, eventID, toConfiguracaoChave(&reqDTO))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrModoChaveInvalido), errors.Is(err, domain.ErrTamanhoChaveInvalido):
+			web.RespondError(w, r, "DADOS_INVALIDOS", err.Error(), http.StatusBadRequest)
+		case errors.Is(err, domain.ErrEventoNaoEncontrado):
+			web.RespondError(w, r, "EVENTO_NAO_ENCONTRADO", "Evento não encontrado.", http.StatusNotFound)
+		case errors.Is(err, domain.ErrGrupoNaoEncontrado), errors.Is(err, domain.ErrChaveDeAcessoEmUso):
+			web.RespondError(w, r, "CONFLITO", "Os grupos do evento mudaram durante a operação. Tente novamente.", http.StatusConflict)
+		default:
+			log.Printf("ERRO: %v\n", err)
+			web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	respDTO := RegenerarChavesResponseDTO{
+		Grupos: make([]ChaveDeAcessoGrupoDTO, len(grupos)),
+		Total:  len(grupos),
+	}
+	for i, g := range grupos {
+		respDTO.Grupos[i] = ChaveDeAcessoGrupoDTO{IDGrupo: g.ID().String(), ChaveDeAcesso: g.ChaveDeAcesso()}
+	}
+	web.Respond(w, r, respDTO, http.StatusOK)
+}
+
+func toAcompanhantesDTO(grupo *domain.GrupoDeConvidados) []AcompanhanteDTO {
+	acompanhantesDTO := make([]AcompanhanteDTO, len(grupo.Acompanhantes()))
+	for i, a := range grupo.Acompanhantes() {
+		acompanhantesDTO[i] = AcompanhanteDTO{ID: a.ID().String(), Nome: a.Nome()}
+	}
+	return acompanhantesDTO
+}
+
+func toConfiguracaoChave(dto *ConfiguracaoChaveDTO) domain.ConfiguracaoChave {
+	return domain.ConfiguracaoChave{Modo: dto.Modo, Tamanho: dto.Tamanho}
+}
+
 func (h *GuestHandler) HandleObterGrupoPorChaveDeAcesso(w http.ResponseWriter, r *http.Request) {
-	// 1. Extrair os query parameters da URL.
+	// 1. Extrair os query parameters da URL. O token do link substitui chave e evento.
 	chaveDeAcesso := r.URL.Query().Get("chave")
-	if chaveDeAcesso == "" {
-		web.RespondError(w, r, "PARAMETRO_AUSENTE", "O parâmetro 'chave' é obrigatório.", http.StatusBadRequest)
+	token := r.URL.Query().Get("token")
+	if chaveDeAcesso == "" && token == "" {
+		web.RespondError(w, r, "PARAMETRO_AUSENTE", "O parâmetro 'chave' ou 'token' é obrigatório.", http.StatusBadRequest)
 		return
 	}
 
 	eventoIDStr := r.URL.Query().Get("idEvento")
-	if eventoIDStr == "" {
+	if eventoIDStr == "" && token == "" {
 		web.RespondError(w, r, "PARAMETRO_AUSENTE", "O parâmetro 'idEvento' é obrigatório.", http.StatusBadRequest)
 		return
 	}
 
-	eventoID, err := uuid.Parse(eventoIDStr)
+	eventoID, err := eventoDoAcesso(eventoIDStr, token)
 	if err != nil {
 		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do evento é inválido.", http.StatusBadRequest)
 		return
 	}
+	eventoID, chaveDeAcesso, ok := h.resolverChaveDeAcesso(w, r, eventoID, chaveDeAcesso, token)
+	if !ok {
+		return
+	}
 
 	// 2. Chamar a camada de aplicação.
-	grupo, err := h.service.ObterGrupoPorChaveDeAcesso(r.Context(), eventoID, chaveDeAcesso)
+	acesso, err := h.service.ObterAcessoConvidado(r.Context(), eventoID, chaveDeAcesso)
 	if err != nil {
 		// Se o erro for "não encontrado", retornamos 404.
 		if errors.Is(err, domain.ErrGrupoNaoEncontrado) {
@@ -98,22 +197,45 @@ func (h *GuestHandler) HandleObterGrupoPorChaveDeAcesso(w http.ResponseWriter, r
 		return
 	}
 
-	// 3. Mapear o agregado de domínio para o DTO de resposta.
-	convidadosDTO := make([]ConvidadoDTO, len(grupo.Convidados()))
+	// 3. Mapear o agregado de domínio para o DTO de resposta e responder.
+	web.Respond(w, r, toGrupoParaConfirmacaoDTO(acesso), http.StatusOK)
+}
+
+func toGrupoParaConfirmacaoDTO(acesso *application.AcessoConvidado) GrupoParaConfirmacaoDTO {
+	grupo := acesso.Grupo
+	convidadosDTO := make([]ConvidadoAcessoDTO, len(grupo.Convidados()))
 	for i, c := range grupo.Convidados() {
-		convidadosDTO[i] = ConvidadoDTO{
-			ID:         c.ID().String(),
-			Nome:       c.Nome(),
-			StatusRSVP: c.StatusRSVP(),
+		convidadosDTO[i] = ConvidadoAcessoDTO{
+			ConvidadoDTO: ConvidadoDTO{
+				ID:                  c.ID().String(),
+				Nome:                c.Nome(),
+				StatusRSVP:          c.StatusRSVP(),
+				RespostasFormulario: toRespostasFormularioDTO(c),
+			},
+		}
+		if acesso.Perfil.Permite(domain.CampoPerfilTelefone) {
+			telefone := c.Telefone()
+			convidadosDTO[i].Telefone = &telefone
+		}
+		if acesso.Perfil.Permite(domain.CampoPerfilEmail) {
+			email := c.Email()
+			convidadosDTO[i].Email = &email
 		}
 	}
-	respDTO := GrupoParaConfirmacaoDTO{
-		IDGrupo:    grupo.ID().String(),
-		Convidados: convidadosDTO,
+	camposEditaveis := []string{}
+	if acesso.Perfil != nil {
+		camposEditaveis = acesso.Perfil.Campos()
+	}
+	return GrupoParaConfirmacaoDTO{
+		IDGrupo:             grupo.ID().String(),
+		Convidados:          convidadosDTO,
+		LimiteAcompanhantes: grupo.LimiteAcompanhantes(),
+		Acompanhantes:       toAcompanhantesDTO(grupo),
+		Perguntas:           toPerguntasDTO(acesso.Formulario),
+		PrazoRSVP:           acesso.PrazoRSVP,
+		RSVPEncerrado:       acesso.Encerrado,
+		CamposEditaveis:     camposEditaveis,
 	}
-
-	// 4. Responder com sucesso.
-	web.Respond(w, r, respDTO, http.StatusOK)
 }
 
 func (h *GuestHandler) HandleConfirmarPresenca(w http.ResponseWriter, r *http.Request) {
@@ -124,40 +246,36 @@ func (h *GuestHandler) HandleConfirmarPresenca(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	// 2. Validar e parsear o ID do evento.
-	eventoID, err := uuid.Parse(reqDTO.IDEvento)
+	// 2. Validar e parsear o ID do evento, opcional quando vem o token do link.
+	eventoID, err := eventoDoAcesso(reqDTO.IDEvento, reqDTO.Token)
 	if err != nil {
 		web.RespondError(w, r, "DADOS_INVALIDOS", "ID do evento inválido: "+reqDTO.IDEvento, http.StatusBadRequest)
 		return
 	}
+	eventoID, chaveDeAcesso, ok := h.resolverChaveDeAcesso(w, r, eventoID, reqDTO.ChaveDeAcesso, reqDTO.Token)
+	if !ok {
+		return
+	}
 
 	// 3. Converter o DTO da camada de interface para o tipo do domínio.
-	respostasDominio := make([]domain.RespostaRSVP, len(reqDTO.Respostas))
-	for i, rsvpDTO := range reqDTO.Respostas {
-		convidadoID, err := uuid.Parse(rsvpDTO.IDConvidado)
-		if err != nil {
-			web.RespondError(w, r, "DADOS_INVALIDOS", "ID de convidado inválido: "+rsvpDTO.IDConvidado, http.StatusBadRequest)
-			return
-		}
-		respostasDominio[i] = domain.RespostaRSVP{
-			ConvidadoID: convidadoID,
-			Status:      rsvpDTO.Status,
-		}
+	respostasDominio, err := toRespostasRSVP(reqDTO.Respostas)
+	if err != nil {
+		web.RespondError(w, r, "DADOS_INVALIDOS", err.Error(), http.StatusBadRequest)
+		return
 	}
 
 	// 4. Chamar o serviço de aplicação.
-	err = h.service.ConfirmarPresencaGrupo(r.Context(), eventoID, reqDTO.ChaveDeAcesso, respostasDominio)
+	err = h.service.ConfirmarPresencaGrupo(r.Context(), eventoID, chaveDeAcesso, respostasDominio, reqDTO.Acompanhantes, origemDaRequisicao(r))
 	if err != nil {
 		if errors.Is(err, domain.ErrGrupoNaoEncontrado) {
 			web.RespondError(w, r, "NAO_ENCONTRADO", "Chave de acesso não encontrada.", http.StatusNotFound)
 			return
 		}
-		if errors.Is(err, domain.ErrStatusRSVPInvalido) || errors.Is(err, domain.ErrConvidadoNaoEncontradoNoGrupo) {
-			web.RespondError(w, r, "DADOS_INVALIDOS", err.Error(), http.StatusBadRequest)
+		if errors.Is(err, domain.ErrPrazoRSVPEncerrado) {
+			web.RespondError(w, r, "PRAZO_RSVP_ENCERRADO", err.Error(), http.StatusForbidden)
 			return
 		}
-		log.Printf("ERRO: %v\n", err)
-		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
+		responderErroRSVP(w, r, err)
 		return
 	}
 
@@ -195,12 +313,17 @@ func (h *GuestHandler) HandleRevisarGrupo(w http.ResponseWriter, r *http.Request
 			convidadoID = parsedID
 		}
 		convidadosDominio[i] = domain.ConvidadoParaRevisao{
-			ID:   convidadoID,
-			Nome: cDTO.Nome,
+			ID:          convidadoID,
+			Nome:        cDTO.Nome,
+			Telefone:    cDTO.Telefone,
+			Email:       cDTO.Email,
+			FaixaEtaria: cDTO.FaixaEtaria,
+			Lado:        cDTO.Lado,
+			Observacoes: cDTO.Observacoes,
 		}
 	}
 
-	err = h.service.RevisarGrupo(r.Context(), userID, grupoID, reqDTO.ChaveDeAcesso, convidadosDominio)
+	err = h.service.RevisarGrupo(r.Context(), userID, grupoID, reqDTO.ChaveDeAcesso, convidadosDominio, reqDTO.LimiteAcompanhantes)
 	// ... (Lógica de tratamento de erro similar aos outros handlers) ...
 	// ...
 	// Exemplo:
@@ -209,6 +332,15 @@ func (h *GuestHandler) HandleRevisarGrupo(w http.ResponseWriter, r *http.Request
 			web.RespondError(w, r, "NAO_ENCONTRADO", "Grupo não encontrado.", http.StatusNotFound)
 			return
 		}
+		if errors.Is(err, domain.ErrLimiteAcompanhantesInvalido) || errors.Is(err, domain.ErrLimiteMenorQueAcompanhantes) ||
+			ehErroDadosConvidado(err) {
+			web.RespondError(w, r, "DADOS_INVALIDOS", err.Error(), http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, domain.ErrConvidadoComRSVPOmitido) {
+			web.RespondError(w, r, "CONVIDADO_COM_RSVP", err.Error(), http.StatusConflict)
+			return
+		}
 		// ... outros erros de negócio
 		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
 		return
@@ -231,30 +363,43 @@ func (h *GuestHandler) HandleListarGruposPorEvento(w http.ResponseWriter, r *htt
 		return
 	}
 
-	// Parâmetro opcional de filtro por status
-	statusFilter := r.URL.Query().Get("status")
+	// Filtros opcionais por status e dados do convidado
+	filtro, err := filtroConvidadosDaQuery(r)
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", err.Error(), http.StatusBadRequest)
+		return
+	}
 
-	grupos, err := h.service.ListarGruposPorEvento(r.Context(), userID, eventID, statusFilter)
+	paginacao, err := paginacaoGruposDaQuery(r)
 	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	pagina, err := h.service.ListarGruposPorEvento(r.Context(), userID, eventID, filtro, paginacao)
+	if err != nil {
+		if responderErroFiltroConvidados(w, r, err) {
+			return
+		}
+		if errors.Is(err, domain.ErrOrdenacaoInvalida) || errors.Is(err, domain.ErrLimitePaginaInvalido) || errors.Is(err, domain.ErrCursorInvalido) {
+			web.RespondError(w, r, "PARAMETRO_INVALIDO", err.Error(), http.StatusBadRequest)
+			return
+		}
 		log.Printf("ERRO: %v\n", err)
 		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
 		return
 	}
 
 	// Mapear para DTOs
-	gruposDTO := make([]GrupoResumoDTO, len(grupos))
-	for i, grupo := range grupos {
+	gruposDTO := make([]GrupoResumoDTO, len(pagina.Grupos))
+	for i, grupo := range pagina.Grupos {
 		confirmados := 0
 		recusados := 0
 		pendentes := 0
 
-		convidadosDTO := make([]ConvidadoDTO, len(grupo.Convidados()))
+		convidadosDTO := make([]ConvidadoAdminDTO, len(grupo.Convidados()))
 		for j, convidado := range grupo.Convidados() {
-			convidadosDTO[j] = ConvidadoDTO{
-				ID:         convidado.ID().String(),
-				Nome:       convidado.Nome(),
-				StatusRSVP: convidado.StatusRSVP(),
-			}
+			convidadosDTO[j] = toConvidadoAdminDTO(convidado)
 
 			switch convidado.StatusRSVP() {
 			case "CONFIRMADO":
@@ -281,13 +426,21 @@ func (h *GuestHandler) HandleListarGruposPorEvento(w http.ResponseWriter, r *htt
 			ConvidadosRecusados:   recusados,
 			ConvidadosPendentes:   pendentes,
 			Convidados:            convidadosDTO,
+			LimiteAcompanhantes:   grupo.LimiteAcompanhantes(),
+			Acompanhantes:         toAcompanhantesDTO(grupo),
+			Etiquetas:             toEtiquetasDTO(grupo.Etiquetas()),
 			DataConfirmacao:       dataConfirmacao,
+			PrazoRSVPEstendido:    grupo.PrazoRSVPEstendido(),
+			UltimaRespostaEm:      grupo.UltimaRespostaEm(),
 		}
 	}
 
 	respDTO := ListarGruposResponseDTO{
 		Grupos: gruposDTO,
-		Total:  len(gruposDTO),
+		Total:  pagina.Total,
+	}
+	if pagina.ProximoCursor != "" {
+		respDTO.ProximoCursor = &pagina.ProximoCursor
 	}
 
 	web.Respond(w, r, respDTO, http.StatusOK)
@@ -318,16 +471,16 @@ func (h *GuestHandler) HandleObterGrupoPorID(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	// Mapear para DTO detalhado
-	convidadosDTO := make([]ConvidadoDTO, len(grupo.Convidados()))
+	web.Respond(w, r, toGrupoDetalhadoDTO(grupo), http.StatusOK)
+}
+
+// toGrupoDetalhadoDTO mapeia o grupo com todos os detalhes vistos pelo anfitrião.
+func toGrupoDetalhadoDTO(grupo *domain.GrupoDeConvidados) GrupoDetalhadoDTO {
+	convidadosDTO := make([]ConvidadoAdminDTO, len(grupo.Convidados()))
 	confirmados := 0
 	recusados := 0
 	for i, c := range grupo.Convidados() {
-		convidadosDTO[i] = ConvidadoDTO{
-			ID:         c.ID().String(),
-			Nome:       c.Nome(),
-			StatusRSVP: c.StatusRSVP(),
-		}
+		convidadosDTO[i] = toConvidadoAdminDTO(c)
 		if c.StatusRSVP() == "CONFIRMADO" {
 			confirmados++
 		} else if c.StatusRSVP() == "RECUSADO" {
@@ -342,15 +495,19 @@ func (h *GuestHandler) HandleObterGrupoPorID(w http.ResponseWriter, r *http.Requ
 		dataConfirmacao = &dataStr
 	}
 
-	respDTO := GrupoDetalhadoDTO{
-		ID:              grupo.ID().String(),
-		IDEvento:        grupo.IDCasamento().String(),
-		ChaveDeAcesso:   grupo.ChaveDeAcesso(),
-		Convidados:      convidadosDTO,
-		DataConfirmacao: dataConfirmacao,
+	return GrupoDetalhadoDTO{
+		ID:                  grupo.ID().String(),
+		IDEvento:            grupo.IDCasamento().String(),
+		ChaveDeAcesso:       grupo.ChaveDeAcesso(),
+		Convidados:          convidadosDTO,
+		ConvidadosRemovidos: toConvidadosRemovidosDTO(grupo.Removidos()),
+		LimiteAcompanhantes: grupo.LimiteAcompanhantes(),
+		Acompanhantes:       toAcompanhantesDTO(grupo),
+		Etiquetas:           toEtiquetasDTO(grupo.Etiquetas()),
+		DataConfirmacao:     dataConfirmacao,
+		PrazoRSVPEstendido:  grupo.PrazoRSVPEstendido(),
+		UltimaRespostaEm:    grupo.UltimaRespostaEm(),
 	}
-
-	web.Respond(w, r, respDTO, http.StatusOK)
 }
 
 func (h *GuestHandler) HandleRemoverGrupo(w http.ResponseWriter, r *http.Request) {
@@ -399,7 +556,13 @@ func (h *GuestHandler) HandleObterEstatisticasRSVP(w http.ResponseWriter, r *htt
 		return
 	}
 
-	stats, err := h.service.ObterEstatisticasRSVP(r.Context(), userID, eventID)
+	etiquetas, err := etiquetasDaQuery(r)
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	stats, err := h.service.ObterEstatisticasRSVP(r.Context(), userID, eventID, etiquetas)
 	if err != nil {
 		log.Printf("ERRO: %v\n", err)
 		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
@@ -407,15 +570,161 @@ func (h *GuestHandler) HandleObterEstatisticasRSVP(w http.ResponseWriter, r *htt
 	}
 
 	respDTO := EstatisticasRSVPDTO{
-		TotalGrupos:           stats.TotalGrupos,
-		TotalConvidados:       stats.TotalConvidados,
-		ConvidadosConfirmados: stats.ConvidadosConfirmados,
-		ConvidadosRecusados:   stats.ConvidadosRecusados,
-		ConvidadosPendentes:   stats.ConvidadosPendentes,
-		PercentualConfirmado:  stats.PercentualConfirmado,
-		PercentualRecusado:    stats.PercentualRecusado,
-		PercentualPendente:    stats.PercentualPendente,
+		TotalGrupos:               stats.TotalGrupos,
+		TotalConvidados:           stats.TotalConvidados,
+		ConvidadosConfirmados:     stats.ConvidadosConfirmados,
+		ConvidadosRecusados:       stats.ConvidadosRecusados,
+		ConvidadosPendentes:       stats.ConvidadosPendentes,
+		PercentualConfirmado:      stats.PercentualConfirmado,
+		PercentualRecusado:        stats.PercentualRecusado,
+		PercentualPendente:        stats.PercentualPendente,
+		LimiteAcompanhantes:       stats.LimiteAcompanhantes,
+		AcompanhantesConfirmados:  stats.AcompanhantesConfirmados,
+		TotalPresencasConfirmadas: stats.TotalPresencasConfirmadas,
+		Perguntas:                 toEstatisticasPerguntasDTO(stats.Perguntas),
+		Etiquetas:                 toEstatisticasEtiquetasDTO(stats.Etiquetas),
 	}
 
 	web.Respond(w, r, respDTO, http.StatusOK)
 }
+
+// tamanhoMaximoPlanilha limita o upload da importação de convidados.
+const tamanhoMaximoPlanilha = 5 << 20 // 5 MB
+
+func (h *GuestHandler) HandleImportarGrupos(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+
+	eventID, err := uuid.Parse(chi.URLParam(r, "idEvento"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do evento é inválido.", http.StatusBadRequest)
+		return
+	}
+	dryRun := r.URL.Query().Get("dryRun") == "true"
+
+	r.Body = http.MaxBytesReader(w, r.Body, tamanhoMaximoPlanilha)
+	if err := r.ParseMultipartForm(tamanhoMaximoPlanilha); err != nil {
+		web.RespondError(w, r, "CORPO_GRANDE", "A planilha deve ter no máximo 5MB.", http.StatusBadRequest)
+		return
+	}
+	arquivo, fileHeader, err := r.FormFile("arquivo")
+	if err != nil {
+		web.RespondError(w, r, "ARQUIVO_AUSENTE", "Envie a planilha no campo 'arquivo'.", http.StatusBadRequest)
+		return
+	}
+	defer arquivo.Close()
+
+	linhas, err := lerPlanilhaConvidados(fileHeader.Filename, arquivo)
+	if err != nil {
+		web.RespondError(w, r, "PLANILHA_INVALIDA", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	plano, err := h.service.ImportarGrupos(r.Context(), userID, eventID, linhas, dryRun)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrImportacaoComProblemas):
+			web.Respond(w, r, toRelatorioImportacaoDTO(plano, dryRun, false), http.StatusUnprocessableEntity)
+		case errors.Is(err, domain.ErrImportacaoVazia), errors.Is(err, domain.ErrImportacaoMuitoGrande):
+			web.RespondError(w, r, "PLANILHA_INVALIDA", err.Error(), http.StatusBadRequest)
+		case errors.Is(err, domain.ErrEventoNaoEncontrado):
+			web.RespondError(w, r, "EVENTO_NAO_ENCONTRADO", "Evento não encontrado.", http.StatusNotFound)
+		case errors.Is(err, domain.ErrChaveDeAcessoEmUso):
+			web.RespondError(w, r, "CHAVE_EM_USO", "Uma das chaves de acesso foi usada por outro grupo durante a importação. Tente novamente.", http.StatusConflict)
+		default:
+			log.Printf("ERRO: %v\n", err)
+			web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	if dryRun {
+		web.Respond(w, r, toRelatorioImportacaoDTO(plano, true, false), http.StatusOK)
+		return
+	}
+	web.Respond(w, r, toRelatorioImportacaoDTO(plano, false, true), http.StatusCreated)
+}
+
+func toRelatorioImportacaoDTO(plano *domain.PlanoImportacao, dryRun, importado bool) RelatorioImportacaoDTO {
+	relatorio := RelatorioImportacaoDTO{
+		DryRun:          dryRun,
+		Importado:       importado,
+		TotalLinhas:     plano.TotalLinhas,
+		TotalGrupos:     len(plano.Grupos),
+		TotalConvidados: plano.TotalConvidados,
+		Grupos:          make([]GrupoImportadoDTO, len(plano.Grupos)),
+		Problemas:       make([]ProblemaImportacaoDTO, len(plano.Problemas)),
+	}
+	for i, grupo := range plano.Grupos {
+		nomes := make([]string, len(grupo.Convidados()))
+		for j, c := range grupo.Convidados() {
+			nomes[j] = c.Nome()
+		}
+		relatorio.Grupos[i] = GrupoImportadoDTO{
+			ID:            grupo.ID().String(),
+			ChaveDeAcesso: grupo.ChaveDeAcesso(),
+			Convidados:    nomes,
+		}
+	}
+	for i, p := range plano.Problemas {
+		relatorio.Problemas[i] = ProblemaImportacaoDTO(p)
+	}
+	return relatorio
+}
+
+func (h *GuestHandler) HandleExportarConvidados(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+
+	eventID, err := uuid.Parse(chi.URLParam(r, "idEvento"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do evento é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	formato := r.URL.Query().Get("formato")
+	if formato == "" {
+		formato = formatoCSV
+	}
+	contentType, ok := contentTypesExportacao[formato]
+	if !ok {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "Formato inválido. Use csv, xlsx ou pdf.", http.StatusBadRequest)
+		return
+	}
+
+	filtro, err := filtroConvidadosDaQuery(r)
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	exportacao, err := h.service.ExportarConvidados(r.Context(), userID, eventID, filtro)
+	if err != nil {
+		if responderErroFiltroConvidados(w, r, err) {
+			return
+		}
+		log.Printf("ERRO: %v\n", err)
+		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
+		return
+	}
+
+	var escrever func(io.Writer, *application.ExportacaoConvidados) error
+	switch formato {
+	case formatoCSV:
+		escrever = escreverCSVConvidados
+	case formatoXLSX:
+		escrever = escreverXLSXConvidados
+	case formatoPDF:
+		escrever = escreverPDFConvidados
+	}
+	nomeArquivo := fmt.Sprintf("convidados-%s.%s", exportacao.GeradoEm.Format("2006-01-02"), formato)
+	relatorio.EnviarArquivo(w, r, nomeArquivo, contentType, func(saida io.Writer) error {
+		return escrever(saida, exportacao)
+	})
+}
@@ -0,0 +1,124 @@
+// file: internal/guest/interfaces/rest/historico.go
+package rest
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/luiszkm/wedding_backend/internal/guest/domain"
+	"github.com/luiszkm/wedding_backend/internal/platform/auth"
+	"github.com/luiszkm/wedding_backend/internal/platform/web"
+)
+
+// tamanhoMaximoUserAgent evita que um cabeçalho enorme vá parar no histórico.
+const tamanhoMaximoUserAgent = 512
+
+func (h *GuestHandler) HandleListarHistoricoRSVPGrupo(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	grupoID, err := uuid.Parse(chi.URLParam(r, "idGrupo"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do grupo é inválido.", http.StatusBadRequest)
+		return
+	}
+	filtro, ok := filtroHistoricoDaQuery(w, r)
+	if !ok {
+		return
+	}
+
+	registros, err := h.service.ListarHistoricoRSVPGrupo(r.Context(), userID, grupoID, filtro)
+	if err != nil {
+		if errors.Is(err, domain.ErrGrupoNaoEncontrado) {
+			web.RespondError(w, r, "NAO_ENCONTRADO", "Grupo não encontrado.", http.StatusNotFound)
+			return
+		}
+		log.Printf("ERRO: %v\n", err)
+		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
+		return
+	}
+
+	web.Respond(w, r, toHistoricoRSVPResponseDTO(registros), http.StatusOK)
+}
+
+func (h *GuestHandler) HandleListarHistoricoRSVPEvento(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	eventID, err := uuid.Parse(chi.URLParam(r, "idEvento"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do evento é inválido.", http.StatusBadRequest)
+		return
+	}
+	filtro, ok := filtroHistoricoDaQuery(w, r)
+	if !ok {
+		return
+	}
+
+	registros, err := h.service.ListarHistoricoRSVPEvento(r.Context(), userID, eventID, filtro)
+	if err != nil {
+		if errors.Is(err, domain.ErrEventoNaoEncontrado) {
+			web.RespondError(w, r, "EVENTO_NAO_ENCONTRADO", "Evento não encontrado.", http.StatusNotFound)
+			return
+		}
+		log.Printf("ERRO: %v\n", err)
+		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
+		return
+	}
+
+	web.Respond(w, r, toHistoricoRSVPResponseDTO(registros), http.StatusOK)
+}
+
+// filtroHistoricoDaQuery lê o parâmetro opcional idConvidado e responde 400 se for inválido.
+func filtroHistoricoDaQuery(w http.ResponseWriter, r *http.Request) (domain.FiltroHistoricoRSVP, bool) {
+	var filtro domain.FiltroHistoricoRSVP
+	if idConvidado := r.URL.Query().Get("idConvidado"); idConvidado != "" {
+		id, err := uuid.Parse(idConvidado)
+		if err != nil {
+			web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do convidado é inválido.", http.StatusBadRequest)
+			return filtro, false
+		}
+		filtro.IDConvidado = id
+	}
+	return filtro, true
+}
+
+// origemDaRequisicao coleta IP e user-agent; o canal é definido pelo serviço.
+func origemDaRequisicao(r *http.Request) domain.OrigemRSVP {
+	userAgent := r.UserAgent()
+	if len(userAgent) > tamanhoMaximoUserAgent {
+		userAgent = userAgent[:tamanhoMaximoUserAgent]
+	}
+	return domain.OrigemRSVP{IP: web.IPDoCliente(r), UserAgent: userAgent}
+}
+
+func toHistoricoRSVPResponseDTO(registros []domain.RegistroRSVP) HistoricoRSVPResponseDTO {
+	registrosDTO := make([]RegistroRSVPDTO, len(registros))
+	for i, reg := range registros {
+		dto := RegistroRSVPDTO{
+			ID:             reg.ID.String(),
+			IDGrupo:        reg.IDGrupo.String(),
+			IDConvidado:    reg.IDConvidado.String(),
+			NomeConvidado:  reg.NomeConvidado,
+			StatusAnterior: reg.StatusAnterior,
+			StatusNovo:     reg.StatusNovo,
+			Canal:          reg.Origem.Canal,
+			IP:             reg.Origem.IP,
+			UserAgent:      reg.Origem.UserAgent,
+			RegistradoEm:   reg.RegistradoEm,
+		}
+		if reg.Origem.IDUsuario != nil {
+			idUsuario := reg.Origem.IDUsuario.String()
+			dto.IDUsuario = &idUsuario
+		}
+		registrosDTO[i] = dto
+	}
+	return HistoricoRSVPResponseDTO{Registros: registrosDTO, Total: len(registrosDTO)}
+}
@@ -0,0 +1,78 @@
+// file: internal/guest/interfaces/rest/link_acesso.go
+package rest
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	eventDomain "github.com/luiszkm/wedding_backend/internal/event/domain"
+	"github.com/luiszkm/wedding_backend/internal/guest/domain"
+	"github.com/luiszkm/wedding_backend/internal/platform/auth"
+	"github.com/luiszkm/wedding_backend/internal/platform/web"
+)
+
+// HandleObterLinkDeAcesso emite um token novo para o grupo e devolve o link que o leva.
+// Tokens emitidos antes continuam válidos até expirar ou até a chave do grupo mudar.
+func (h *ConviteHandler) HandleObterLinkDeAcesso(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	grupoID, err := uuid.Parse(chi.URLParam(r, "idGrupo"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do grupo é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	convite, err := h.service.GerarConvite(r.Context(), userID, grupoID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrGrupoNaoEncontrado), errors.Is(err, eventDomain.ErrEventoNaoEncontrado):
+			web.RespondError(w, r, "NAO_ENCONTRADO", "Grupo não encontrado.", http.StatusNotFound)
+		default:
+			log.Printf("ERRO: %v\n", err)
+			web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	respDTO := LinkDeAcessoDTO{
+		IDGrupo:  convite.Grupo.ID().String(),
+		Link:     convite.Link,
+		Token:    convite.Token,
+		ExpiraEm: convite.ExpiraEm,
+	}
+	web.Respond(w, r, respDTO, http.StatusOK)
+}
+
+// eventoDoAcesso lê o ID do evento das rotas públicas; com o token do link ele é opcional.
+func eventoDoAcesso(valor, token string) (uuid.UUID, error) {
+	if valor == "" && token != "" {
+		return uuid.Nil, nil
+	}
+	return uuid.Parse(valor)
+}
+
+// resolverChaveDeAcesso troca o token do link, quando enviado, pelo evento e pela chave
+// do grupo. Em caso de falha já responde ao cliente e devolve ok falso. O token recusado
+// não responde 404, que o limitador contaria como tentativa de adivinhar chaves.
+func (h *GuestHandler) resolverChaveDeAcesso(w http.ResponseWriter, r *http.Request, eventoID uuid.UUID, chaveDeAcesso, token string) (uuid.UUID, string, bool) {
+	eventoID, chaveDeAcesso, err := h.convites.ResolverChaveDeAcesso(r.Context(), eventoID, chaveDeAcesso, token)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrLinkDeAcessoInvalido):
+			web.RespondError(w, r, "LINK_INVALIDO", err.Error(), http.StatusUnauthorized)
+		case errors.Is(err, domain.ErrGrupoNaoEncontrado):
+			web.RespondError(w, r, "NAO_ENCONTRADO", "Grupo não encontrado.", http.StatusNotFound)
+		default:
+			log.Printf("ERRO: %v\n", err)
+			web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
+		}
+		return uuid.Nil, "", false
+	}
+	return eventoID, chaveDeAcesso, true
+}
@@ -0,0 +1,223 @@
+// file: internal/guest/interfaces/rest/perfil.go
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/luiszkm/wedding_backend/internal/guest/domain"
+	"github.com/luiszkm/wedding_backend/internal/platform/auth"
+	"github.com/luiszkm/wedding_backend/internal/platform/web"
+)
+
+// HandleAtualizarPerfilConvidado é a edição do cadastro pelo próprio grupo, no link público.
+func (h *GuestHandler) HandleAtualizarPerfilConvidado(w http.ResponseWriter, r *http.Request) {
+	var reqDTO AtualizarPerfilRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&reqDTO); err != nil {
+		web.RespondError(w, r, "CORPO_INVALIDO", "O corpo da requisição está malformado.", http.StatusBadRequest)
+		return
+	}
+	eventoID, err := eventoDoAcesso(reqDTO.IDEvento, reqDTO.Token)
+	if err != nil {
+		web.RespondError(w, r, "DADOS_INVALIDOS", "ID do evento inválido: "+reqDTO.IDEvento, http.StatusBadRequest)
+		return
+	}
+	alteracao, err := toAlteracaoPerfil(reqDTO)
+	if err != nil {
+		web.RespondError(w, r, "DADOS_INVALIDOS", err.Error(), http.StatusBadRequest)
+		return
+	}
+	eventoID, chaveDeAcesso, ok := h.resolverChaveDeAcesso(w, r, eventoID, reqDTO.ChaveDeAcesso, reqDTO.Token)
+	if !ok {
+		return
+	}
+
+	acesso, err := h.service.AtualizarPerfilConvidado(r.Context(), eventoID, chaveDeAcesso, alteracao, origemDaRequisicao(r))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrGrupoNaoEncontrado):
+			web.RespondError(w, r, "NAO_ENCONTRADO", "Chave de acesso não encontrada.", http.StatusNotFound)
+		case errors.Is(err, domain.ErrCampoPerfilNaoEditavel):
+			web.RespondError(w, r, "CAMPO_NAO_EDITAVEL", err.Error(), http.StatusForbidden)
+		case errors.Is(err, domain.ErrPrazoRSVPEncerrado):
+			web.RespondError(w, r, "PRAZO_RSVP_ENCERRADO", err.Error(), http.StatusForbidden)
+		case ehErroDadosConvidado(err):
+			web.RespondError(w, r, "DADOS_INVALIDOS", err.Error(), http.StatusBadRequest)
+		case errors.Is(err, domain.ErrAcompanhanteNaoEncontrado):
+			web.RespondError(w, r, "ACOMPANHANTES_INVALIDOS", err.Error(), http.StatusBadRequest)
+		default:
+			responderErroRSVP(w, r, err)
+		}
+		return
+	}
+
+	web.Respond(w, r, toGrupoParaConfirmacaoDTO(acesso), http.StatusOK)
+}
+
+// toAlteracaoPerfil preserva a diferença entre 'acompanhantes' ausente (mantém a lista)
+// e vazio (remove todos).
+func toAlteracaoPerfil(reqDTO AtualizarPerfilRequestDTO) (domain.AlteracaoPerfil, error) {
+	alteracao := domain.AlteracaoPerfil{Convidados: make([]domain.AlteracaoConvidado, len(reqDTO.Convidados))}
+	for i, c := range reqDTO.Convidados {
+		id, err := uuid.Parse(c.ID)
+		if err != nil {
+			return domain.AlteracaoPerfil{}, errors.New("ID de convidado inválido: " + c.ID)
+		}
+		alteracao.Convidados[i] = domain.AlteracaoConvidado{ID: id, Nome: c.Nome, Telefone: c.Telefone, Email: c.Email}
+	}
+	if reqDTO.Acompanhantes != nil {
+		alteracao.Acompanhantes = make([]domain.AcompanhanteParaPerfil, len(reqDTO.Acompanhantes))
+		for i, a := range reqDTO.Acompanhantes {
+			var id uuid.UUID
+			if a.ID != "" {
+				var err error
+				if id, err = uuid.Parse(a.ID); err != nil {
+					return domain.AlteracaoPerfil{}, errors.New("ID de acompanhante inválido: " + a.ID)
+				}
+			}
+			alteracao.Acompanhantes[i] = domain.AcompanhanteParaPerfil{ID: id, Nome: a.Nome}
+		}
+	}
+	return alteracao, nil
+}
+
+func (h *GuestHandler) HandleObterConfiguracaoPerfil(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	eventID, err := uuid.Parse(chi.URLParam(r, "idEvento"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do evento é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	config, err := h.service.ObterConfiguracaoPerfil(r.Context(), userID, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrEventoNaoEncontrado) {
+			web.RespondError(w, r, "EVENTO_NAO_ENCONTRADO", "Evento não encontrado.", http.StatusNotFound)
+			return
+		}
+		log.Printf("ERRO: %v\n", err)
+		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
+		return
+	}
+
+	web.Respond(w, r, ConfiguracaoPerfilDTO{CamposEditaveis: config.Campos()}, http.StatusOK)
+}
+
+func (h *GuestHandler) HandleDefinirConfiguracaoPerfil(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	eventID, err := uuid.Parse(chi.URLParam(r, "idEvento"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do evento é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	var reqDTO ConfiguracaoPerfilDTO
+	if err := json.NewDecoder(r.Body).Decode(&reqDTO); err != nil {
+		web.RespondError(w, r, "CORPO_INVALIDO", "O corpo da requisição está malformado.", http.StatusBadRequest)
+		return
+	}
+
+	config, err := h.service.DefinirConfiguracaoPerfil(r.Context(), userID, eventID, reqDTO.CamposEditaveis)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrCampoPerfilInvalido):
+			web.RespondError(w, r, "DADOS_INVALIDOS", err.Error(), http.StatusBadRequest)
+		case errors.Is(err, domain.ErrEventoNaoEncontrado):
+			web.RespondError(w, r, "EVENTO_NAO_ENCONTRADO", "Evento não encontrado.", http.StatusNotFound)
+		default:
+			log.Printf("ERRO: %v\n", err)
+			web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	web.Respond(w, r, ConfiguracaoPerfilDTO{CamposEditaveis: config.Campos()}, http.StatusOK)
+}
+
+func (h *GuestHandler) HandleListarAlteracoesPerfil(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	eventID, err := uuid.Parse(chi.URLParam(r, "idEvento"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do evento é inválido.", http.StatusBadRequest)
+		return
+	}
+	filtro := domain.FiltroAlteracoesPerfil{SomentePendentes: r.URL.Query().Get("pendentes") == "true"}
+
+	alteracoes, err := h.service.ListarAlteracoesPerfil(r.Context(), userID, eventID, filtro)
+	if err != nil {
+		if errors.Is(err, domain.ErrEventoNaoEncontrado) {
+			web.RespondError(w, r, "EVENTO_NAO_ENCONTRADO", "Evento não encontrado.", http.StatusNotFound)
+			return
+		}
+		log.Printf("ERRO: %v\n", err)
+		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
+		return
+	}
+
+	alteracoesDTO := make([]AlteracaoPerfilDTO, len(alteracoes))
+	for i, a := range alteracoes {
+		alteracoesDTO[i] = toAlteracaoPerfilDTO(a)
+	}
+	web.Respond(w, r, ListarAlteracoesPerfilResponseDTO{Alteracoes: alteracoesDTO, Total: len(alteracoesDTO)}, http.StatusOK)
+}
+
+func (h *GuestHandler) HandleMarcarAlteracaoPerfilRevisada(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	alteracaoID, err := uuid.Parse(chi.URLParam(r, "idAlteracao"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID da alteração é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.MarcarAlteracaoPerfilRevisada(r.Context(), userID, alteracaoID); err != nil {
+		if errors.Is(err, domain.ErrAlteracaoPerfilNaoEncontrada) {
+			web.RespondError(w, r, "NAO_ENCONTRADO", err.Error(), http.StatusNotFound)
+			return
+		}
+		log.Printf("ERRO: %v\n", err)
+		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func toAlteracaoPerfilDTO(a domain.RegistroAlteracaoPerfil) AlteracaoPerfilDTO {
+	dto := AlteracaoPerfilDTO{
+		ID:            a.ID.String(),
+		IDGrupo:       a.IDGrupo.String(),
+		NomeConvidado: a.NomeConvidado,
+		Campo:         a.Campo,
+		ValorAnterior: a.ValorAnterior,
+		ValorNovo:     a.ValorNovo,
+		IP:            a.Origem.IP,
+		UserAgent:     a.Origem.UserAgent,
+		RegistradoEm:  a.RegistradoEm,
+		RevisadaEm:    a.RevisadaEm,
+	}
+	if a.IDConvidado != nil {
+		id := a.IDConvidado.String()
+		dto.IDConvidado = &id
+	}
+	return dto
+}
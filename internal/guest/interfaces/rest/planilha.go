@@ -0,0 +1,166 @@
+// file: internal/guest/interfaces/rest/planilha.go
+package rest
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/luiszkm/wedding_backend/internal/guest/domain"
+	"github.com/luiszkm/wedding_backend/internal/platform/relatorio"
+	"github.com/xuri/excelize/v2"
+)
+
+var (
+	errFormatoPlanilhaNaoSuportado = errors.New("formato de planilha não suportado (use .csv ou .xlsx)")
+	errCabecalhoPlanilhaInvalido   = errors.New("a planilha deve ter as colunas 'chave' e 'nome' no cabeçalho")
+)
+
+// Colunas reconhecidas no cabeçalho, já normalizadas (minúsculas, sem acentos e separadores).
+var aliasesColunas = map[string]string{
+	"chave":         "chave",
+	"chavedeacesso": "chave",
+	"chaveacesso":   "chave",
+	"grupo":         "chave",
+	"nome":          "nome",
+	"convidado":     "nome",
+	"nomeconvidado": "nome",
+	"telefone":      "telefone",
+	"celular":       "telefone",
+	"whatsapp":      "telefone",
+	"phone":         "telefone",
+	"email":         "email",
+	"faixaetaria":   "faixaEtaria",
+	"idade":         "faixaEtaria",
+	"lado":          "lado",
+	"observacoes":   "observacoes",
+	"observacao":    "observacoes",
+	"obs":           "observacoes",
+	"notas":         "observacoes",
+}
+
+var normalizadorCabecalho = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "é", "e", "ê", "e", "í", "i",
+	"ó", "o", "ô", "o", "õ", "o", "ú", "u", "ç", "c",
+	" ", "", "_", "", "-", "", ".", "",
+)
+
+// lerPlanilhaConvidados converte um arquivo CSV ou XLSX em linhas de importação.
+// O formato é decidido pela extensão do arquivo enviado.
+func lerPlanilhaConvidados(nomeArquivo string, arquivo io.Reader) ([]domain.LinhaImportacao, error) {
+	var registros [][]string
+	var err error
+
+	switch strings.ToLower(filepath.Ext(nomeArquivo)) {
+	case ".csv":
+		registros, err = lerRegistrosCSV(arquivo)
+	case ".xlsx":
+		registros, err = lerRegistrosXLSX(arquivo)
+	default:
+		return nil, errFormatoPlanilhaNaoSuportado
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(registros) == 0 {
+		return nil, errCabecalhoPlanilhaInvalido
+	}
+
+	indices := make(map[string]int)
+	for i, coluna := range registros[0] {
+		chave := normalizadorCabecalho.Replace(strings.ToLower(strings.TrimSpace(coluna)))
+		if campo, ok := aliasesColunas[chave]; ok {
+			if _, repetida := indices[campo]; !repetida {
+				indices[campo] = i
+			}
+		}
+	}
+	if _, ok := indices["chave"]; !ok {
+		return nil, errCabecalhoPlanilhaInvalido
+	}
+	if _, ok := indices["nome"]; !ok {
+		return nil, errCabecalhoPlanilhaInvalido
+	}
+
+	valor := func(registro []string, campo string) string {
+		i, ok := indices[campo]
+		if !ok || i >= len(registro) {
+			return ""
+		}
+		// O apóstrofo que a exportação põe diante de fórmulas não faz parte do valor.
+		return relatorio.RestaurarFormula(strings.TrimSpace(registro[i]))
+	}
+
+	var linhas []domain.LinhaImportacao
+	for i, registro := range registros[1:] {
+		if linhaVazia(registro) {
+			continue
+		}
+		linhas = append(linhas, domain.LinhaImportacao{
+			Numero:        i + 2, // +1 pelo cabeçalho, +1 porque planilhas começam na linha 1
+			ChaveDeAcesso: valor(registro, "chave"),
+			Nome:          valor(registro, "nome"),
+			Telefone:      valor(registro, "telefone"),
+			Email:         valor(registro, "email"),
+			FaixaEtaria:   valor(registro, "faixaEtaria"),
+			Lado:          valor(registro, "lado"),
+			Observacoes:   valor(registro, "observacoes"),
+		})
+	}
+	return linhas, nil
+}
+
+func lerRegistrosCSV(arquivo io.Reader) ([][]string, error) {
+	conteudo, err := io.ReadAll(arquivo)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao ler arquivo CSV: %w", err)
+	}
+	conteudo = bytes.TrimPrefix(conteudo, []byte("\xef\xbb\xbf")) // BOM gerado pelo Excel
+
+	leitor := csv.NewReader(bytes.NewReader(conteudo))
+	leitor.FieldsPerRecord = -1
+	leitor.TrimLeadingSpace = true
+	// O Excel em português exporta CSV separado por ponto e vírgula.
+	primeiraLinha, _, _ := bytes.Cut(conteudo, []byte("\n"))
+	if bytes.Count(primeiraLinha, []byte(";")) > bytes.Count(primeiraLinha, []byte(",")) {
+		leitor.Comma = ';'
+	}
+
+	registros, err := leitor.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("arquivo CSV inválido: %w", err)
+	}
+	return registros, nil
+}
+
+func lerRegistrosXLSX(arquivo io.Reader) ([][]string, error) {
+	planilha, err := excelize.OpenReader(arquivo)
+	if err != nil {
+		return nil, fmt.Errorf("arquivo XLSX inválido: %w", err)
+	}
+	defer planilha.Close()
+
+	abas := planilha.GetSheetList()
+	if len(abas) == 0 {
+		return nil, errCabecalhoPlanilhaInvalido
+	}
+	// Apenas a primeira aba é importada.
+	registros, err := planilha.GetRows(abas[0])
+	if err != nil {
+		return nil, fmt.Errorf("falha ao ler aba %q: %w", abas[0], err)
+	}
+	return registros, nil
+}
+
+func linhaVazia(registro []string) bool {
+	for _, campo := range registro {
+		if strings.TrimSpace(campo) != "" {
+			return false
+		}
+	}
+	return true
+}
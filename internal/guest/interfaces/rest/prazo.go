@@ -0,0 +1,169 @@
+// file: internal/guest/interfaces/rest/prazo.go
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/luiszkm/wedding_backend/internal/guest/domain"
+	"github.com/luiszkm/wedding_backend/internal/platform/auth"
+	"github.com/luiszkm/wedding_backend/internal/platform/web"
+)
+
+// HandleRegistrarRSVPAnfitriao permite ao anfitrião responder pelo grupo mesmo após o prazo.
+func (h *GuestHandler) HandleRegistrarRSVPAnfitriao(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	grupoID, err := uuid.Parse(chi.URLParam(r, "idGrupo"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do grupo é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	var reqDTO RegistrarRSVPAnfitriaoRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&reqDTO); err != nil {
+		web.RespondError(w, r, "CORPO_INVALIDO", "O corpo da requisição está malformado.", http.StatusBadRequest)
+		return
+	}
+	respostas, err := toRespostasRSVP(reqDTO.Respostas)
+	if err != nil {
+		web.RespondError(w, r, "DADOS_INVALIDOS", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = h.service.ConfirmarPresencaPeloAnfitriao(r.Context(), userID, grupoID, respostas, reqDTO.Acompanhantes, origemDaRequisicao(r))
+	if err != nil {
+		if errors.Is(err, domain.ErrGrupoNaoEncontrado) {
+			web.RespondError(w, r, "NAO_ENCONTRADO", "Grupo não encontrado.", http.StatusNotFound)
+			return
+		}
+		responderErroRSVP(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *GuestHandler) HandleEstenderPrazoRSVP(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	grupoID, err := uuid.Parse(chi.URLParam(r, "idGrupo"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do grupo é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	var reqDTO EstenderPrazoRSVPRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&reqDTO); err != nil {
+		web.RespondError(w, r, "CORPO_INVALIDO", "O corpo da requisição está malformado.", http.StatusBadRequest)
+		return
+	}
+
+	grupo, err := h.service.EstenderPrazoRSVPGrupo(r.Context(), userID, grupoID, reqDTO.PrazoRSVP)
+	if err != nil {
+		if errors.Is(err, domain.ErrGrupoNaoEncontrado) {
+			web.RespondError(w, r, "NAO_ENCONTRADO", "Grupo não encontrado.", http.StatusNotFound)
+			return
+		}
+		log.Printf("ERRO: %v\n", err)
+		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
+		return
+	}
+
+	web.Respond(w, r, EstenderPrazoRSVPRequestDTO{PrazoRSVP: grupo.PrazoRSVPEstendido()}, http.StatusOK)
+}
+
+func (h *GuestHandler) HandleListarRSVPsAtrasados(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	eventID, err := uuid.Parse(chi.URLParam(r, "idEvento"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do evento é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	relatorio, err := h.service.ListarRSVPsAtrasados(r.Context(), userID, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrEventoNaoEncontrado) {
+			web.RespondError(w, r, "EVENTO_NAO_ENCONTRADO", "Evento não encontrado.", http.StatusNotFound)
+			return
+		}
+		log.Printf("ERRO: %v\n", err)
+		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
+		return
+	}
+
+	gruposDTO := make([]GrupoAtrasadoDTO, len(relatorio.Atrasos))
+	for i, a := range relatorio.Atrasos {
+		pendentesDTO := make([]ConvidadoAdminDTO, len(a.ConvidadosPendentes))
+		for j, c := range a.ConvidadosPendentes {
+			pendentesDTO[j] = toConvidadoAdminDTO(c)
+		}
+		gruposDTO[i] = GrupoAtrasadoDTO{
+			ID:                  a.Grupo.ID().String(),
+			ChaveDeAcesso:       a.Grupo.ChaveDeAcesso(),
+			PrazoEfetivo:        a.PrazoEfetivo,
+			PrazoRSVPEstendido:  a.Grupo.PrazoRSVPEstendido(),
+			ConvidadosPendentes: pendentesDTO,
+			UltimaRespostaEm:    a.Grupo.UltimaRespostaEm(),
+			RespondeuAposPrazo:  a.RespondeuAposPrazo,
+		}
+	}
+
+	web.Respond(w, r, RSVPsAtrasadosResponseDTO{
+		PrazoRSVP: relatorio.PrazoRSVP,
+		GeradoEm:  relatorio.GeradoEm,
+		Grupos:    gruposDTO,
+		Total:     len(gruposDTO),
+	}, http.StatusOK)
+}
+
+func toRespostasRSVP(respostasDTO []RespostaRSVPDTO) ([]domain.RespostaRSVP, error) {
+	respostas := make([]domain.RespostaRSVP, len(respostasDTO))
+	for i, rsvpDTO := range respostasDTO {
+		convidadoID, err := uuid.Parse(rsvpDTO.IDConvidado)
+		if err != nil {
+			return nil, errors.New("ID de convidado inválido: " + rsvpDTO.IDConvidado)
+		}
+		respostasFormulario, err := toRespostasPergunta(rsvpDTO.RespostasFormulario)
+		if err != nil {
+			return nil, err
+		}
+		respostas[i] = domain.RespostaRSVP{
+			ConvidadoID:         convidadoID,
+			Status:              rsvpDTO.Status,
+			RespostasFormulario: respostasFormulario,
+		}
+	}
+	return respostas, nil
+}
+
+// responderErroRSVP traduz os erros de regra do RSVP, comuns ao convidado e ao anfitrião.
+func responderErroRSVP(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, domain.ErrStatusRSVPInvalido), errors.Is(err, domain.ErrConvidadoNaoEncontradoNoGrupo):
+		web.RespondError(w, r, "DADOS_INVALIDOS", err.Error(), http.StatusBadRequest)
+	case errors.Is(err, domain.ErrLimiteAcompanhantesExcedido), errors.Is(err, domain.ErrNomeAcompanhanteInvalido),
+		errors.Is(err, domain.ErrAcompanhantesSemConfirmacao):
+		web.RespondError(w, r, "ACOMPANHANTES_INVALIDOS", err.Error(), http.StatusBadRequest)
+	case errors.Is(err, domain.ErrPerguntaNaoEncontrada), errors.Is(err, domain.ErrPerguntaRepetida),
+		errors.Is(err, domain.ErrRespostaInvalida), errors.Is(err, domain.ErrRespostaObrigatoriaAusente):
+		web.RespondError(w, r, "RESPOSTAS_INVALIDAS", err.Error(), http.StatusBadRequest)
+	default:
+		log.Printf("ERRO: %v\n", err)
+		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,71 @@
+// file: internal/guest/interfaces/rest/relatorio_buffet.go
+package rest
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/luiszkm/wedding_backend/internal/guest/domain"
+	"github.com/luiszkm/wedding_backend/internal/platform/auth"
+	"github.com/luiszkm/wedding_backend/internal/platform/web"
+)
+
+func toContagemFaixaEtariaDTO(c domain.ContagemFaixaEtaria) ContagemFaixaEtariaDTO {
+	return ContagemFaixaEtariaDTO{Adultos: c.Adultos, Criancas: c.Criancas, NaoInformada: c.NaoInformada, Total: c.Total()}
+}
+
+func toResumoPerguntaBuffetDTO(resumo *domain.ResumoPerguntaBuffet) *ResumoPerguntaBuffetDTO {
+	if resumo == nil {
+		return nil
+	}
+	itens := make([]ItemBuffetDTO, len(resumo.Itens))
+	for i, item := range resumo.Itens {
+		itens[i] = ItemBuffetDTO{Opcao: item.Opcao, ContagemFaixaEtariaDTO: toContagemFaixaEtariaDTO(item.ContagemFaixaEtaria)}
+	}
+	return &ResumoPerguntaBuffetDTO{
+		IDPergunta:  resumo.IDPergunta.String(),
+		Texto:       resumo.Texto,
+		Itens:       itens,
+		SemResposta: toContagemFaixaEtariaDTO(resumo.SemResposta),
+	}
+}
+
+func (h *GuestHandler) HandleObterRelatorioBuffet(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	eventID, err := uuid.Parse(chi.URLParam(r, "idEvento"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do evento é inválido.", http.StatusBadRequest)
+		return
+	}
+	etiquetas, err := etiquetasDaQuery(r)
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	relatorio, err := h.service.ObterRelatorioBuffet(r.Context(), userID, eventID, etiquetas)
+	if err != nil {
+		if errors.Is(err, domain.ErrEventoNaoEncontrado) {
+			web.RespondError(w, r, "EVENTO_NAO_ENCONTRADO", "Evento não encontrado.", http.StatusNotFound)
+			return
+		}
+		log.Printf("ERRO: %v\n", err)
+		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
+		return
+	}
+
+	web.Respond(w, r, RelatorioBuffetDTO{
+		Convidados:            toContagemFaixaEtariaDTO(relatorio.Convidados),
+		Acompanhantes:         relatorio.Acompanhantes,
+		TotalPessoas:          relatorio.TotalPessoas(),
+		Prato:                 toResumoPerguntaBuffetDTO(relatorio.Prato),
+		RestricoesAlimentares: toResumoPerguntaBuffetDTO(relatorio.RestricoesAlimentares),
+	}, http.StatusOK)
+}
@@ -0,0 +1,112 @@
+// file: internal/guest/interfaces/rest/remocao_convidado.go
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/luiszkm/wedding_backend/internal/guest/domain"
+	"github.com/luiszkm/wedding_backend/internal/platform/auth"
+	"github.com/luiszkm/wedding_backend/internal/platform/web"
+)
+
+func (h *GuestHandler) HandleRemoverConvidado(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	grupoID, convidadoID, ok := parseGrupoEConvidado(w, r)
+	if !ok {
+		return
+	}
+
+	var reqDTO RemoverConvidadoRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&reqDTO); err != nil {
+		web.RespondError(w, r, "CORPO_INVALIDO", "O corpo da requisição está malformado.", http.StatusBadRequest)
+		return
+	}
+
+	grupo, err := h.service.RemoverConvidado(r.Context(), userID, grupoID, convidadoID, reqDTO.Motivo, origemDaRequisicao(r))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrGrupoNaoEncontrado):
+			web.RespondError(w, r, "NAO_ENCONTRADO", "Grupo não encontrado.", http.StatusNotFound)
+		case errors.Is(err, domain.ErrConvidadoNaoEncontradoNoGrupo):
+			web.RespondError(w, r, "NAO_ENCONTRADO", "Convidado não encontrado no grupo.", http.StatusNotFound)
+		case errors.Is(err, domain.ErrMotivoRemocaoInvalido), errors.Is(err, domain.ErrPeloMenosUmConvidado):
+			web.RespondError(w, r, "DADOS_INVALIDOS", err.Error(), http.StatusBadRequest)
+		default:
+			log.Printf("ERRO: %v\n", err)
+			web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	web.Respond(w, r, toGrupoDetalhadoDTO(grupo), http.StatusOK)
+}
+
+func (h *GuestHandler) HandleRestaurarConvidado(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	grupoID, convidadoID, ok := parseGrupoEConvidado(w, r)
+	if !ok {
+		return
+	}
+
+	grupo, err := h.service.RestaurarConvidado(r.Context(), userID, grupoID, convidadoID, origemDaRequisicao(r))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrGrupoNaoEncontrado):
+			web.RespondError(w, r, "NAO_ENCONTRADO", "Grupo não encontrado.", http.StatusNotFound)
+		case errors.Is(err, domain.ErrConvidadoRemovidoNaoEncontrado):
+			web.RespondError(w, r, "NAO_ENCONTRADO", err.Error(), http.StatusNotFound)
+		default:
+			log.Printf("ERRO: %v\n", err)
+			web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	web.Respond(w, r, toGrupoDetalhadoDTO(grupo), http.StatusOK)
+}
+
+// parseGrupoEConvidado lê os IDs da URL; em caso de erro, a resposta já foi enviada.
+func parseGrupoEConvidado(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	grupoID, err := uuid.Parse(chi.URLParam(r, "idGrupo"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do grupo é inválido.", http.StatusBadRequest)
+		return uuid.Nil, uuid.Nil, false
+	}
+	convidadoID, err := uuid.Parse(chi.URLParam(r, "idConvidado"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do convidado é inválido.", http.StatusBadRequest)
+		return uuid.Nil, uuid.Nil, false
+	}
+	return grupoID, convidadoID, true
+}
+
+func toConvidadosRemovidosDTO(removidos []*domain.ConvidadoRemovido) []ConvidadoRemovidoDTO {
+	dtos := make([]ConvidadoRemovidoDTO, len(removidos))
+	for i, removido := range removidos {
+		var removidoPor *string
+		if removido.RemovidoPor() != nil {
+			id := removido.RemovidoPor().String()
+			removidoPor = &id
+		}
+		dtos[i] = ConvidadoRemovidoDTO{
+			ConvidadoAdminDTO: toConvidadoAdminDTO(removido.Convidado()),
+			Motivo:            removido.Motivo(),
+			RemovidoEm:        removido.RemovidoEm(),
+			RemovidoPor:       removidoPor,
+		}
+	}
+	return dtos
+}
@@ -4,9 +4,11 @@ package rest
 import "time"
 
 // DeixarRecadoRequestDTO é o corpo da requisição para postar um recado.
+// Token, o do link de convite, substitui chave de acesso e evento.
 type DeixarRecadoRequestDTO struct {
 	IDEvento      string `json:"idEvento"`
 	ChaveDeAcesso string `json:"chaveDeAcesso"`
+	Token         string `json:"token"`
 	NomeDoAutor   string `json:"nomeDoAutor"`
 	Texto         string `json:"texto"`
 }
@@ -2,6 +2,7 @@
 package rest
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"log"
@@ -16,12 +17,19 @@ import (
 	"github.com/luiszkm/wedding_backend/internal/platform/web"
 )
 
+// ResolvedorDeAcesso troca o token do link de convite pelo evento e pela chave de acesso
+// do grupo; sem token, devolve o evento e a chave recebidos.
+type ResolvedorDeAcesso interface {
+	ResolverChaveDeAcesso(ctx context.Context, eventID uuid.UUID, chaveDeAcesso, token string) (uuid.UUID, string, error)
+}
+
 type MessageBoardHandler struct {
 	service *application.MessageBoardService
+	acessos ResolvedorDeAcesso
 }
 
-func NewMessageBoardHandler(service *application.MessageBoardService) *MessageBoardHandler {
-	return &MessageBoardHandler{service: service}
+func NewMessageBoardHandler(service *application.MessageBoardService, acessos ResolvedorDeAcesso) *MessageBoardHandler {
+	return &MessageBoardHandler{service: service, acessos: acessos}
 }
 
 func (h *MessageBoardHandler) HandleDeixarRecado(w http.ResponseWriter, r *http.Request) {
@@ -31,13 +39,24 @@ func (h *MessageBoardHandler) HandleDeixarRecado(w http.ResponseWriter, r *http.
 		return
 	}
 
-	eventID, err := uuid.Parse(reqDTO.IDEvento)
-	if err != nil {
-		web.RespondError(w, r, "DADOS_INVALIDOS", "ID do evento inválido: "+reqDTO.IDEvento, http.StatusBadRequest)
-		return
+	// Com o token do link, o evento é opcional.
+	eventID := uuid.Nil
+	if reqDTO.IDEvento != "" || reqDTO.Token == "" {
+		var err error
+		if eventID, err = uuid.Parse(reqDTO.IDEvento); err != nil {
+			web.RespondError(w, r, "DADOS_INVALIDOS", "ID do evento inválido: "+reqDTO.IDEvento, http.StatusBadRequest)
+			return
+		}
 	}
 
-	err = h.service.DeixarNovoRecado(r.Context(), eventID, reqDTO.ChaveDeAcesso, reqDTO.NomeDoAutor, reqDTO.Texto)
+	eventID, chaveDeAcesso, err := h.acessos.ResolverChaveDeAcesso(r.Context(), eventID, reqDTO.ChaveDeAcesso, reqDTO.Token)
+	if errors.Is(err, guestDomain.ErrLinkDeAcessoInvalido) {
+		web.RespondError(w, r, "LINK_INVALIDO", err.Error(), http.StatusUnauthorized)
+		return
+	}
+	if err == nil {
+		err = h.service.DeixarNovoRecado(r.Context(), eventID, chaveDeAcesso, reqDTO.NomeDoAutor, reqDTO.Texto)
+	}
 	if err != nil {
 		// Verifica se o erro foi porque a chave de acesso não foi encontrada.
 		if errors.Is(err, guestDomain.ErrGrupoNaoEncontrado) {
@@ -0,0 +1,81 @@
+// file: internal/pagetemplate/application/service.go
+package application
+
+import (
+	"context"
+	"fmt"
+
+	communicationDomain "github.com/luiszkm/wedding_backend/internal/communication/domain"
+	eventDomain "github.com/luiszkm/wedding_backend/internal/event/domain"
+	galleryDomain "github.com/luiszkm/wedding_backend/internal/gallery/domain"
+	giftDomain "github.com/luiszkm/wedding_backend/internal/gift/domain"
+	itineraryDomain "github.com/luiszkm/wedding_backend/internal/itinerary/domain"
+	mbDomain "github.com/luiszkm/wedding_backend/internal/messageboard/domain"
+	"github.com/luiszkm/wedding_backend/internal/pagetemplate/domain"
+)
+
+// PageTemplateService monta a página pública de um evento reunindo dados de vários contextos.
+type PageTemplateService struct {
+	eventRepo      eventDomain.EventoRepository
+	presenteRepo   giftDomain.PresenteRepository
+	recadoRepo     mbDomain.RecadoRepository
+	fotoRepo       galleryDomain.FotoRepository
+	roteiroRepo    itineraryDomain.ItineraryRepository
+	comunicadoRepo communicationDomain.ComunicadoRepository
+}
+
+func NewPageTemplateService(
+	eventRepo eventDomain.EventoRepository,
+	presenteRepo giftDomain.PresenteRepository,
+	recadoRepo mbDomain.RecadoRepository,
+	fotoRepo galleryDomain.FotoRepository,
+	roteiroRepo itineraryDomain.ItineraryRepository,
+	comunicadoRepo communicationDomain.ComunicadoRepository,
+) *PageTemplateService {
+	return &PageTemplateService{
+		eventRepo:      eventRepo,
+		presenteRepo:   presenteRepo,
+		recadoRepo:     recadoRepo,
+		fotoRepo:       fotoRepo,
+		roteiroRepo:    roteiroRepo,
+		comunicadoRepo: comunicadoRepo,
+	}
+}
+
+// MontarPaginaDoEvento resolve o template do evento e carrega todo o conteúdo público da página.
+func (s *PageTemplateService) MontarPaginaDoEvento(ctx context.Context, urlSlug string) (*domain.EventPageData, domain.TemplateMetadata, error) {
+	evento, err := s.eventRepo.FindBySlug(ctx, urlSlug)
+	if err != nil {
+		return nil, domain.TemplateMetadata{}, fmt.Errorf("falha ao buscar evento da página: %w", err)
+	}
+
+	tmpl := domain.ResolverTemplate(evento.IDTemplate(), evento.IDTemplateArquivo())
+
+	presentes, err := s.presenteRepo.ListarDisponiveisPorCasamento(ctx, evento.ID())
+	if err != nil {
+		return nil, tmpl, fmt.Errorf("falha ao buscar presentes da página: %w", err)
+	}
+	recados, err := s.recadoRepo.ListarAprovadosPorCasamento(ctx, evento.ID())
+	if err != nil {
+		return nil, tmpl, fmt.Errorf("falha ao buscar recados da página: %w", err)
+	}
+	fotos, err := s.fotoRepo.ListarPublicasPorCasamento(ctx, evento.ID(), "")
+	if err != nil {
+		return nil, tmpl, fmt.Errorf("falha ao buscar fotos da página: %w", err)
+	}
+	roteiro, err := s.roteiroRepo.FindByEventID(ctx, evento.ID())
+	if err != nil {
+		return nil, tmpl, fmt.Errorf("falha ao buscar roteiro da página: %w", err)
+	}
+	comunicados, err := s.comunicadoRepo.BuscarPorEvento(ctx, evento.ID())
+	if err != nil {
+		return nil, tmpl, fmt.Errorf("falha ao buscar comunicados da página: %w", err)
+	}
+
+	return domain.NewEventPageData(evento, tmpl, presentes, recados, fotos, roteiro, comunicados), tmpl, nil
+}
+
+// ListarTemplatesDisponiveis retorna o catálogo de templates standard que o dono do evento pode escolher.
+func (s *PageTemplateService) ListarTemplatesDisponiveis() []domain.TemplateMetadata {
+	return domain.ListarTemplatesPadrao()
+}
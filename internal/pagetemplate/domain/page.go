@@ -0,0 +1,77 @@
+// file: internal/pagetemplate/domain/page.go
+package domain
+
+import (
+	communicationDomain "github.com/luiszkm/wedding_backend/internal/communication/domain"
+	eventDomain "github.com/luiszkm/wedding_backend/internal/event/domain"
+	galleryDomain "github.com/luiszkm/wedding_backend/internal/gallery/domain"
+	giftDomain "github.com/luiszkm/wedding_backend/internal/gift/domain"
+	itineraryDomain "github.com/luiszkm/wedding_backend/internal/itinerary/domain"
+	mbDomain "github.com/luiszkm/wedding_backend/internal/messageboard/domain"
+)
+
+// ContactInfo são os dados de contato exibidos no rodapé, quando disponíveis.
+type ContactInfo struct {
+	Nome     string
+	Email    string
+	Telefone string
+}
+
+// EventPageData é o conjunto de dados entregue aos templates de página pública.
+// Grupos de convidados não são expostos aqui, pois carregam as chaves de acesso.
+type EventPageData struct {
+	Event         *eventDomain.Evento
+	Gifts         []*giftDomain.Presente
+	Messages      []*mbDomain.Recado // apenas recados aprovados
+	Photos        []*galleryDomain.Foto
+	Itinerary     []*itineraryDomain.ItineraryItem
+	Announcements []*communicationDomain.Comunicado
+	PaletaCores   eventDomain.PaletaCores
+
+	// Flags de controle: a seção só aparece se o template suporta e há conteúdo.
+	ShowGifts         bool
+	ShowGallery       bool
+	ShowMessages      bool
+	ShowRSVP          bool
+	ShowItinerary     bool
+	ShowAnnouncements bool
+
+	Contact    *ContactInfo
+	CustomData map[string]interface{}
+}
+
+// NewEventPageData monta os dados da página e calcula as flags de exibição a partir
+// do template escolhido. Cores ausentes na paleta do evento vêm da paleta do template.
+func NewEventPageData(
+	evento *eventDomain.Evento,
+	tmpl TemplateMetadata,
+	presentes []*giftDomain.Presente,
+	recados []*mbDomain.Recado,
+	fotos []*galleryDomain.Foto,
+	roteiro []*itineraryDomain.ItineraryItem,
+	comunicados []*communicationDomain.Comunicado,
+) *EventPageData {
+	aprovados := make([]*mbDomain.Recado, 0, len(recados))
+	for _, r := range recados {
+		if r.Status() == mbDomain.StatusAprovado {
+			aprovados = append(aprovados, r)
+		}
+	}
+
+	return &EventPageData{
+		Event:             evento,
+		Gifts:             presentes,
+		Messages:          aprovados,
+		Photos:            fotos,
+		Itinerary:         roteiro,
+		Announcements:     comunicados,
+		PaletaCores:       evento.PaletaCores().ComFallback(tmpl.PaletaDefault),
+		ShowGifts:         tmpl.SuportaGifts && len(presentes) > 0,
+		ShowGallery:       tmpl.SuportaGallery && len(fotos) > 0,
+		ShowMessages:      tmpl.SuportaMessages && len(aprovados) > 0,
+		ShowRSVP:          tmpl.SuportaRSVP,
+		ShowItinerary:     len(roteiro) > 0,
+		ShowAnnouncements: len(comunicados) > 0,
+		CustomData:        map[string]interface{}{},
+	}
+}
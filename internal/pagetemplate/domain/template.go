@@ -0,0 +1,138 @@
+// file: internal/pagetemplate/domain/template.go
+package domain
+
+import (
+	"errors"
+	"path"
+	"strings"
+
+	eventDomain "github.com/luiszkm/wedding_backend/internal/event/domain"
+)
+
+const (
+	TipoTemplateStandard = "STANDARD"
+	TipoTemplateBespoke  = "BESPOKE"
+
+	diretorioStandard = "standard"
+	diretorioBespoke  = "bespoke"
+)
+
+var ErrTemplateNaoEncontrado = errors.New("template não encontrado")
+
+// TemplateMetadata descreve um template disponível e o que ele é capaz de exibir.
+type TemplateMetadata struct {
+	ID              string
+	Nome            string
+	Descricao       string
+	Tipo            string
+	Arquivo         string // caminho relativo à raiz de templates, ex: "standard/template_moderno.html"
+	PaletaDefault   eventDomain.PaletaCores
+	SuportaGifts    bool
+	SuportaGallery  bool
+	SuportaMessages bool
+	SuportaRSVP     bool
+}
+
+// templatesPadrao é o catálogo de templates standard. A ordem é a de exibição na API.
+var templatesPadrao = []TemplateMetadata{
+	{
+		ID:        "template_moderno",
+		Nome:      "Moderno",
+		Descricao: "Template moderno e minimalista",
+		PaletaDefault: eventDomain.PaletaCores{
+			eventDomain.CorPrimary:    "#2563eb",
+			eventDomain.CorSecondary:  "#f1f5f9",
+			eventDomain.CorAccent:     "#10b981",
+			eventDomain.CorBackground: "#ffffff",
+			eventDomain.CorText:       "#1f2937",
+		},
+	},
+	{
+		ID:        "template_classico",
+		Nome:      "Clássico",
+		Descricao: "Design tradicional com tipografia serifada, ideal para eventos formais",
+		PaletaDefault: eventDomain.PaletaCores{
+			eventDomain.CorPrimary:    "#8b5a3c",
+			eventDomain.CorSecondary:  "#f5f5dc",
+			eventDomain.CorAccent:     "#d4af37",
+			eventDomain.CorBackground: "#fdfdf8",
+			eventDomain.CorText:       "#2c1810",
+		},
+	},
+	{
+		ID:        "template_elegante",
+		Nome:      "Elegante",
+		Descricao: "Design sofisticado com fundo escuro e acentos vibrantes",
+		PaletaDefault: eventDomain.PaletaCores{
+			eventDomain.CorPrimary:    "#1a1a2e",
+			eventDomain.CorSecondary:  "#16213e",
+			eventDomain.CorAccent:     "#e94560",
+			eventDomain.CorBackground: "#0f0f23",
+			eventDomain.CorText:       "#ffffff",
+		},
+	},
+}
+
+func init() {
+	// Todos os templates standard suportam todas as seções.
+	for i := range templatesPadrao {
+		t := &templatesPadrao[i]
+		t.Tipo = TipoTemplateStandard
+		t.Arquivo = path.Join(diretorioStandard, t.ID+".html")
+		t.SuportaGifts, t.SuportaGallery, t.SuportaMessages, t.SuportaRSVP = true, true, true, true
+	}
+}
+
+// ListarTemplatesPadrao retorna uma cópia do catálogo de templates standard.
+func ListarTemplatesPadrao() []TemplateMetadata {
+	lista := make([]TemplateMetadata, len(templatesPadrao))
+	for i, t := range templatesPadrao {
+		lista[i] = t
+		lista[i].PaletaDefault = t.PaletaDefault.Clone()
+	}
+	return lista
+}
+
+// BuscarTemplatePadrao retorna os metadados de um template standard pelo ID.
+func BuscarTemplatePadrao(id string) (TemplateMetadata, error) {
+	for _, t := range templatesPadrao {
+		if t.ID == id {
+			t.PaletaDefault = t.PaletaDefault.Clone()
+			return t, nil
+		}
+	}
+	return TemplateMetadata{}, ErrTemplateNaoEncontrado
+}
+
+// TemplatePadrao é o fallback final da lógica de precedência.
+func TemplatePadrao() TemplateMetadata {
+	t, _ := BuscarTemplatePadrao(eventDomain.IDTemplatePadrao)
+	return t
+}
+
+// ResolverTemplate aplica a precedência da ADR-002: template bespoke (id_template_arquivo)
+// primeiro, depois o template standard (id_template) e, por fim, o template padrão.
+func ResolverTemplate(idTemplate string, idTemplateArquivo *string) TemplateMetadata {
+	if idTemplateArquivo != nil {
+		// Apenas o nome do arquivo é aceito; qualquer diretório informado é descartado.
+		arquivo := path.Base(strings.TrimSpace(*idTemplateArquivo))
+		if arquivo != "." && arquivo != "/" && path.Ext(arquivo) == ".html" {
+			return TemplateMetadata{
+				ID:              strings.TrimSuffix(arquivo, ".html"),
+				Nome:            arquivo,
+				Tipo:            TipoTemplateBespoke,
+				Arquivo:         path.Join(diretorioBespoke, arquivo),
+				PaletaDefault:   eventDomain.PaletaCoresPadrao(),
+				SuportaGifts:    true,
+				SuportaGallery:  true,
+				SuportaMessages: true,
+				SuportaRSVP:     true,
+			}
+		}
+	}
+
+	if t, err := BuscarTemplatePadrao(idTemplate); err == nil {
+		return t
+	}
+	return TemplatePadrao()
+}
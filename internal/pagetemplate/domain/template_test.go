@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	eventDomain "github.com/luiszkm/wedding_backend/internal/event/domain"
+	mbDomain "github.com/luiszkm/wedding_backend/internal/messageboard/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolverTemplate(t *testing.T) {
+	t.Run("deve priorizar o template bespoke quando informado", func(t *testing.T) {
+		arquivo := "casamento_joao_maria.html"
+
+		tmpl := ResolverTemplate("template_classico", &arquivo)
+
+		assert.Equal(t, TipoTemplateBespoke, tmpl.Tipo)
+		assert.Equal(t, "bespoke/casamento_joao_maria.html", tmpl.Arquivo)
+	})
+
+	t.Run("deve descartar diretórios do arquivo bespoke", func(t *testing.T) {
+		arquivo := "../../etc/passwd.html"
+
+		tmpl := ResolverTemplate("", &arquivo)
+
+		assert.Equal(t, "bespoke/passwd.html", tmpl.Arquivo)
+	})
+
+	t.Run("deve ignorar arquivo bespoke sem extensão html", func(t *testing.T) {
+		arquivo := "template.txt"
+
+		tmpl := ResolverTemplate("template_elegante", &arquivo)
+
+		assert.Equal(t, TipoTemplateStandard, tmpl.Tipo)
+		assert.Equal(t, "template_elegante", tmpl.ID)
+	})
+
+	t.Run("deve usar o template standard escolhido", func(t *testing.T) {
+		tmpl := ResolverTemplate("template_classico", nil)
+
+		assert.Equal(t, "standard/template_classico.html", tmpl.Arquivo)
+		assert.True(t, tmpl.SuportaRSVP)
+	})
+
+	t.Run("deve cair no template padrão quando o ID é desconhecido", func(t *testing.T) {
+		tmpl := ResolverTemplate("template_inexistente", nil)
+
+		assert.Equal(t, eventDomain.IDTemplatePadrao, tmpl.ID)
+	})
+}
+
+func TestListarTemplatesPadrao(t *testing.T) {
+	t.Run("deve retornar cópias independentes das paletas", func(t *testing.T) {
+		lista := ListarTemplatesPadrao()
+		lista[0].PaletaDefault[eventDomain.CorPrimary] = "#000000"
+
+		original, err := BuscarTemplatePadrao(lista[0].ID)
+
+		assert.NoError(t, err)
+		assert.NotEqual(t, "#000000", original.PaletaDefault[eventDomain.CorPrimary])
+	})
+
+	t.Run("deve retornar erro para template desconhecido", func(t *testing.T) {
+		_, err := BuscarTemplatePadrao("nao_existe")
+		assert.ErrorIs(t, err, ErrTemplateNaoEncontrado)
+	})
+}
+
+func TestNewEventPageData(t *testing.T) {
+	evento, _ := eventDomain.NewEvento(uuid.New(), "Casamento", time.Now(), eventDomain.TipoCasamento, "casamento")
+	tmpl := TemplatePadrao()
+
+	t.Run("deve exibir apenas recados aprovados", func(t *testing.T) {
+		aprovado := mbDomain.HydrateRecado(uuid.New(), evento.ID(), uuid.New(), "Ana", "Parabéns", mbDomain.StatusAprovado, false, time.Now())
+		pendente := mbDomain.HydrateRecado(uuid.New(), evento.ID(), uuid.New(), "Bia", "Oi", mbDomain.StatusPendente, false, time.Now())
+
+		data := NewEventPageData(evento, tmpl, nil, []*mbDomain.Recado{aprovado, pendente}, nil, nil, nil)
+
+		assert.Len(t, data.Messages, 1)
+		assert.True(t, data.ShowMessages)
+	})
+
+	t.Run("deve ocultar seções sem conteúdo", func(t *testing.T) {
+		pendente := mbDomain.HydrateRecado(uuid.New(), evento.ID(), uuid.New(), "Bia", "Oi", mbDomain.StatusPendente, false, time.Now())
+
+		data := NewEventPageData(evento, tmpl, nil, []*mbDomain.Recado{pendente}, nil, nil, nil)
+
+		assert.False(t, data.ShowGifts)
+		assert.False(t, data.ShowGallery)
+		assert.False(t, data.ShowMessages)
+		assert.False(t, data.ShowItinerary)
+		assert.False(t, data.ShowAnnouncements)
+		assert.True(t, data.ShowRSVP)
+	})
+
+	t.Run("deve completar a paleta do evento com a do template", func(t *testing.T) {
+		classico, _ := BuscarTemplatePadrao("template_classico")
+		customizado := eventDomain.HydrateEvento(evento.ID(), evento.IDUsuario(), evento.Nome(), evento.Data(), evento.Tipo(), evento.UrlSlug(),
+			"template_classico", nil, eventDomain.PaletaCores{eventDomain.CorPrimary: "#123456"}, nil)
+
+		data := NewEventPageData(customizado, classico, nil, nil, nil, nil, nil)
+
+		assert.Equal(t, "#123456", data.PaletaCores[eventDomain.CorPrimary])
+		assert.Equal(t, classico.PaletaDefault[eventDomain.CorAccent], data.PaletaCores[eventDomain.CorAccent])
+	})
+}
@@ -0,0 +1,19 @@
+// file: internal/pagetemplate/interfaces/rest/dto.go
+package rest
+
+type TemplateDisponivelDTO struct {
+	ID              string            `json:"id"`
+	Nome            string            `json:"nome"`
+	Descricao       string            `json:"descricao"`
+	Tipo            string            `json:"tipo"`
+	PaletaDefault   map[string]string `json:"paleta_default"`
+	SuportaGifts    bool              `json:"suporta_gifts"`
+	SuportaGallery  bool              `json:"suporta_gallery"`
+	SuportaMessages bool              `json:"suporta_messages"`
+	SuportaRSVP     bool              `json:"suporta_rsvp"`
+}
+
+type ListaTemplatesResponseDTO struct {
+	Templates []TemplateDisponivelDTO `json:"templates"`
+	Total     int                     `json:"total"`
+}
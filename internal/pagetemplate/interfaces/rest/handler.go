@@ -0,0 +1,81 @@
+// file: internal/pagetemplate/interfaces/rest/handler.go
+package rest
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	eventDomain "github.com/luiszkm/wedding_backend/internal/event/domain"
+	"github.com/luiszkm/wedding_backend/internal/pagetemplate/application"
+	"github.com/luiszkm/wedding_backend/internal/pagetemplate/domain"
+	"github.com/luiszkm/wedding_backend/internal/platform/template"
+	"github.com/luiszkm/wedding_backend/internal/platform/web"
+)
+
+type PageTemplateHandler struct {
+	service *application.PageTemplateService
+	engine  *template.GoTemplateEngine
+}
+
+func NewPageTemplateHandler(service *application.PageTemplateService, engine *template.GoTemplateEngine) *PageTemplateHandler {
+	return &PageTemplateHandler{service: service, engine: engine}
+}
+
+func (h *PageTemplateHandler) HandleRenderizarPagina(w http.ResponseWriter, r *http.Request) {
+	urlSlug := chi.URLParam(r, "urlSlug")
+	if urlSlug == "" {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O slug da URL é obrigatório.", http.StatusBadRequest)
+		return
+	}
+
+	data, tmpl, err := h.service.MontarPaginaDoEvento(r.Context(), urlSlug)
+	if err != nil {
+		if errors.Is(err, eventDomain.ErrEventoNaoEncontrado) {
+			web.RespondError(w, r, "EVENTO_NAO_ENCONTRADO", "Evento não encontrado.", http.StatusNotFound)
+			return
+		}
+		log.Printf("ERRO ao montar página do evento %s: %v", urlSlug, err)
+		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao montar a página do evento.", http.StatusInternalServerError)
+		return
+	}
+
+	html, err := h.engine.RenderEventPage(tmpl.Arquivo, data)
+	// Um template bespoke ausente no servidor não deve derrubar a página: caímos no template padrão.
+	if err != nil && tmpl.Tipo == domain.TipoTemplateBespoke &&
+		(errors.Is(err, template.ErrTemplateNaoEncontrado) || errors.Is(err, template.ErrNomeTemplateInvalido)) {
+		log.Printf("AVISO: template bespoke %s indisponível para o evento %s, usando o padrão: %v", tmpl.Arquivo, urlSlug, err)
+		html, err = h.engine.RenderEventPage(domain.TemplatePadrao().Arquivo, data)
+	}
+	if err != nil {
+		log.Printf("ERRO ao renderizar template %s do evento %s: %v", tmpl.Arquivo, urlSlug, err)
+		web.RespondError(w, r, "ERRO_RENDERIZACAO", "Falha ao renderizar a página do evento.", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	w.WriteHeader(http.StatusOK)
+	w.Write(html)
+}
+
+func (h *PageTemplateHandler) HandleListarTemplatesDisponiveis(w http.ResponseWriter, r *http.Request) {
+	templates := h.service.ListarTemplatesDisponiveis()
+
+	respDTO := make([]TemplateDisponivelDTO, 0, len(templates))
+	for _, t := range templates {
+		respDTO = append(respDTO, TemplateDisponivelDTO{
+			ID:              t.ID,
+			Nome:            t.Nome,
+			Descricao:       t.Descricao,
+			Tipo:            t.Tipo,
+			PaletaDefault:   t.PaletaDefault,
+			SuportaGifts:    t.SuportaGifts,
+			SuportaGallery:  t.SuportaGallery,
+			SuportaMessages: t.SuportaMessages,
+			SuportaRSVP:     t.SuportaRSVP,
+		})
+	}
+	web.Respond(w, r, ListaTemplatesResponseDTO{Templates: respDTO, Total: len(respDTO)}, http.StatusOK)
+}
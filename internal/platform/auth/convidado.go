@@ -0,0 +1,91 @@
+// file: internal/platform/auth/convidado.go
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// AudienciaConvidado identifica os tokens dos links de convite. Eles usam a mesma
+// chave secreta dos tokens de usuário, que por isso recusam qualquer audiência.
+const AudienciaConvidado = "convidado"
+
+var ErrTokenConvidadoInvalido = errors.New("token de convidado inválido ou expirado")
+
+// TokenConvidado são os dados de um token de convidado já validado.
+type TokenConvidado struct {
+	IDGrupo   uuid.UUID
+	IDEvento  uuid.UUID
+	ExpiraEm  time.Time
+	impressao string
+}
+
+// GerarTokenConvidado cria o token de acesso de um grupo, válido pelo prazo informado.
+// O token guarda uma impressão da chave de acesso, e não a chave: trocar a chave do
+// grupo invalida os links emitidos antes.
+func (s *JWTService) GerarTokenConvidado(idGrupo, idEvento uuid.UUID, chaveDeAcesso string, validade time.Duration) (string, time.Time, error) {
+	agora := time.Now()
+	expiraEm := agora.Add(validade)
+	claims := jwt.MapClaims{
+		"sub": idGrupo.String(),
+		"aud": AudienciaConvidado,
+		"evt": idEvento.String(),
+		"chv": s.impressaoChave(idGrupo, chaveDeAcesso),
+		"exp": expiraEm.Unix(),
+		"iat": agora.Unix(),
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.secretKey)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("falha ao assinar o token de convidado: %w", err)
+	}
+	return token, expiraEm, nil
+}
+
+// ValidarTokenConvidado confere assinatura, audiência e expiração. Qualquer falha
+// devolve ErrTokenConvidadoInvalido.
+func (s *JWTService) ValidarTokenConvidado(tokenString string) (*TokenConvidado, error) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		return s.secretKey, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithAudience(AudienciaConvidado), jwt.WithExpirationRequired())
+	if err != nil || !token.Valid {
+		return nil, ErrTokenConvidadoInvalido
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, ErrTokenConvidadoInvalido
+	}
+	sub, _ := claims["sub"].(string)
+	evt, _ := claims["evt"].(string)
+	impressao, _ := claims["chv"].(string)
+	idGrupo, errGrupo := uuid.Parse(sub)
+	idEvento, errEvento := uuid.Parse(evt)
+	if errGrupo != nil || errEvento != nil || impressao == "" {
+		return nil, ErrTokenConvidadoInvalido
+	}
+	expiraEm, err := claims.GetExpirationTime()
+	if err != nil {
+		return nil, ErrTokenConvidadoInvalido
+	}
+	return &TokenConvidado{IDGrupo: idGrupo, IDEvento: idEvento, ExpiraEm: expiraEm.Time, impressao: impressao}, nil
+}
+
+// ConfereChave diz se o token foi emitido para a chave de acesso atual do grupo.
+func (s *JWTService) ConfereChave(token *TokenConvidado, chaveDeAcesso string) bool {
+	return hmac.Equal([]byte(token.impressao), []byte(s.impressaoChave(token.IDGrupo, chaveDeAcesso)))
+}
+
+// impressaoChave usa HMAC com o segredo dos tokens: como o conteúdo do JWT é legível,
+// um hash simples permitiria descobrir chaves curtas por força bruta.
+func (s *JWTService) impressaoChave(idGrupo uuid.UUID, chaveDeAcesso string) string {
+	mac := hmac.New(sha256.New, s.secretKey)
+	mac.Write([]byte(idGrupo.String() + ":" + chaveDeAcesso))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil)[:16])
+}
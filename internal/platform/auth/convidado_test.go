@@ -0,0 +1,104 @@
+// file: internal/platform/auth/convidado_test.go
+package auth
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidarTokenConvidado(t *testing.T) {
+	svc := NewJWTService("segredo-de-teste")
+	idGrupo, idEvento := uuid.New(), uuid.New()
+
+	t.Run("deve devolver o grupo e o evento de um token válido", func(t *testing.T) {
+		token, expiraEm, err := svc.GerarTokenConvidado(idGrupo, idEvento, "FAMILIA-SILVA", time.Hour)
+		require.NoError(t, err)
+
+		dados, err := svc.ValidarTokenConvidado(token)
+
+		require.NoError(t, err)
+		assert.Equal(t, idGrupo, dados.IDGrupo)
+		assert.Equal(t, idEvento, dados.IDEvento)
+		assert.Equal(t, expiraEm.Unix(), dados.ExpiraEm.Unix())
+		assert.True(t, svc.ConfereChave(dados, "FAMILIA-SILVA"))
+	})
+
+	t.Run("deve recusar o token de usuário onde se espera o de convidado", func(t *testing.T) {
+		token, err := svc.GenerateToken(uuid.New())
+		require.NoError(t, err)
+
+		_, err = svc.ValidarTokenConvidado(token)
+
+		assert.ErrorIs(t, err, ErrTokenConvidadoInvalido)
+	})
+
+	t.Run("deve recusar o token de convidado nas rotas de usuário", func(t *testing.T) {
+		token, _, err := svc.GerarTokenConvidado(idGrupo, idEvento, "FAMILIA-SILVA", time.Hour)
+		require.NoError(t, err)
+
+		_, err = svc.ValidateToken(token)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("deve recusar o token expirado", func(t *testing.T) {
+		token, _, err := svc.GerarTokenConvidado(idGrupo, idEvento, "FAMILIA-SILVA", -time.Minute)
+		require.NoError(t, err)
+
+		_, err = svc.ValidarTokenConvidado(token)
+
+		assert.ErrorIs(t, err, ErrTokenConvidadoInvalido)
+	})
+
+	t.Run("deve recusar o token adulterado", func(t *testing.T) {
+		token, _, err := svc.GerarTokenConvidado(idGrupo, idEvento, "FAMILIA-SILVA", time.Hour)
+		require.NoError(t, err)
+		partes := strings.Split(token, ".")
+		require.Len(t, partes, 3)
+		payload, err := base64.RawURLEncoding.DecodeString(partes[1])
+		require.NoError(t, err)
+
+		// Troca o evento mantendo a assinatura original.
+		outroEvento := strings.Replace(string(payload), idEvento.String(), uuid.NewString(), 1)
+		adulterado := partes[0] + "." + base64.RawURLEncoding.EncodeToString([]byte(outroEvento)) + "." + partes[2]
+
+		_, err = svc.ValidarTokenConvidado(adulterado)
+
+		assert.ErrorIs(t, err, ErrTokenConvidadoInvalido)
+	})
+
+	t.Run("deve recusar o token assinado com outro segredo", func(t *testing.T) {
+		token, _, err := NewJWTService("outro-segredo").GerarTokenConvidado(idGrupo, idEvento, "FAMILIA-SILVA", time.Hour)
+		require.NoError(t, err)
+
+		_, err = svc.ValidarTokenConvidado(token)
+
+		assert.ErrorIs(t, err, ErrTokenConvidadoInvalido)
+	})
+}
+
+func TestConfereChave(t *testing.T) {
+	svc := NewJWTService("segredo-de-teste")
+	idGrupo := uuid.New()
+	token, _, err := svc.GerarTokenConvidado(idGrupo, uuid.New(), "FAMILIA-SILVA", time.Hour)
+	require.NoError(t, err)
+	dados, err := svc.ValidarTokenConvidado(token)
+	require.NoError(t, err)
+
+	t.Run("deve deixar de conferir depois que a chave é regenerada", func(t *testing.T) {
+		assert.False(t, svc.ConfereChave(dados, "K7Q2-MX9P-4TWA"))
+	})
+
+	t.Run("não deve conferir a mesma chave em outro grupo", func(t *testing.T) {
+		outroGrupo := *dados
+		outroGrupo.IDGrupo = uuid.New()
+
+		assert.False(t, svc.ConfereChave(&outroGrupo, "FAMILIA-SILVA"))
+	})
+}
@@ -56,6 +56,10 @@ func (s *JWTService) ValidateToken(tokenString string) (uuid.UUID, error) {
 	}
 
 	if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
+		// Tokens com audiência, como os de convidado, não dão acesso às rotas de usuário.
+		if _, temAudiencia := claims["aud"]; temAudiencia {
+			return uuid.Nil, fmt.Errorf("token sem permissão para acesso de usuário")
+		}
 		userIDStr, ok := claims["sub"].(string)
 		if !ok {
 			return uuid.Nil, fmt.Errorf("claim 'sub' inválida no token")
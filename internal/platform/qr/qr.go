@@ -0,0 +1,86 @@
+// file: internal/platform/qr/qr.go
+package qr
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/skip2/go-qrcode"
+)
+
+// Formatos e tamanhos aceitos pelos endpoints de QR code. O tamanho, em pixels, vale só para PNG.
+const (
+	FormatoPNG    = "png"
+	FormatoSVG    = "svg"
+	TamanhoPadrao = 256
+	TamanhoMinimo = 128
+	TamanhoMaximo = 1024
+)
+
+// O nível médio de correção ainda lê o código com a impressão um pouco borrada.
+const NivelCorrecao = qrcode.Medium
+
+// ParametrosDaQuery lê formato e tamanho da query string, com PNG de TamanhoPadrao
+// quando omitidos. O erro já vem no texto mostrado ao cliente.
+func ParametrosDaQuery(r *http.Request) (string, int, error) {
+	formato := r.URL.Query().Get("formato")
+	if formato == "" {
+		formato = FormatoPNG
+	}
+	if formato != FormatoPNG && formato != FormatoSVG {
+		return "", 0, errors.New("Formato inválido. Use png ou svg.")
+	}
+	tamanho := TamanhoPadrao
+	if valor := r.URL.Query().Get("tamanho"); valor != "" {
+		var err error
+		tamanho, err = strconv.Atoi(valor)
+		if err != nil || tamanho < TamanhoMinimo || tamanho > TamanhoMaximo {
+			return "", 0, fmt.Errorf("O tamanho deve ser um número entre %d e %d.", TamanhoMinimo, TamanhoMaximo)
+		}
+	}
+	return formato, tamanho, nil
+}
+
+// Gerar desenha o QR code do conteúdo no formato pedido e devolve a imagem com o
+// Content-Type correspondente.
+func Gerar(conteudo, formato string, tamanho int) ([]byte, string, error) {
+	qr, err := qrcode.New(conteudo, NivelCorrecao)
+	if err != nil {
+		return nil, "", fmt.Errorf("falha ao gerar o QR code: %w", err)
+	}
+	var buf bytes.Buffer
+	if formato == FormatoSVG {
+		if err := escreverSVG(&buf, qr); err != nil {
+			return nil, "", fmt.Errorf("falha ao gerar o QR code em SVG: %w", err)
+		}
+		return buf.Bytes(), "image/svg+xml", nil
+	}
+	if err := qr.Write(tamanho, &buf); err != nil {
+		return nil, "", fmt.Errorf("falha ao gerar o QR code em PNG: %w", err)
+	}
+	return buf.Bytes(), "image/png", nil
+}
+
+// escreverSVG desenha cada módulo escuro como um quadrado de 1 unidade; o viewBox
+// deixa o SVG escalar sem perder nitidez. A margem de 4 módulos já vem em Bitmap.
+func escreverSVG(w io.Writer, qr *qrcode.QRCode) error {
+	modulos := qr.Bitmap()
+	var caminho strings.Builder
+	for y, linha := range modulos {
+		for x, escuro := range linha {
+			if escuro {
+				fmt.Fprintf(&caminho, "M%d %dh1v1h-1z", x, y)
+			}
+		}
+	}
+	n := len(modulos)
+	_, err := fmt.Fprintf(w,
+		`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" shape-rendering="crispEdges"><rect width="%d" height="%d" fill="#ffffff"/><path d="%s" fill="#000000"/></svg>`,
+		n, n, n, n, caminho.String())
+	return err
+}
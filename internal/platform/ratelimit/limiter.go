@@ -0,0 +1,221 @@
+// file: internal/platform/ratelimit/limiter.go
+package ratelimit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/luiszkm/wedding_backend/internal/platform/web"
+)
+
+// Config define os limites das janelas deslizantes.
+//
+// Há dois mecanismos: o limite de requisições por IP, que freia qualquer volume alto,
+// e o bloqueio por falhas, que conta apenas as chaves de acesso recusadas por IP e
+// por evento. O contador por evento pega a enumeração distribuída entre muitos IPs:
+// ao atingir FalhasPorEvento, o evento fica visado e os IPs passam a ser bloqueados
+// com FalhasPorIPEventoVisado. O evento em si nunca é bloqueado, para que um ataque
+// não tire do ar o RSVP dos convidados que têm a chave certa.
+type Config struct {
+	RequisicoesPorIP        int
+	JanelaRequisicoes       time.Duration
+	FalhasPorIP             int
+	FalhasPorEvento         int
+	FalhasPorIPEventoVisado int
+	JanelaFalhas            time.Duration
+}
+
+// ConfigPadrao é generosa para um convidado que erra a chave algumas vezes,
+// mas torna a enumeração de chaves impraticável.
+func ConfigPadrao() Config {
+	return Config{
+		RequisicoesPorIP:        60,
+		JanelaRequisicoes:       time.Minute,
+		FalhasPorIP:             10,
+		FalhasPorEvento:         100,
+		FalhasPorIPEventoVisado: 3,
+		JanelaFalhas:            15 * time.Minute,
+	}
+}
+
+// ExtratorDeEvento devolve o ID do evento alvo da requisição, ou "" quando ele não é conhecido.
+type ExtratorDeEvento func(r *http.Request) string
+
+// Limitador aplica a Config sobre uma Store.
+type Limitador struct {
+	store  Store
+	config Config
+	agora  func() time.Time
+}
+
+func NewLimitador(store Store, config Config) *Limitador {
+	return &Limitador{store: store, config: config, agora: time.Now}
+}
+
+// Proteger devolve o middleware para uma rota pública que recebe chave de acesso.
+// Respostas 404 do handler contam como chave recusada.
+func (l *Limitador) Proteger(rota string, extrairEvento ExtratorDeEvento) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			agora := l.agora()
+			ip := web.IPDoCliente(r)
+			evento := ""
+			if extrairEvento != nil {
+				evento = extrairEvento(r)
+			}
+
+			chaveFalhasIP := "falha:ip:" + ip
+			chaveFalhasEvento := "falha:evento:" + evento
+
+			// 1. Bloqueio por falhas do IP, verificado antes de qualquer trabalho. Com o
+			// evento visado, bastam poucas falhas; IPs sem falhas continuam passando.
+			limiteIP := l.config.FalhasPorIP
+			if evento != "" {
+				if _, visado := l.bloqueado(ctx, chaveFalhasEvento, l.config.FalhasPorEvento, agora); visado {
+					limiteIP = l.config.FalhasPorIPEventoVisado
+				}
+			}
+			if espera, bloqueado := l.bloqueado(ctx, chaveFalhasIP, limiteIP, agora); bloqueado {
+				l.recusar(w, r, espera)
+				return
+			}
+
+			// 2. Limite de requisições por IP. Só as requisições admitidas entram na janela,
+			// para que um IP que insiste enquanto está bloqueado não prolongue o bloqueio.
+			chaveRequisicoesIP := "req:ip:" + ip
+			total, maisAntiga, err := l.store.Contar(ctx, chaveRequisicoesIP, agora, l.config.JanelaRequisicoes)
+			if err == nil && total >= l.config.RequisicoesPorIP {
+				l.recusar(w, r, tempoDeEspera(maisAntiga, l.config.JanelaRequisicoes, agora))
+				return
+			}
+			if err == nil {
+				_, _, err = l.store.Registrar(ctx, chaveRequisicoesIP, agora, l.config.JanelaRequisicoes)
+			}
+			if err != nil {
+				// Uma falha na Store não pode derrubar o RSVP dos convidados.
+				log.Printf("ERRO no limitador de requisições: %v", err)
+			}
+
+			gravador := &gravadorDeStatus{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(gravador, r)
+			if gravador.status != http.StatusNotFound {
+				return
+			}
+
+			// 3. Chave recusada: conta a falha para o IP e para o evento.
+			l.registrarFalha(ctx, chaveFalhasIP, limiteIP, agora,
+				"possível enumeração de chaves de acesso bloqueada", rota, ip, evento)
+			if evento != "" {
+				l.registrarFalha(ctx, chaveFalhasEvento, l.config.FalhasPorEvento, agora,
+					"enumeração distribuída de chaves de acesso no evento; limite por IP reduzido", rota, ip, evento)
+			}
+		})
+	}
+}
+
+func (l *Limitador) bloqueado(ctx context.Context, chave string, limite int, agora time.Time) (time.Duration, bool) {
+	total, maisAntiga, err := l.store.Contar(ctx, chave, agora, l.config.JanelaFalhas)
+	if err != nil {
+		log.Printf("ERRO no limitador de tentativas: %v", err)
+		return 0, false
+	}
+	if total < limite {
+		return 0, false
+	}
+	return tempoDeEspera(maisAntiga, l.config.JanelaFalhas, agora), true
+}
+
+func (l *Limitador) registrarFalha(ctx context.Context, chave string, limite int, agora time.Time, alerta, rota, ip, evento string) {
+	total, _, err := l.store.Registrar(ctx, chave, agora, l.config.JanelaFalhas)
+	if err != nil {
+		log.Printf("ERRO no limitador de tentativas: %v", err)
+		return
+	}
+	// Registra no log a falha que atinge o limite, uma vez por janela.
+	if total == limite {
+		log.Printf("ALERTA: %s (rota=%s ip=%s evento=%q contador=%s falhas=%d)",
+			alerta, rota, ip, evento, chave, total)
+	}
+}
+
+func (l *Limitador) recusar(w http.ResponseWriter, r *http.Request, espera time.Duration) {
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(espera.Seconds()))))
+	web.RespondError(w, r, "MUITAS_TENTATIVAS", "Muitas tentativas. Aguarde antes de tentar novamente.", http.StatusTooManyRequests)
+}
+
+// tempoDeEspera é o tempo até a tentativa mais antiga sair da janela, com mínimo de um segundo.
+func tempoDeEspera(maisAntiga time.Time, janela time.Duration, agora time.Time) time.Duration {
+	espera := maisAntiga.Add(janela).Sub(agora)
+	if espera < time.Second {
+		return time.Second
+	}
+	return espera
+}
+
+// EventoDaQuery lê o ID do evento de um parâmetro da query string.
+func EventoDaQuery(parametro string) ExtratorDeEvento {
+	return func(r *http.Request) string {
+		return normalizarEvento(r.URL.Query().Get(parametro))
+	}
+}
+
+// tamanhoMaximoCorpoInspecionado limita quanto do corpo é lido para achar o evento.
+const tamanhoMaximoCorpoInspecionado = 1 << 20
+
+// EventoDoCorpoJSON lê o ID do evento de um campo do corpo JSON e devolve o corpo
+// intacto para que o handler possa decodificá-lo normalmente.
+func EventoDoCorpoJSON(campo string) ExtratorDeEvento {
+	return func(r *http.Request) string {
+		if r.Body == nil {
+			return ""
+		}
+		inicio, err := io.ReadAll(io.LimitReader(r.Body, tamanhoMaximoCorpoInspecionado))
+		r.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(inicio), r.Body), r.Body}
+		if err != nil {
+			return ""
+		}
+
+		var campos map[string]json.RawMessage
+		if err := json.Unmarshal(inicio, &campos); err != nil {
+			return ""
+		}
+		var evento string
+		if err := json.Unmarshal(campos[campo], &evento); err != nil {
+			return ""
+		}
+		return normalizarEvento(evento)
+	}
+}
+
+// normalizarEvento aceita apenas UUIDs, para que valores arbitrários enviados
+// pelo cliente não criem contadores na Store.
+func normalizarEvento(valor string) string {
+	id, err := uuid.Parse(valor)
+	if err != nil {
+		return ""
+	}
+	return id.String()
+}
+
+// gravadorDeStatus guarda o status escrito pelo handler.
+type gravadorDeStatus struct {
+	http.ResponseWriter
+	status int
+}
+
+func (g *gravadorDeStatus) WriteHeader(status int) {
+	g.status = status
+	g.ResponseWriter.WriteHeader(status)
+}
@@ -0,0 +1,218 @@
+// file: internal/platform/ratelimit/limiter_test.go
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// storeComErro simula a Store fora do ar.
+type storeComErro struct{}
+
+func (storeComErro) Registrar(context.Context, string, time.Time, time.Duration) (int, time.Time, error) {
+	return 0, time.Time{}, errors.New("store indisponível")
+}
+
+func (storeComErro) Contar(context.Context, string, time.Time, time.Duration) (int, time.Time, error) {
+	return 0, time.Time{}, errors.New("store indisponível")
+}
+
+func configDeTeste() Config {
+	return Config{
+		RequisicoesPorIP:        100,
+		JanelaRequisicoes:       time.Minute,
+		FalhasPorIP:             2,
+		FalhasPorEvento:         3,
+		FalhasPorIPEventoVisado: 1,
+		JanelaFalhas:            15 * time.Minute,
+	}
+}
+
+// novoLimitadorDeTeste devolve o limitador com o relógio controlado pelo teste.
+func novoLimitadorDeTeste(store Store, config Config) (*Limitador, *time.Time) {
+	agora := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	l := NewLimitador(store, config)
+	l.agora = func() time.Time { return agora }
+	return l, &agora
+}
+
+// handlerComStatus responde o status pedido no parâmetro "status" da query.
+var handlerComStatus = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Query().Get("status") {
+	case "404":
+		w.WriteHeader(http.StatusNotFound)
+	case "400":
+		w.WriteHeader(http.StatusBadRequest)
+	case "401":
+		w.WriteHeader(http.StatusUnauthorized)
+	default:
+		w.WriteHeader(http.StatusOK)
+	}
+})
+
+func requisitar(t *testing.T, handler http.Handler, ip, evento, status string) *httptest.ResponseRecorder {
+	t.Helper()
+	r := httptest.NewRequest(http.MethodGet, "/rsvps?idEvento="+evento+"&status="+status, nil)
+	r.RemoteAddr = ip + ":4321"
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w
+}
+
+func TestLimitador_Proteger(t *testing.T) {
+	t.Run("deve bloquear o IP ao atingir o limite de chaves recusadas", func(t *testing.T) {
+		l, _ := novoLimitadorDeTeste(NewMemoryStore(), configDeTeste())
+		handler := l.Proteger("rsvps", nil)(handlerComStatus)
+
+		assert.Equal(t, http.StatusNotFound, requisitar(t, handler, "10.0.0.1", "", "404").Code)
+		assert.Equal(t, http.StatusNotFound, requisitar(t, handler, "10.0.0.1", "", "404").Code)
+		assert.Equal(t, http.StatusTooManyRequests, requisitar(t, handler, "10.0.0.1", "", "").Code)
+		assert.Equal(t, http.StatusOK, requisitar(t, handler, "10.0.0.2", "", "").Code)
+	})
+
+	t.Run("deve liberar o IP quando as falhas saem da janela", func(t *testing.T) {
+		l, agora := novoLimitadorDeTeste(NewMemoryStore(), configDeTeste())
+		handler := l.Proteger("rsvps", nil)(handlerComStatus)
+
+		requisitar(t, handler, "10.0.0.1", "", "404")
+		*agora = agora.Add(10 * time.Minute)
+		requisitar(t, handler, "10.0.0.1", "", "404")
+		require.Equal(t, http.StatusTooManyRequests, requisitar(t, handler, "10.0.0.1", "", "").Code)
+
+		// A primeira falha sai da janela; a segunda ainda conta, mas sozinha não bloqueia.
+		*agora = agora.Add(5*time.Minute + time.Second)
+		assert.Equal(t, http.StatusOK, requisitar(t, handler, "10.0.0.1", "", "").Code)
+	})
+
+	t.Run("deve contar como falha apenas as respostas 404", func(t *testing.T) {
+		l, _ := novoLimitadorDeTeste(NewMemoryStore(), configDeTeste())
+		handler := l.Proteger("rsvps", nil)(handlerComStatus)
+
+		for _, status := range []string{"400", "401", "", "400", "401"} {
+			requisitar(t, handler, "10.0.0.1", "", status)
+		}
+
+		assert.Equal(t, http.StatusOK, requisitar(t, handler, "10.0.0.1", "", "").Code)
+	})
+
+	t.Run("deve responder 429 com o tempo até a falha mais antiga sair da janela", func(t *testing.T) {
+		l, agora := novoLimitadorDeTeste(NewMemoryStore(), configDeTeste())
+		handler := l.Proteger("rsvps", nil)(handlerComStatus)
+
+		requisitar(t, handler, "10.0.0.1", "", "404")
+		*agora = agora.Add(time.Minute)
+		requisitar(t, handler, "10.0.0.1", "", "404")
+		*agora = agora.Add(time.Minute + 500*time.Millisecond)
+
+		w := requisitar(t, handler, "10.0.0.1", "", "")
+
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+		assert.Equal(t, "780", w.Header().Get("Retry-After")) // 15min - 2min0,5s, arredondado para cima
+		assert.Contains(t, w.Body.String(), "MUITAS_TENTATIVAS")
+	})
+
+	t.Run("deve limitar o volume de requisições por IP", func(t *testing.T) {
+		config := configDeTeste()
+		config.RequisicoesPorIP = 2
+		l, _ := novoLimitadorDeTeste(NewMemoryStore(), config)
+		handler := l.Proteger("rsvps", nil)(handlerComStatus)
+
+		requisitar(t, handler, "10.0.0.1", "", "")
+		requisitar(t, handler, "10.0.0.1", "", "")
+		w := requisitar(t, handler, "10.0.0.1", "", "")
+
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+		assert.Equal(t, "60", w.Header().Get("Retry-After"))
+	})
+
+	t.Run("não deve prolongar o bloqueio com as requisições recusadas", func(t *testing.T) {
+		config := configDeTeste()
+		config.RequisicoesPorIP = 2
+		l, agora := novoLimitadorDeTeste(NewMemoryStore(), config)
+		handler := l.Proteger("rsvps", nil)(handlerComStatus)
+
+		requisitar(t, handler, "10.0.0.1", "", "")
+		requisitar(t, handler, "10.0.0.1", "", "")
+		for i := 0; i < 30; i++ {
+			*agora = agora.Add(time.Second)
+			w := requisitar(t, handler, "10.0.0.1", "", "")
+			require.Equal(t, http.StatusTooManyRequests, w.Code)
+			assert.Equal(t, strconv.Itoa(60-(i+1)), w.Header().Get("Retry-After"))
+		}
+
+		*agora = agora.Add(30 * time.Second)
+		assert.Equal(t, http.StatusOK, requisitar(t, handler, "10.0.0.1", "", "").Code)
+	})
+
+	t.Run("deve deixar passar quando a Store falha", func(t *testing.T) {
+		l, _ := novoLimitadorDeTeste(storeComErro{}, configDeTeste())
+		handler := l.Proteger("rsvps", EventoDaQuery("idEvento"))(handlerComStatus)
+		evento := uuid.NewString()
+
+		for i := 0; i < 5; i++ {
+			requisitar(t, handler, "10.0.0.1", evento, "404")
+		}
+
+		assert.Equal(t, http.StatusOK, requisitar(t, handler, "10.0.0.1", evento, "").Code)
+	})
+
+	t.Run("não deve bloquear o evento visado para quem não errou a chave", func(t *testing.T) {
+		l, _ := novoLimitadorDeTeste(NewMemoryStore(), configDeTeste())
+		handler := l.Proteger("rsvps", EventoDaQuery("idEvento"))(handlerComStatus)
+		evento := uuid.NewString()
+
+		// Enumeração distribuída: cada IP erra uma vez, abaixo do limite por IP.
+		for _, ip := range []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"} {
+			requisitar(t, handler, ip, evento, "404")
+		}
+
+		assert.Equal(t, http.StatusOK, requisitar(t, handler, "10.0.0.9", evento, "").Code)
+		assert.Equal(t, http.StatusTooManyRequests, requisitar(t, handler, "10.0.0.1", evento, "").Code)
+		assert.Equal(t, http.StatusOK, requisitar(t, handler, "10.0.0.1", uuid.NewString(), "").Code)
+	})
+}
+
+func TestEventoDoCorpoJSON(t *testing.T) {
+	t.Run("deve ler o evento e manter o corpo para o handler", func(t *testing.T) {
+		evento := uuid.New()
+		corpo := `{"idEvento": "` + strings.ToUpper(evento.String()) + `", "chaveDeAcesso": "FAMILIA-SILVA"}`
+		r := httptest.NewRequest(http.MethodPost, "/rsvps", strings.NewReader(corpo))
+
+		extraido := EventoDoCorpoJSON("idEvento")(r)
+
+		assert.Equal(t, evento.String(), extraido)
+		lido, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, corpo, string(lido))
+	})
+
+	t.Run("deve ignorar evento que não é UUID", func(t *testing.T) {
+		corpo := `{"idEvento": "qualquer-coisa"}`
+		r := httptest.NewRequest(http.MethodPost, "/rsvps", strings.NewReader(corpo))
+
+		assert.Empty(t, EventoDoCorpoJSON("idEvento")(r))
+		lido, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, corpo, string(lido))
+	})
+
+	t.Run("deve manter o corpo que não é JSON", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPost, "/rsvps", strings.NewReader("não é json"))
+
+		assert.Empty(t, EventoDoCorpoJSON("idEvento")(r))
+		lido, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "não é json", string(lido))
+	})
+}
@@ -0,0 +1,82 @@
+// file: internal/platform/ratelimit/postgres_store.go
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresStore guarda as tentativas na tabela tentativas_de_acesso, para que o limite
+// valha para todas as instâncias da API.
+type PostgresStore struct {
+	db        *pgxpool.Pool
+	operacoes atomic.Int64
+}
+
+// retencaoTentativas é maior que qualquer janela configurada; linhas mais velhas
+// pertencem a chaves que não voltaram e são apagadas na limpeza periódica.
+const retencaoTentativas = 24 * time.Hour
+
+func NewPostgresStore(db *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+func (s *PostgresStore) Registrar(ctx context.Context, chave string, instante time.Time, janela time.Duration) (int, time.Time, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("falha ao iniciar transação: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	limite := instante.Add(-janela)
+	// As tentativas que já saíram da janela não contam mais; aproveitamos para apagá-las.
+	if _, err := tx.Exec(ctx, "DELETE FROM tentativas_de_acesso WHERE chave = $1 AND instante <= $2", chave, limite); err != nil {
+		return 0, time.Time{}, fmt.Errorf("falha ao descartar tentativas antigas: %w", err)
+	}
+	if _, err := tx.Exec(ctx, "INSERT INTO tentativas_de_acesso (chave, instante) VALUES ($1, $2)", chave, instante); err != nil {
+		return 0, time.Time{}, fmt.Errorf("falha ao registrar tentativa: %w", err)
+	}
+
+	total, maisAntiga, err := contarTentativas(ctx, tx, chave, limite)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, time.Time{}, fmt.Errorf("falha ao confirmar tentativa: %w", err)
+	}
+
+	if s.operacoes.Add(1)%operacoesEntreLimpezas == 0 {
+		if _, err := s.db.Exec(ctx, "DELETE FROM tentativas_de_acesso WHERE instante < $1", instante.Add(-retencaoTentativas)); err != nil {
+			log.Printf("ERRO ao limpar tentativas de acesso expiradas: %v", err)
+		}
+	}
+	return total, maisAntiga, nil
+}
+
+func (s *PostgresStore) Contar(ctx context.Context, chave string, instante time.Time, janela time.Duration) (int, time.Time, error) {
+	return contarTentativas(ctx, s.db, chave, instante.Add(-janela))
+}
+
+// consultaLinha é atendida tanto pelo pool quanto por uma transação.
+type consultaLinha interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+func contarTentativas(ctx context.Context, db consultaLinha, chave string, limite time.Time) (int, time.Time, error) {
+	var total int
+	var maisAntiga *time.Time
+	sql := "SELECT COUNT(*), MIN(instante) FROM tentativas_de_acesso WHERE chave = $1 AND instante > $2"
+	if err := db.QueryRow(ctx, sql, chave, limite).Scan(&total, &maisAntiga); err != nil {
+		return 0, time.Time{}, fmt.Errorf("falha ao contar tentativas: %w", err)
+	}
+	if maisAntiga == nil {
+		return 0, time.Time{}, nil
+	}
+	return total, *maisAntiga, nil
+}
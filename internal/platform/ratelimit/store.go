@@ -0,0 +1,93 @@
+// file: internal/platform/ratelimit/store.go
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Store guarda os instantes das tentativas por chave para a janela deslizante.
+// A implementação em memória atende uma instância; a de Postgres compartilha os
+// contadores entre várias réplicas da API.
+type Store interface {
+	// Registrar adiciona uma tentativa à chave e devolve quantas existem dentro da janela,
+	// já contando a nova, e o instante da mais antiga delas.
+	Registrar(ctx context.Context, chave string, instante time.Time, janela time.Duration) (total int, maisAntiga time.Time, err error)
+	// Contar devolve quantas tentativas existem dentro da janela, sem registrar uma nova.
+	Contar(ctx context.Context, chave string, instante time.Time, janela time.Duration) (total int, maisAntiga time.Time, err error)
+}
+
+// operacoesEntreLimpezas controla a frequência com que a MemoryStore descarta chaves expiradas.
+const operacoesEntreLimpezas = 1000
+
+type registroMemoria struct {
+	instantes []time.Time
+	janela    time.Duration
+}
+
+// MemoryStore é a Store padrão, mantida no processo.
+type MemoryStore struct {
+	mu        sync.Mutex
+	registros map[string]*registroMemoria
+	operacoes int
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{registros: make(map[string]*registroMemoria)}
+}
+
+func (s *MemoryStore) Registrar(_ context.Context, chave string, instante time.Time, janela time.Duration) (int, time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.limparSeNecessario(instante)
+	registro, ok := s.registros[chave]
+	if !ok {
+		registro = &registroMemoria{}
+		s.registros[chave] = registro
+	}
+	registro.janela = janela
+	registro.instantes = append(descartarAntigos(registro.instantes, instante.Add(-janela)), instante)
+	return len(registro.instantes), registro.instantes[0], nil
+}
+
+func (s *MemoryStore) Contar(_ context.Context, chave string, instante time.Time, janela time.Duration) (int, time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	registro, ok := s.registros[chave]
+	if !ok {
+		return 0, time.Time{}, nil
+	}
+	registro.instantes = descartarAntigos(registro.instantes, instante.Add(-janela))
+	if len(registro.instantes) == 0 {
+		delete(s.registros, chave)
+		return 0, time.Time{}, nil
+	}
+	return len(registro.instantes), registro.instantes[0], nil
+}
+
+// limparSeNecessario remove, de tempos em tempos, as chaves cuja última tentativa
+// já saiu da janela, para que IPs que não voltam não fiquem para sempre no mapa.
+func (s *MemoryStore) limparSeNecessario(instante time.Time) {
+	s.operacoes++
+	if s.operacoes < operacoesEntreLimpezas {
+		return
+	}
+	s.operacoes = 0
+	for chave, registro := range s.registros {
+		if len(registro.instantes) == 0 || !registro.instantes[len(registro.instantes)-1].After(instante.Add(-registro.janela)) {
+			delete(s.registros, chave)
+		}
+	}
+}
+
+// descartarAntigos remove os instantes iguais ou anteriores a limite; a fatia está em ordem crescente.
+func descartarAntigos(instantes []time.Time, limite time.Time) []time.Time {
+	i := 0
+	for i < len(instantes) && !instantes[i].After(limite) {
+		i++
+	}
+	return instantes[i:]
+}
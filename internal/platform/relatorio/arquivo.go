@@ -0,0 +1,29 @@
+// file: internal/platform/relatorio/arquivo.go
+package relatorio
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/luiszkm/wedding_backend/internal/platform/web"
+)
+
+// EnviarArquivo gera o arquivo em memória e só então o envia como download. Assim uma
+// falha na geração ainda vira uma resposta JSON de erro, em vez de um arquivo cortado
+// com status 200.
+func EnviarArquivo(w http.ResponseWriter, r *http.Request, nomeArquivo, contentType string, gerar func(io.Writer) error) {
+	var buf bytes.Buffer
+	if err := gerar(&buf); err != nil {
+		log.Printf("ERRO ao gerar %s: %v\n", nomeArquivo, err)
+		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao gerar o arquivo.", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", nomeArquivo))
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
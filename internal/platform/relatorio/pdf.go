@@ -0,0 +1,25 @@
+// file: internal/platform/relatorio/pdf.go
+package relatorio
+
+import "github.com/go-pdf/fpdf"
+
+// NovoPDF cria um documento A4, em milímetros, e o tradutor que todo texto precisa
+// atravessar antes de ir para a página: as fontes padrão do PDF usam cp1252, e o
+// tradutor converte os acentos do UTF-8.
+func NovoPDF(orientacao, titulo string) (*fpdf.Fpdf, func(string) string) {
+	pdf := fpdf.New(orientacao, "mm", "A4", "")
+	pdf.SetTitle(titulo, true)
+	return pdf, pdf.UnicodeTranslatorFromDescriptor("")
+}
+
+// TruncarParaLargura corta o texto para caber na célula e o devolve já traduzido.
+func TruncarParaLargura(pdf *fpdf.Fpdf, tr func(string) string, texto string, largura float64) string {
+	if pdf.GetStringWidth(tr(texto)) <= largura {
+		return tr(texto)
+	}
+	runas := []rune(texto)
+	for len(runas) > 0 && pdf.GetStringWidth(tr(string(runas)+"...")) > largura {
+		runas = runas[:len(runas)-1]
+	}
+	return tr(string(runas) + "...")
+}
@@ -0,0 +1,27 @@
+// file: internal/platform/relatorio/planilha.go
+package relatorio
+
+import "strings"
+
+// iniciosDeFormula são os caracteres com que Excel, LibreOffice e Google Sheets começam
+// a interpretar uma célula de CSV como fórmula.
+const iniciosDeFormula = "=+-@\t\r"
+
+// NeutralizarFormula prefixa com apóstrofo o valor que a planilha leria como fórmula.
+// Os nomes e as observações vêm dos próprios convidados, e uma célula como
+// =HYPERLINK(...) viraria uma fórmula viva no Excel do anfitrião.
+func NeutralizarFormula(valor string) string {
+	if valor != "" && strings.ContainsRune(iniciosDeFormula, rune(valor[0])) {
+		return "'" + valor
+	}
+	return valor
+}
+
+// RestaurarFormula desfaz NeutralizarFormula, para que um CSV exportado possa ser
+// importado de volta.
+func RestaurarFormula(valor string) string {
+	if len(valor) > 1 && valor[0] == '\'' && strings.ContainsRune(iniciosDeFormula, rune(valor[1])) {
+		return valor[1:]
+	}
+	return valor
+}
@@ -0,0 +1,186 @@
+// file: internal/platform/template/engine.go
+package template
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DiretorioPartials é o diretório (relativo à raiz de templates) cujos arquivos
+// ficam disponíveis para todo template como {{template "partials/<arquivo>" .}}.
+const DiretorioPartials = "partials"
+
+var (
+	ErrTemplateNaoEncontrado = errors.New("template não encontrado")
+	ErrNomeTemplateInvalido  = errors.New("nome de template inválido")
+)
+
+// GoTemplateEngine renderiza os templates de página usando html/template.
+// Os templates são carregados sob demanda e mantidos em cache depois de compilados.
+type GoTemplateEngine struct {
+	arquivos fs.FS
+
+	mu    sync.RWMutex
+	cache map[string]*template.Template
+}
+
+// NewGoTemplateEngine cria um engine que lê os templates do diretório informado.
+func NewGoTemplateEngine(diretorioBase string) *GoTemplateEngine {
+	return NewGoTemplateEngineFS(os.DirFS(diretorioBase))
+}
+
+// NewGoTemplateEngineFS cria um engine a partir de um sistema de arquivos qualquer (útil em testes e para embed).
+func NewGoTemplateEngineFS(arquivos fs.FS) *GoTemplateEngine {
+	return &GoTemplateEngine{
+		arquivos: arquivos,
+		cache:    make(map[string]*template.Template),
+	}
+}
+
+// RenderEventPage executa o template indicado (ex: "standard/template_moderno.html")
+// e devolve o HTML completo. Nada é escrito se a execução falhar no meio.
+func (e *GoTemplateEngine) RenderEventPage(arquivo string, data any) ([]byte, error) {
+	tmpl, err := e.carregar(arquivo)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, arquivo, data); err != nil {
+		return nil, fmt.Errorf("falha ao executar template %s: %w", arquivo, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// LimparCache descarta todos os templates compilados, forçando a releitura do disco.
+func (e *GoTemplateEngine) LimparCache() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cache = make(map[string]*template.Template)
+}
+
+// TemplatesEmCache retorna os nomes dos templates já compilados.
+func (e *GoTemplateEngine) TemplatesEmCache() []string {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	nomes := make([]string, 0, len(e.cache))
+	for nome := range e.cache {
+		nomes = append(nomes, nome)
+	}
+	return nomes
+}
+
+func (e *GoTemplateEngine) carregar(arquivo string) (*template.Template, error) {
+	// fs.ValidPath rejeita caminhos absolutos e "..", impedindo leitura fora da raiz de templates.
+	if !fs.ValidPath(arquivo) || path.Ext(arquivo) != ".html" || strings.HasPrefix(arquivo, DiretorioPartials+"/") {
+		return nil, ErrNomeTemplateInvalido
+	}
+
+	e.mu.RLock()
+	tmpl, ok := e.cache[arquivo]
+	e.mu.RUnlock()
+	if ok {
+		return tmpl, nil
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	// Outro goroutine pode ter compilado o template enquanto esperávamos o lock.
+	if tmpl, ok := e.cache[arquivo]; ok {
+		return tmpl, nil
+	}
+
+	tmpl, err := e.compilar(arquivo)
+	if err != nil {
+		return nil, err
+	}
+	e.cache[arquivo] = tmpl
+	return tmpl, nil
+}
+
+func (e *GoTemplateEngine) compilar(arquivo string) (*template.Template, error) {
+	conteudo, err := fs.ReadFile(e.arquivos, arquivo)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %s", ErrTemplateNaoEncontrado, arquivo)
+		}
+		return nil, fmt.Errorf("falha ao ler template %s: %w", arquivo, err)
+	}
+
+	raiz := template.New(arquivo).Funcs(funcoes())
+
+	partials, err := fs.Glob(e.arquivos, DiretorioPartials+"/*.html")
+	if err != nil {
+		return nil, fmt.Errorf("falha ao listar partials: %w", err)
+	}
+	for _, partial := range partials {
+		conteudoPartial, err := fs.ReadFile(e.arquivos, partial)
+		if err != nil {
+			return nil, fmt.Errorf("falha ao ler partial %s: %w", partial, err)
+		}
+		if _, err := raiz.New(partial).Parse(string(conteudoPartial)); err != nil {
+			return nil, fmt.Errorf("falha ao compilar partial %s: %w", partial, err)
+		}
+	}
+
+	if _, err := raiz.Parse(string(conteudo)); err != nil {
+		return nil, fmt.Errorf("falha ao compilar template %s: %w", arquivo, err)
+	}
+	return raiz, nil
+}
+
+var mesesPTBR = strings.NewReplacer(
+	"January", "Janeiro", "February", "Fevereiro", "March", "Março",
+	"April", "Abril", "May", "Maio", "June", "Junho",
+	"July", "Julho", "August", "Agosto", "September", "Setembro",
+	"October", "Outubro", "November", "Novembro", "December", "Dezembro",
+)
+
+// funcoes são as funções disponíveis em todos os templates (ver docs/template-developer-guide.md).
+func funcoes() template.FuncMap {
+	return template.FuncMap{
+		"upper": strings.ToUpper,
+		"lower": strings.ToLower,
+		"title": func(s string) string {
+			palavras := strings.Fields(strings.ToLower(s))
+			for i, p := range palavras {
+				runas := []rune(p)
+				palavras[i] = strings.ToUpper(string(runas[0])) + string(runas[1:])
+			}
+			return strings.Join(palavras, " ")
+		},
+		"truncate": func(limite int, s string) string {
+			runas := []rune(s)
+			if len(runas) <= limite {
+				return s
+			}
+			return string(runas[:limite]) + "…"
+		},
+		// formatDate usa o layout do pacote time e traduz os nomes dos meses para português.
+		"formatDate": func(layout string, t time.Time) string {
+			return mesesPTBR.Replace(t.Format(layout))
+		},
+		"dict": func(pares ...any) (map[string]any, error) {
+			if len(pares)%2 != 0 {
+				return nil, errors.New("dict requer um número par de argumentos")
+			}
+			m := make(map[string]any, len(pares)/2)
+			for i := 0; i < len(pares); i += 2 {
+				chave, ok := pares[i].(string)
+				if !ok {
+					return nil, errors.New("as chaves de dict devem ser strings")
+				}
+				m[chave] = pares[i+1]
+			}
+			return m, nil
+		},
+	}
+}
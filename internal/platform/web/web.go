@@ -3,6 +3,7 @@ package web
 
 import (
 	"encoding/json"
+	"net"
 	"net/http"
 )
 
@@ -35,3 +36,13 @@ func RespondError(w http.ResponseWriter, r *http.Request, codigo string, mensage
 	}
 	Respond(w, r, errResponse, statusCode)
 }
+
+// IPDoCliente usa RemoteAddr. Atrás de um proxy reverso confiável, registre
+// middleware.RealIP antes para que o endereço real seja considerado.
+func IPDoCliente(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,161 @@
+// file: internal/seating/application/service.go
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/luiszkm/wedding_backend/internal/seating/domain"
+)
+
+type SeatingService struct {
+	mesaRepo      domain.MesaRepository
+	convidadoRepo domain.ConvidadoRepository
+}
+
+func NewSeatingService(mesaRepo domain.MesaRepository, convidadoRepo domain.ConvidadoRepository) *SeatingService {
+	return &SeatingService{mesaRepo: mesaRepo, convidadoRepo: convidadoRepo}
+}
+
+// PlanoDeMesas reúne as mesas do evento e os confirmados que ainda não têm lugar.
+type PlanoDeMesas struct {
+	Mesas    []*domain.Mesa
+	SemMesa  []domain.Convidado
+	GeradoEm time.Time
+}
+
+// ResultadoDistribuicao traz o plano depois da distribuição automática, em que SemMesa são os
+// confirmados que não couberam, e quantos convidados foram sentados.
+type ResultadoDistribuicao struct {
+	Plano    *PlanoDeMesas
+	Sentados int
+}
+
+func (s *SeatingService) ObterPlanoDeMesas(ctx context.Context, userID, eventID uuid.UUID) (*PlanoDeMesas, error) {
+	mesas, err := s.mesaRepo.FindAllByEventID(ctx, userID, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao listar mesas: %w", err)
+	}
+	confirmados, err := s.convidadoRepo.FindConfirmadosByEventID(ctx, userID, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao listar convidados confirmados: %w", err)
+	}
+	return &PlanoDeMesas{
+		Mesas:    mesas,
+		SemMesa:  domain.ConvidadosSemMesa(mesas, confirmados),
+		GeradoEm: time.Now(),
+	}, nil
+}
+
+func (s *SeatingService) CriarMesa(ctx context.Context, userID, eventID uuid.UUID, nome string, capacidade int, formato string) (*domain.Mesa, error) {
+	mesa, err := domain.NewMesa(eventID, nome, capacidade, formato)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.mesaRepo.Save(ctx, userID, mesa); err != nil {
+		return nil, fmt.Errorf("falha ao salvar mesa: %w", err)
+	}
+	return mesa, nil
+}
+
+func (s *SeatingService) EditarMesa(ctx context.Context, userID, mesaID uuid.UUID, nome string, capacidade int, formato string) (*domain.Mesa, error) {
+	mesa, err := s.mesaRepo.FindByID(ctx, userID, mesaID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao buscar mesa: %w", err)
+	}
+	if err := mesa.Editar(nome, capacidade, formato); err != nil {
+		return nil, err
+	}
+	if err := s.mesaRepo.Update(ctx, userID, mesa); err != nil {
+		return nil, fmt.Errorf("falha ao atualizar mesa: %w", err)
+	}
+	return mesa, nil
+}
+
+func (s *SeatingService) RemoverMesa(ctx context.Context, userID, mesaID uuid.UUID) error {
+	if err := s.mesaRepo.Delete(ctx, userID, mesaID); err != nil {
+		return fmt.Errorf("falha ao remover mesa: %w", err)
+	}
+	return nil
+}
+
+// SentarConvidado coloca o convidado no assento da mesa (0 para o primeiro livre). Se ele
+// estava em outra mesa, muda de mesa na mesma gravação.
+func (s *SeatingService) SentarConvidado(ctx context.Context, userID, mesaID, convidadoID uuid.UUID, assento int) (*domain.Mesa, error) {
+	mesa, err := s.mesaRepo.FindByID(ctx, userID, mesaID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao buscar mesa: %w", err)
+	}
+	convidado, err := s.convidadoRepo.FindByID(ctx, userID, convidadoID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao buscar convidado: %w", err)
+	}
+
+	mesas, err := s.mesaRepo.FindAllByEventID(ctx, userID, mesa.IDEvento())
+	if err != nil {
+		return nil, fmt.Errorf("falha ao listar mesas: %w", err)
+	}
+	alteradas := []*domain.Mesa{mesa}
+	for _, outra := range mesas {
+		if outra.ID() != mesa.ID() && outra.TemConvidado(convidado.ID) {
+			if err := outra.Levantar(convidado.ID); err != nil {
+				return nil, err
+			}
+			alteradas = append(alteradas, outra)
+		}
+	}
+
+	if err := mesa.Sentar(*convidado, assento); err != nil {
+		return nil, err
+	}
+	if err := s.mesaRepo.SalvarAssentos(ctx, userID, alteradas...); err != nil {
+		return nil, fmt.Errorf("falha ao salvar assentos: %w", err)
+	}
+	return mesa, nil
+}
+
+func (s *SeatingService) LevantarConvidado(ctx context.Context, userID, mesaID, convidadoID uuid.UUID) error {
+	mesa, err := s.mesaRepo.FindByID(ctx, userID, mesaID)
+	if err != nil {
+		return fmt.Errorf("falha ao buscar mesa: %w", err)
+	}
+	if err := mesa.Levantar(convidadoID); err != nil {
+		return err
+	}
+	if err := s.mesaRepo.SalvarAssentos(ctx, userID, mesa); err != nil {
+		return fmt.Errorf("falha ao salvar assentos: %w", err)
+	}
+	return nil
+}
+
+// DistribuirAutomaticamente senta os confirmados sem mesa mantendo os grupos juntos. Com
+// reiniciar, esvazia todas as mesas antes, inclusive de quem deixou de estar confirmado.
+func (s *SeatingService) DistribuirAutomaticamente(ctx context.Context, userID, eventID uuid.UUID, reiniciar bool) (*ResultadoDistribuicao, error) {
+	mesas, err := s.mesaRepo.FindAllByEventID(ctx, userID, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao listar mesas: %w", err)
+	}
+	confirmados, err := s.convidadoRepo.FindConfirmadosByEventID(ctx, userID, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao listar convidados confirmados: %w", err)
+	}
+
+	if reiniciar {
+		for _, m := range mesas {
+			m.Esvaziar()
+		}
+	}
+	semMesa := domain.ConvidadosSemMesa(mesas, confirmados)
+	naoAlocados := domain.DistribuirConvidados(mesas, confirmados)
+
+	if err := s.mesaRepo.SalvarAssentos(ctx, userID, mesas...); err != nil {
+		return nil, fmt.Errorf("falha ao salvar assentos: %w", err)
+	}
+
+	return &ResultadoDistribuicao{
+		Plano:    &PlanoDeMesas{Mesas: mesas, SemMesa: naoAlocados, GeradoEm: time.Now()},
+		Sentados: len(semMesa) - len(naoAlocados),
+	}, nil
+}
@@ -0,0 +1,111 @@
+// file: internal/seating/domain/distribuicao.go
+package domain
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// ConvidadosSemMesa devolve os convidados confirmados que ainda não estão em nenhuma mesa,
+// na ordem recebida.
+func ConvidadosSemMesa(mesas []*Mesa, convidados []Convidado) []Convidado {
+	sentados := make(map[uuid.UUID]bool)
+	for _, m := range mesas {
+		for _, a := range m.assentos {
+			sentados[a.Convidado.ID] = true
+		}
+	}
+	semMesa := []Convidado{}
+	for _, c := range convidados {
+		if c.StatusRSVP == StatusRSVPConfirmado && !sentados[c.ID] {
+			semMesa = append(semMesa, c)
+		}
+	}
+	return semMesa
+}
+
+// DistribuirConvidados senta automaticamente os confirmados sem mesa, mantendo cada grupo
+// junto. Os grupos maiores são sentados primeiro, cada um na mesa em que cabe inteiro com
+// menos sobra, preferindo a mesa onde parte do grupo já está. Um grupo que não cabe inteiro
+// em nenhuma mesa é dividido entre as mesas mais vazias. Os assentos já ocupados são mantidos.
+// Devolve os convidados que ficaram sem lugar.
+func DistribuirConvidados(mesas []*Mesa, convidados []Convidado) []Convidado {
+	var ordemGrupos []uuid.UUID
+	porGrupo := make(map[uuid.UUID][]Convidado)
+	for _, c := range ConvidadosSemMesa(mesas, convidados) {
+		if _, ok := porGrupo[c.IDGrupo]; !ok {
+			ordemGrupos = append(ordemGrupos, c.IDGrupo)
+		}
+		porGrupo[c.IDGrupo] = append(porGrupo[c.IDGrupo], c)
+	}
+	sort.SliceStable(ordemGrupos, func(i, j int) bool {
+		return len(porGrupo[ordemGrupos[i]]) > len(porGrupo[ordemGrupos[j]])
+	})
+
+	naoAlocados := []Convidado{}
+	for _, idGrupo := range ordemGrupos {
+		grupo := porGrupo[idGrupo]
+		if mesa := mesaParaGrupo(mesas, idGrupo, len(grupo)); mesa != nil {
+			sentarTodos(mesa, grupo)
+			continue
+		}
+		for len(grupo) > 0 {
+			mesa := mesaMaisVazia(mesas)
+			if mesa == nil {
+				naoAlocados = append(naoAlocados, grupo...)
+				break
+			}
+			n := min(mesa.LugaresLivres(), len(grupo))
+			sentarTodos(mesa, grupo[:n])
+			grupo = grupo[n:]
+		}
+	}
+	return naoAlocados
+}
+
+// mesaParaGrupo escolhe a mesa onde o grupo cabe inteiro: primeiro as que já têm alguém do
+// grupo, depois a de menor sobra. Devolve nil se o grupo não cabe inteiro em nenhuma.
+func mesaParaGrupo(mesas []*Mesa, idGrupo uuid.UUID, tamanho int) *Mesa {
+	var escolhida *Mesa
+	escolhidaTemGrupo := false
+	for _, m := range mesas {
+		if m.LugaresLivres() < tamanho {
+			continue
+		}
+		temGrupo := m.temGrupo(idGrupo)
+		switch {
+		case escolhida == nil,
+			temGrupo && !escolhidaTemGrupo,
+			temGrupo == escolhidaTemGrupo && m.LugaresLivres() < escolhida.LugaresLivres():
+			escolhida, escolhidaTemGrupo = m, temGrupo
+		}
+	}
+	return escolhida
+}
+
+func mesaMaisVazia(mesas []*Mesa) *Mesa {
+	var escolhida *Mesa
+	for _, m := range mesas {
+		if m.LugaresLivres() > 0 && (escolhida == nil || m.LugaresLivres() > escolhida.LugaresLivres()) {
+			escolhida = m
+		}
+	}
+	return escolhida
+}
+
+func sentarTodos(mesa *Mesa, convidados []Convidado) {
+	for _, c := range convidados {
+		// Os convidados já foram filtrados e a mesa tem lugares para todos.
+		_ = mesa.Sentar(c, 0)
+	}
+}
+
+func (m *Mesa) temGrupo(idGrupo uuid.UUID) bool {
+	for _, a := range m.assentos {
+		if a.Convidado.IDGrupo == idGrupo {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+// file: internal/seating/domain/distribuicao_test.go
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// mesaDe devolve o nome da mesa onde o convidado foi sentado, ou "" se ficou sem lugar.
+func mesaDe(mesas []*Mesa, c Convidado) string {
+	for _, m := range mesas {
+		if m.TemConvidado(c.ID) {
+			return m.Nome()
+		}
+	}
+	return ""
+}
+
+func grupoDe(idEvento uuid.UUID, nomes ...string) []Convidado {
+	idGrupo := uuid.New()
+	convidados := make([]Convidado, len(nomes))
+	for i, nome := range nomes {
+		convidados[i] = novoConvidado(idEvento, idGrupo, nome)
+	}
+	return convidados
+}
+
+func TestDistribuirConvidados(t *testing.T) {
+	idEvento := uuid.New()
+
+	t.Run("deve manter os grupos juntos na mesa com menos sobra", func(t *testing.T) {
+		grande, _ := NewMesa(idEvento, "Grande", 6, FormatoRetangular)
+		pequena, _ := NewMesa(idEvento, "Pequena", 3, FormatoRedonda)
+		familia := grupoDe(idEvento, "Ana", "Bruno", "Carla")
+		amigos := grupoDe(idEvento, "Davi", "Eva", "Fábio", "Gil")
+		convidados := append(append([]Convidado{}, familia...), amigos...)
+
+		naoAlocados := DistribuirConvidados([]*Mesa{grande, pequena}, convidados)
+
+		assert.Empty(t, naoAlocados)
+		for _, c := range amigos {
+			assert.Equal(t, "Grande", mesaDe([]*Mesa{grande, pequena}, c))
+		}
+		for _, c := range familia {
+			assert.Equal(t, "Pequena", mesaDe([]*Mesa{grande, pequena}, c))
+		}
+	})
+
+	t.Run("deve preferir a mesa onde parte do grupo já está e manter os assentos existentes", func(t *testing.T) {
+		mesa1, _ := NewMesa(idEvento, "Mesa 1", 4, FormatoRedonda)
+		mesa2, _ := NewMesa(idEvento, "Mesa 2", 2, FormatoRedonda)
+		familia := grupoDe(idEvento, "Ana", "Bruno")
+		assert.NoError(t, mesa1.Sentar(familia[0], 4))
+
+		naoAlocados := DistribuirConvidados([]*Mesa{mesa1, mesa2}, familia)
+
+		assert.Empty(t, naoAlocados)
+		assert.Equal(t, 4, mesa1.Assentos()[1].Numero)
+		assert.Equal(t, "Mesa 1", mesaDe([]*Mesa{mesa1, mesa2}, familia[1]))
+	})
+
+	t.Run("deve dividir o grupo que não cabe inteiro e devolver quem ficou sem lugar", func(t *testing.T) {
+		mesa1, _ := NewMesa(idEvento, "Mesa 1", 2, FormatoRedonda)
+		mesa2, _ := NewMesa(idEvento, "Mesa 2", 1, FormatoRedonda)
+		familia := grupoDe(idEvento, "Ana", "Bruno", "Carla", "Davi")
+
+		naoAlocados := DistribuirConvidados([]*Mesa{mesa1, mesa2}, familia)
+
+		assert.Equal(t, []Convidado{familia[3]}, naoAlocados)
+		assert.Equal(t, 0, mesa1.LugaresLivres())
+		assert.Equal(t, 0, mesa2.LugaresLivres())
+	})
+
+	t.Run("deve ignorar convidados não confirmados", func(t *testing.T) {
+		mesa, _ := NewMesa(idEvento, "Mesa 1", 4, FormatoRedonda)
+		familia := grupoDe(idEvento, "Ana", "Bruno")
+		familia[1].StatusRSVP = "RECUSADO"
+
+		naoAlocados := DistribuirConvidados([]*Mesa{mesa}, familia)
+
+		assert.Empty(t, naoAlocados)
+		assert.True(t, mesa.TemConvidado(familia[0].ID))
+		assert.False(t, mesa.TemConvidado(familia[1].ID))
+	})
+}
+
+func TestConvidadosSemMesa(t *testing.T) {
+	idEvento := uuid.New()
+	mesa, _ := NewMesa(idEvento, "Mesa 1", 4, FormatoRedonda)
+	familia := grupoDe(idEvento, "Ana", "Bruno", "Carla")
+	familia[2].StatusRSVP = "PENDENTE"
+	assert.NoError(t, mesa.Sentar(familia[0], 0))
+
+	assert.Equal(t, []Convidado{familia[1]}, ConvidadosSemMesa([]*Mesa{mesa}, familia))
+}
@@ -0,0 +1,197 @@
+// file: internal/seating/domain/mesa.go
+package domain
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Formatos de mesa aceitos; o formato só orienta a montagem do salão.
+const (
+	FormatoRedonda    = "REDONDA"
+	FormatoRetangular = "RETANGULAR"
+	FormatoQuadrada   = "QUADRADA"
+)
+
+// StatusRSVPConfirmado é o único status que permite sentar o convidado.
+const StatusRSVPConfirmado = "CONFIRMADO"
+
+const (
+	tamanhoMaximoNomeMesa = 100
+	capacidadeMaximaMesa  = 50
+)
+
+var (
+	ErrNomeMesaInvalido           = errors.New("o nome da mesa é obrigatório e deve ter até 100 caracteres")
+	ErrCapacidadeInvalida         = errors.New("a capacidade da mesa deve ser entre 1 e 50 lugares")
+	ErrFormatoMesaInvalido        = errors.New("formato de mesa inválido")
+	ErrCapacidadeMenorQueOcupacao = errors.New("a nova capacidade deixaria de fora convidados já sentados na mesa")
+	ErrMesaNaoEncontrada          = errors.New("mesa não encontrada")
+	ErrMesaJaExiste               = errors.New("já existe uma mesa com este nome no evento")
+	ErrMesaLotada                 = errors.New("a mesa não tem lugares livres")
+	ErrAssentoInvalido            = errors.New("o assento deve estar entre 1 e a capacidade da mesa")
+	ErrAssentoOcupado             = errors.New("o assento já está ocupado")
+	ErrConvidadoNaoEncontrado     = errors.New("convidado não encontrado")
+	ErrConvidadoNaoConfirmado     = errors.New("apenas convidados com presença confirmada podem ser sentados")
+	ErrConvidadoJaSentado         = errors.New("o convidado já está sentado em outra mesa")
+	ErrConvidadoForaDaMesa        = errors.New("o convidado não está sentado nesta mesa")
+	ErrEventoNaoEncontrado        = errors.New("evento não encontrado")
+)
+
+// Convidado é a visão do convidado usada pelo plano de mesas; os dados vêm do contexto de convidados.
+type Convidado struct {
+	ID         uuid.UUID
+	IDEvento   uuid.UUID
+	IDGrupo    uuid.UUID
+	Nome       string
+	StatusRSVP string
+}
+
+// Assento é um lugar numerado da mesa ocupado por um convidado.
+type Assento struct {
+	Numero    int
+	Convidado Convidado
+}
+
+type Mesa struct {
+	id         uuid.UUID
+	idEvento   uuid.UUID
+	nome       string
+	capacidade int
+	formato    string
+	assentos   []Assento
+}
+
+func NewMesa(idEvento uuid.UUID, nome string, capacidade int, formato string) (*Mesa, error) {
+	m := &Mesa{id: uuid.New(), idEvento: idEvento}
+	if err := m.Editar(nome, capacidade, formato); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func HydrateMesa(id, idEvento uuid.UUID, nome string, capacidade int, formato string, assentos []Assento) *Mesa {
+	m := &Mesa{id: id, idEvento: idEvento, nome: nome, capacidade: capacidade, formato: formato, assentos: assentos}
+	m.ordenarAssentos()
+	return m
+}
+
+// Editar altera os dados da mesa. A nova capacidade não pode deixar de fora um assento ocupado.
+func (m *Mesa) Editar(nome string, capacidade int, formato string) error {
+	nome = strings.Join(strings.Fields(nome), " ")
+	if nome == "" || utf8.RuneCountInString(nome) > tamanhoMaximoNomeMesa {
+		return ErrNomeMesaInvalido
+	}
+	if capacidade < 1 || capacidade > capacidadeMaximaMesa {
+		return ErrCapacidadeInvalida
+	}
+	switch formato {
+	case FormatoRedonda, FormatoRetangular, FormatoQuadrada:
+	default:
+		return ErrFormatoMesaInvalido
+	}
+	for _, a := range m.assentos {
+		if a.Numero > capacidade {
+			return ErrCapacidadeMenorQueOcupacao
+		}
+	}
+
+	m.nome = nome
+	m.capacidade = capacidade
+	m.formato = formato
+	return nil
+}
+
+// Sentar coloca o convidado no assento informado, ou no primeiro livre quando numero é 0.
+// Se o convidado já está nesta mesa, ele muda de assento.
+func (m *Mesa) Sentar(convidado Convidado, numero int) error {
+	if convidado.IDEvento != m.idEvento {
+		return ErrConvidadoNaoEncontrado
+	}
+	if convidado.StatusRSVP != StatusRSVPConfirmado {
+		return ErrConvidadoNaoConfirmado
+	}
+	if numero < 0 || numero > m.capacidade {
+		return ErrAssentoInvalido
+	}
+
+	// Ignora o lugar atual do próprio convidado para permitir a troca de assento.
+	ocupados := make(map[int]bool, len(m.assentos))
+	for _, a := range m.assentos {
+		if a.Convidado.ID != convidado.ID {
+			ocupados[a.Numero] = true
+		}
+	}
+	if numero == 0 {
+		numero = primeiroLivre(ocupados, m.capacidade)
+		if numero == 0 {
+			return ErrMesaLotada
+		}
+	} else if ocupados[numero] {
+		return ErrAssentoOcupado
+	}
+
+	m.removerConvidado(convidado.ID)
+	m.assentos = append(m.assentos, Assento{Numero: numero, Convidado: convidado})
+	m.ordenarAssentos()
+	return nil
+}
+
+// Levantar libera o assento do convidado.
+func (m *Mesa) Levantar(idConvidado uuid.UUID) error {
+	if !m.removerConvidado(idConvidado) {
+		return ErrConvidadoForaDaMesa
+	}
+	return nil
+}
+
+// Esvaziar libera todos os assentos da mesa.
+func (m *Mesa) Esvaziar() {
+	m.assentos = nil
+}
+
+// TemConvidado informa se o convidado está sentado nesta mesa.
+func (m *Mesa) TemConvidado(idConvidado uuid.UUID) bool {
+	for _, a := range m.assentos {
+		if a.Convidado.ID == idConvidado {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Mesa) LugaresLivres() int { return m.capacidade - len(m.assentos) }
+
+func (m *Mesa) ID() uuid.UUID       { return m.id }
+func (m *Mesa) IDEvento() uuid.UUID { return m.idEvento }
+func (m *Mesa) Nome() string        { return m.nome }
+func (m *Mesa) Capacidade() int     { return m.capacidade }
+func (m *Mesa) Formato() string     { return m.formato }
+func (m *Mesa) Assentos() []Assento { return m.assentos }
+
+func (m *Mesa) removerConvidado(idConvidado uuid.UUID) bool {
+	for i, a := range m.assentos {
+		if a.Convidado.ID == idConvidado {
+			m.assentos = append(m.assentos[:i], m.assentos[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Mesa) ordenarAssentos() {
+	sort.Slice(m.assentos, func(i, j int) bool { return m.assentos[i].Numero < m.assentos[j].Numero })
+}
+
+func primeiroLivre(ocupados map[int]bool, capacidade int) int {
+	for n := 1; n <= capacidade; n++ {
+		if !ocupados[n] {
+			return n
+		}
+	}
+	return 0
+}
@@ -0,0 +1,119 @@
+// file: internal/seating/domain/mesa_test.go
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func novoConvidado(idEvento, idGrupo uuid.UUID, nome string) Convidado {
+	return Convidado{ID: uuid.New(), IDEvento: idEvento, IDGrupo: idGrupo, Nome: nome, StatusRSVP: StatusRSVPConfirmado}
+}
+
+func TestNewMesa(t *testing.T) {
+	idEvento := uuid.New()
+
+	t.Run("deve criar mesa com dados válidos", func(t *testing.T) {
+		mesa, err := NewMesa(idEvento, "  Mesa   dos padrinhos ", 8, FormatoRedonda)
+
+		assert.NoError(t, err)
+		assert.NotEqual(t, uuid.Nil, mesa.ID())
+		assert.Equal(t, "Mesa dos padrinhos", mesa.Nome())
+		assert.Equal(t, 8, mesa.Capacidade())
+		assert.Equal(t, 8, mesa.LugaresLivres())
+	})
+
+	t.Run("deve validar nome, capacidade e formato", func(t *testing.T) {
+		_, err := NewMesa(idEvento, " ", 8, FormatoRedonda)
+		assert.Equal(t, ErrNomeMesaInvalido, err)
+
+		_, err = NewMesa(idEvento, strings.Repeat("a", 101), 8, FormatoRedonda)
+		assert.Equal(t, ErrNomeMesaInvalido, err)
+
+		_, err = NewMesa(idEvento, "Mesa 1", 0, FormatoRedonda)
+		assert.Equal(t, ErrCapacidadeInvalida, err)
+
+		_, err = NewMesa(idEvento, "Mesa 1", 51, FormatoRedonda)
+		assert.Equal(t, ErrCapacidadeInvalida, err)
+
+		_, err = NewMesa(idEvento, "Mesa 1", 8, "OVAL")
+		assert.Equal(t, ErrFormatoMesaInvalido, err)
+	})
+}
+
+func TestMesa_Editar(t *testing.T) {
+	idEvento := uuid.New()
+
+	t.Run("não deve reduzir a capacidade abaixo de um assento ocupado", func(t *testing.T) {
+		mesa, _ := NewMesa(idEvento, "Mesa 1", 8, FormatoRedonda)
+		assert.NoError(t, mesa.Sentar(novoConvidado(idEvento, uuid.New(), "Ana"), 6))
+
+		err := mesa.Editar("Mesa 1", 5, FormatoRedonda)
+
+		assert.Equal(t, ErrCapacidadeMenorQueOcupacao, err)
+		assert.Equal(t, 8, mesa.Capacidade())
+		assert.NoError(t, mesa.Editar("Mesa principal", 6, FormatoRetangular))
+		assert.Equal(t, FormatoRetangular, mesa.Formato())
+	})
+}
+
+func TestMesa_Sentar(t *testing.T) {
+	idEvento := uuid.New()
+	idGrupo := uuid.New()
+
+	t.Run("deve sentar no primeiro assento livre quando nenhum é informado", func(t *testing.T) {
+		mesa, _ := NewMesa(idEvento, "Mesa 1", 4, FormatoRedonda)
+		ana := novoConvidado(idEvento, idGrupo, "Ana")
+		bruno := novoConvidado(idEvento, idGrupo, "Bruno")
+
+		assert.NoError(t, mesa.Sentar(ana, 1))
+		assert.NoError(t, mesa.Sentar(bruno, 0))
+
+		assert.Equal(t, []Assento{{Numero: 1, Convidado: ana}, {Numero: 2, Convidado: bruno}}, mesa.Assentos())
+		assert.Equal(t, 2, mesa.LugaresLivres())
+	})
+
+	t.Run("deve trocar o convidado de assento na mesma mesa", func(t *testing.T) {
+		mesa, _ := NewMesa(idEvento, "Mesa 1", 4, FormatoRedonda)
+		ana := novoConvidado(idEvento, idGrupo, "Ana")
+		assert.NoError(t, mesa.Sentar(ana, 1))
+
+		assert.NoError(t, mesa.Sentar(ana, 3))
+
+		assert.Equal(t, []Assento{{Numero: 3, Convidado: ana}}, mesa.Assentos())
+	})
+
+	t.Run("deve recusar convidado não confirmado ou de outro evento", func(t *testing.T) {
+		mesa, _ := NewMesa(idEvento, "Mesa 1", 4, FormatoRedonda)
+		pendente := novoConvidado(idEvento, idGrupo, "Ana")
+		pendente.StatusRSVP = "PENDENTE"
+
+		assert.Equal(t, ErrConvidadoNaoConfirmado, mesa.Sentar(pendente, 0))
+		assert.Equal(t, ErrConvidadoNaoEncontrado, mesa.Sentar(novoConvidado(uuid.New(), idGrupo, "Bruno"), 0))
+		assert.Empty(t, mesa.Assentos())
+	})
+
+	t.Run("deve respeitar a capacidade e os assentos ocupados", func(t *testing.T) {
+		mesa, _ := NewMesa(idEvento, "Mesa 1", 2, FormatoQuadrada)
+		assert.NoError(t, mesa.Sentar(novoConvidado(idEvento, idGrupo, "Ana"), 2))
+
+		assert.Equal(t, ErrAssentoOcupado, mesa.Sentar(novoConvidado(idEvento, idGrupo, "Bruno"), 2))
+		assert.Equal(t, ErrAssentoInvalido, mesa.Sentar(novoConvidado(idEvento, idGrupo, "Bruno"), 3))
+		assert.NoError(t, mesa.Sentar(novoConvidado(idEvento, idGrupo, "Bruno"), 0))
+		assert.Equal(t, ErrMesaLotada, mesa.Sentar(novoConvidado(idEvento, idGrupo, "Carla"), 0))
+	})
+}
+
+func TestMesa_Levantar(t *testing.T) {
+	idEvento := uuid.New()
+	mesa, _ := NewMesa(idEvento, "Mesa 1", 4, FormatoRedonda)
+	ana := novoConvidado(idEvento, uuid.New(), "Ana")
+	assert.NoError(t, mesa.Sentar(ana, 0))
+
+	assert.NoError(t, mesa.Levantar(ana.ID))
+	assert.False(t, mesa.TemConvidado(ana.ID))
+	assert.Equal(t, ErrConvidadoForaDaMesa, mesa.Levantar(ana.ID))
+}
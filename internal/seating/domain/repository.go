@@ -0,0 +1,28 @@
+// file: internal/seating/domain/repository.go
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type MesaRepository interface {
+	// Save devolve ErrEventoNaoEncontrado se o evento não pertencer ao usuário.
+	Save(ctx context.Context, userID uuid.UUID, mesa *Mesa) error
+	// Update grava nome, capacidade e formato; os assentos são gravados por SalvarAssentos.
+	Update(ctx context.Context, userID uuid.UUID, mesa *Mesa) error
+	Delete(ctx context.Context, userID, mesaID uuid.UUID) error
+	FindByID(ctx context.Context, userID, mesaID uuid.UUID) (*Mesa, error)
+	// FindAllByEventID devolve as mesas com seus assentos, na ordem de criação.
+	FindAllByEventID(ctx context.Context, userID, eventID uuid.UUID) ([]*Mesa, error)
+	// SalvarAssentos substitui, em uma única transação, os assentos das mesas informadas.
+	SalvarAssentos(ctx context.Context, userID uuid.UUID, mesas ...*Mesa) error
+}
+
+// ConvidadoRepository lê os convidados do evento para o plano de mesas.
+type ConvidadoRepository interface {
+	FindByID(ctx context.Context, userID, convidadoID uuid.UUID) (*Convidado, error)
+	// FindConfirmadosByEventID devolve os confirmados do evento, agrupados pelo grupo de convidados.
+	FindConfirmadosByEventID(ctx context.Context, userID, eventID uuid.UUID) ([]Convidado, error)
+}
@@ -0,0 +1,70 @@
+// file: internal/seating/infrastructure/postgres_convidado_repository.go
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/luiszkm/wedding_backend/internal/seating/domain"
+)
+
+// PostgresConvidadoRepository lê as tabelas do contexto de convidados; o plano de mesas não as altera.
+type PostgresConvidadoRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPostgresConvidadoRepository(db *pgxpool.Pool) domain.ConvidadoRepository {
+	return &PostgresConvidadoRepository{db: db}
+}
+
+func (r *PostgresConvidadoRepository) FindByID(ctx context.Context, userID, convidadoID uuid.UUID) (*domain.Convidado, error) {
+	sql := `
+		SELECT c.id, g.id_evento, g.id, c.nome, c.status_rsvp
+		FROM convidados c
+		JOIN convidados_grupos g ON g.id = c.id_grupo
+		JOIN eventos e ON e.id = g.id_evento
+		WHERE c.id = $1 AND e.id_usuario = $2 AND c.removido_em IS NULL
+	`
+	var c domain.Convidado
+	err := r.db.QueryRow(ctx, sql, convidadoID, userID).Scan(&c.ID, &c.IDEvento, &c.IDGrupo, &c.Nome, &c.StatusRSVP)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrConvidadoNaoEncontrado
+		}
+		return nil, fmt.Errorf("falha ao buscar convidado: %w", err)
+	}
+	return &c, nil
+}
+
+func (r *PostgresConvidadoRepository) FindConfirmadosByEventID(ctx context.Context, userID, eventID uuid.UUID) ([]domain.Convidado, error) {
+	sql := `
+		SELECT c.id, g.id_evento, g.id, c.nome, c.status_rsvp
+		FROM convidados c
+		JOIN convidados_grupos g ON g.id = c.id_grupo
+		JOIN eventos e ON e.id = g.id_evento
+		WHERE g.id_evento = $1 AND e.id_usuario = $2 AND c.status_rsvp = $3 AND c.removido_em IS NULL
+		ORDER BY g.created_at, g.id, c.nome
+	`
+	rows, err := r.db.Query(ctx, sql, eventID, userID, domain.StatusRSVPConfirmado)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao consultar convidados confirmados: %w", err)
+	}
+	defer rows.Close()
+
+	convidados := []domain.Convidado{}
+	for rows.Next() {
+		var c domain.Convidado
+		if err := rows.Scan(&c.ID, &c.IDEvento, &c.IDGrupo, &c.Nome, &c.StatusRSVP); err != nil {
+			return nil, fmt.Errorf("falha ao escanear convidado: %w", err)
+		}
+		convidados = append(convidados, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("erro durante iteração dos convidados: %w", err)
+	}
+	return convidados, nil
+}
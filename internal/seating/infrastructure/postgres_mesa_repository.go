@@ -0,0 +1,260 @@
+// file: internal/seating/infrastructure/postgres_mesa_repository.go
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/luiszkm/wedding_backend/internal/seating/domain"
+)
+
+// SQLSTATE e índices do Postgres tratados pelo repositório.
+const (
+	codigoViolacaoUnique        = "23505"
+	indiceNomeMesa              = "idx_mesas_evento_nome"
+	indiceConvidadoUnicoSentado = "idx_mesas_assentos_convidado"
+)
+
+type PostgresMesaRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPostgresMesaRepository(db *pgxpool.Pool) domain.MesaRepository {
+	return &PostgresMesaRepository{db: db}
+}
+
+func (r *PostgresMesaRepository) Save(ctx context.Context, userID uuid.UUID, mesa *domain.Mesa) error {
+	sql := `
+		INSERT INTO mesas (id, id_evento, nome, capacidade, formato)
+		SELECT $1, $2, $3, $4, $5
+		WHERE EXISTS(SELECT 1 FROM eventos WHERE id = $2 AND id_usuario = $6)
+	`
+	cmdTag, err := r.db.Exec(ctx, sql, mesa.ID(), mesa.IDEvento(), mesa.Nome(), mesa.Capacidade(), mesa.Formato(), userID)
+	if err != nil {
+		return traduzirErroMesa(err, "falha ao inserir mesa")
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return domain.ErrEventoNaoEncontrado
+	}
+	return nil
+}
+
+func (r *PostgresMesaRepository) Update(ctx context.Context, userID uuid.UUID, mesa *domain.Mesa) error {
+	sql := `
+		UPDATE mesas SET nome = $1, capacidade = $2, formato = $3, updated_at = NOW()
+		WHERE id = $4 AND id_evento IN (SELECT id FROM eventos WHERE id_usuario = $5)
+	`
+	cmdTag, err := r.db.Exec(ctx, sql, mesa.Nome(), mesa.Capacidade(), mesa.Formato(), mesa.ID(), userID)
+	if err != nil {
+		return traduzirErroMesa(err, "falha ao atualizar mesa")
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return domain.ErrMesaNaoEncontrada
+	}
+	return nil
+}
+
+// Delete remove a mesa; os assentos caem em cascata e os convidados voltam a ficar sem mesa.
+func (r *PostgresMesaRepository) Delete(ctx context.Context, userID, mesaID uuid.UUID) error {
+	sql := `
+		DELETE FROM mesas
+		WHERE id = $1 AND id_evento IN (SELECT id FROM eventos WHERE id_usuario = $2)
+	`
+	cmdTag, err := r.db.Exec(ctx, sql, mesaID, userID)
+	if err != nil {
+		return fmt.Errorf("falha ao remover mesa: %w", err)
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return domain.ErrMesaNaoEncontrada
+	}
+	return nil
+}
+
+func (r *PostgresMesaRepository) FindByID(ctx context.Context, userID, mesaID uuid.UUID) (*domain.Mesa, error) {
+	sql := `
+		SELECT m.id, m.id_evento, m.nome, m.capacidade, m.formato
+		FROM mesas m JOIN eventos e ON m.id_evento = e.id
+		WHERE m.id = $1 AND e.id_usuario = $2
+	`
+	mesas, err := r.consultarMesas(ctx, sql, mesaID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(mesas) == 0 {
+		return nil, domain.ErrMesaNaoEncontrada
+	}
+	return mesas[0], nil
+}
+
+func (r *PostgresMesaRepository) FindAllByEventID(ctx context.Context, userID, eventID uuid.UUID) ([]*domain.Mesa, error) {
+	var existe bool
+	checkSQL := `SELECT EXISTS(SELECT 1 FROM eventos WHERE id = $1 AND id_usuario = $2)`
+	if err := r.db.QueryRow(ctx, checkSQL, eventID, userID).Scan(&existe); err != nil {
+		return nil, fmt.Errorf("falha ao verificar propriedade do evento: %w", err)
+	}
+	if !existe {
+		return nil, domain.ErrEventoNaoEncontrado
+	}
+
+	sql := `
+		SELECT id, id_evento, nome, capacidade, formato
+		FROM mesas
+		WHERE id_evento = $1
+		ORDER BY created_at, nome
+	`
+	return r.consultarMesas(ctx, sql, eventID)
+}
+
+// consultarMesas executa a consulta das mesas e carrega os assentos de todas elas de uma vez.
+func (r *PostgresMesaRepository) consultarMesas(ctx context.Context, sql string, args ...any) ([]*domain.Mesa, error) {
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao consultar mesas: %w", err)
+	}
+	defer rows.Close()
+
+	type dadosMesa struct {
+		id, idEvento uuid.UUID
+		nome         string
+		capacidade   int
+		formato      string
+	}
+	var dados []dadosMesa
+	var ids []string
+	for rows.Next() {
+		var d dadosMesa
+		if err := rows.Scan(&d.id, &d.idEvento, &d.nome, &d.capacidade, &d.formato); err != nil {
+			return nil, fmt.Errorf("falha ao escanear mesa: %w", err)
+		}
+		dados = append(dados, d)
+		ids = append(ids, d.id.String())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("erro durante iteração das mesas: %w", err)
+	}
+
+	assentos, err := r.carregarAssentos(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	mesas := make([]*domain.Mesa, len(dados))
+	for i, d := range dados {
+		mesas[i] = domain.HydrateMesa(d.id, d.idEvento, d.nome, d.capacidade, d.formato, assentos[d.id])
+	}
+	return mesas, nil
+}
+
+// carregarAssentos lê os assentos com os dados atuais dos convidados; assentos de convidados
+// removidos ficam de fora.
+func (r *PostgresMesaRepository) carregarAssentos(ctx context.Context, idsMesas []string) (map[uuid.UUID][]domain.Assento, error) {
+	assentos := make(map[uuid.UUID][]domain.Assento)
+	if len(idsMesas) == 0 {
+		return assentos, nil
+	}
+
+	sql := `
+		SELECT a.id_mesa, a.numero, c.id, g.id_evento, g.id, c.nome, c.status_rsvp
+		FROM mesas_assentos a
+		JOIN convidados c ON c.id = a.id_convidado
+		JOIN convidados_grupos g ON g.id = c.id_grupo
+		WHERE a.id_mesa = ANY($1::uuid[]) AND c.removido_em IS NULL
+		ORDER BY a.numero
+	`
+	rows, err := r.db.Query(ctx, sql, idsMesas)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao consultar assentos: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var idMesa uuid.UUID
+		var a domain.Assento
+		if err := rows.Scan(&idMesa, &a.Numero, &a.Convidado.ID, &a.Convidado.IDEvento, &a.Convidado.IDGrupo, &a.Convidado.Nome, &a.Convidado.StatusRSVP); err != nil {
+			return nil, fmt.Errorf("falha ao escanear assento: %w", err)
+		}
+		assentos[idMesa] = append(assentos[idMesa], a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("erro durante iteração dos assentos: %w", err)
+	}
+	return assentos, nil
+}
+
+func (r *PostgresMesaRepository) SalvarAssentos(ctx context.Context, userID uuid.UUID, mesas ...*domain.Mesa) error {
+	if len(mesas) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("falha ao iniciar transação para salvar assentos: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	ids := make([]string, len(mesas))
+	for i, m := range mesas {
+		ids[i] = m.ID().String()
+	}
+
+	// Trava as mesas para que gravações concorrentes no mesmo plano não se intercalem.
+	lockSQL := `
+		SELECT m.id FROM mesas m JOIN eventos e ON m.id_evento = e.id
+		WHERE m.id = ANY($1::uuid[]) AND e.id_usuario = $2
+		FOR UPDATE OF m
+	`
+	rows, err := tx.Query(ctx, lockSQL, ids, userID)
+	if err != nil {
+		return fmt.Errorf("falha ao travar mesas: %w", err)
+	}
+	travadas := 0
+	for rows.Next() {
+		travadas++
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("falha ao travar mesas: %w", err)
+	}
+	if travadas != len(mesas) {
+		return domain.ErrMesaNaoEncontrada
+	}
+
+	if _, err := tx.Exec(ctx, "DELETE FROM mesas_assentos WHERE id_mesa = ANY($1::uuid[])", ids); err != nil {
+		return fmt.Errorf("falha ao remover assentos antigos: %w", err)
+	}
+
+	var linhas [][]any
+	for _, m := range mesas {
+		for _, a := range m.Assentos() {
+			linhas = append(linhas, []any{m.ID(), a.Numero, a.Convidado.ID})
+		}
+	}
+	if len(linhas) > 0 {
+		_, err := tx.CopyFrom(ctx, pgx.Identifier{"mesas_assentos"}, []string{"id_mesa", "numero", "id_convidado"}, pgx.CopyFromRows(linhas))
+		if err != nil {
+			return traduzirErroMesa(err, "falha ao inserir assentos")
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func traduzirErroMesa(err error, contexto string) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == codigoViolacaoUnique {
+		switch pgErr.ConstraintName {
+		case indiceNomeMesa:
+			return domain.ErrMesaJaExiste
+		case indiceConvidadoUnicoSentado:
+			return domain.ErrConvidadoJaSentado
+		default:
+			return domain.ErrAssentoOcupado
+		}
+	}
+	return fmt.Errorf("%s: %w", contexto, err)
+}
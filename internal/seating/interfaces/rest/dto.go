@@ -0,0 +1,46 @@
+// file: internal/seating/interfaces/rest/dto.go
+package rest
+
+type MesaRequestDTO struct {
+	Nome       string `json:"nome"`
+	Capacidade int    `json:"capacidade"`
+	Formato    string `json:"formato"`
+}
+
+// SentarConvidadoRequestDTO é opcional; sem assento, o convidado vai para o primeiro lugar livre.
+type SentarConvidadoRequestDTO struct {
+	Assento int `json:"assento"`
+}
+
+type ConvidadoMesaDTO struct {
+	ID         string `json:"id"`
+	IDGrupo    string `json:"idGrupo"`
+	Nome       string `json:"nome"`
+	StatusRSVP string `json:"statusRSVP"`
+}
+
+type AssentoDTO struct {
+	Numero    int              `json:"numero"`
+	Convidado ConvidadoMesaDTO `json:"convidado"`
+}
+
+type MesaDTO struct {
+	ID            string       `json:"id"`
+	Nome          string       `json:"nome"`
+	Capacidade    int          `json:"capacidade"`
+	Formato       string       `json:"formato"`
+	LugaresLivres int          `json:"lugaresLivres"`
+	Assentos      []AssentoDTO `json:"assentos"`
+}
+
+type PlanoDeMesasDTO struct {
+	Mesas         []MesaDTO          `json:"mesas"`
+	SemMesa       []ConvidadoMesaDTO `json:"semMesa"`
+	TotalLugares  int                `json:"totalLugares"`
+	TotalSentados int                `json:"totalSentados"`
+}
+
+type DistribuicaoResponseDTO struct {
+	Sentados int `json:"sentados"`
+	PlanoDeMesasDTO
+}
@@ -0,0 +1,104 @@
+// file: internal/seating/interfaces/rest/exportacao.go
+package rest
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/luiszkm/wedding_backend/internal/platform/relatorio"
+	"github.com/luiszkm/wedding_backend/internal/seating/application"
+	"github.com/luiszkm/wedding_backend/internal/seating/domain"
+)
+
+// Formatos aceitos pelo endpoint de exportação.
+const (
+	formatoPDF = "pdf"
+	formatoCSV = "csv"
+)
+
+var contentTypesExportacao = map[string]string{
+	formatoPDF: "application/pdf",
+	formatoCSV: "text/csv; charset=utf-8",
+}
+
+var cabecalhoExportacao = []string{"Mesa", "Assento", "Convidado", "Status RSVP"}
+
+// observacaoStatus destaca quem foi sentado e depois deixou de estar confirmado.
+func observacaoStatus(c domain.Convidado) string {
+	if c.StatusRSVP == domain.StatusRSVPConfirmado {
+		return ""
+	}
+	return " (" + strings.ToLower(c.StatusRSVP) + ")"
+}
+
+// escreverCSVPlanoDeMesas gera uma linha por assento ocupado, seguida dos confirmados sem mesa,
+// com ponto e vírgula, BOM UTF-8 e fórmulas neutralizadas como a exportação de convidados.
+func escreverCSVPlanoDeMesas(w io.Writer, plano *application.PlanoDeMesas) error {
+	if _, err := io.WriteString(w, "\xef\xbb\xbf"); err != nil {
+		return err
+	}
+	escritor := csv.NewWriter(w)
+	escritor.Comma = ';'
+	if err := escritor.Write(cabecalhoExportacao); err != nil {
+		return err
+	}
+	for _, m := range plano.Mesas {
+		for _, a := range m.Assentos() {
+			if err := escritor.Write([]string{relatorio.NeutralizarFormula(m.Nome()), strconv.Itoa(a.Numero), relatorio.NeutralizarFormula(a.Convidado.Nome), a.Convidado.StatusRSVP}); err != nil {
+				return err
+			}
+		}
+	}
+	for _, c := range plano.SemMesa {
+		if err := escritor.Write([]string{"", "", relatorio.NeutralizarFormula(c.Nome), c.StatusRSVP}); err != nil {
+			return err
+		}
+	}
+	escritor.Flush()
+	return escritor.Error()
+}
+
+// escreverPDFPlanoDeMesas gera uma página por mesa, para imprimir e deixar na mesa ou na
+// recepção, e uma página final com os confirmados que ainda não têm lugar.
+func escreverPDFPlanoDeMesas(w io.Writer, plano *application.PlanoDeMesas) error {
+	pdf, tr := relatorio.NovoPDF("P", "Plano de Mesas")
+	pdf.SetAutoPageBreak(true, 15)
+
+	const larguraAssento, larguraNome = 25.0, 155.0
+	for _, m := range plano.Mesas {
+		pdf.AddPage()
+		pdf.SetFont("Helvetica", "B", 20)
+		pdf.CellFormat(0, 12, tr(m.Nome()), "", 1, "L", false, 0, "")
+		pdf.SetFont("Helvetica", "", 11)
+		ocupacao := fmt.Sprintf("%s - %d de %d lugares ocupados", strings.ToLower(m.Formato()), len(m.Assentos()), m.Capacidade())
+		pdf.CellFormat(0, 7, tr("Mesa "+ocupacao), "", 1, "L", false, 0, "")
+		pdf.Ln(4)
+
+		pdf.SetFont("Helvetica", "B", 11)
+		pdf.SetFillColor(230, 230, 230)
+		pdf.CellFormat(larguraAssento, 8, "Assento", "1", 0, "L", true, 0, "")
+		pdf.CellFormat(larguraNome, 8, "Convidado", "1", 1, "L", true, 0, "")
+		pdf.SetFont("Helvetica", "", 11)
+		for _, a := range m.Assentos() {
+			pdf.CellFormat(larguraAssento, 8, strconv.Itoa(a.Numero), "1", 0, "L", false, 0, "")
+			nome := a.Convidado.Nome + observacaoStatus(a.Convidado)
+			pdf.CellFormat(larguraNome, 8, relatorio.TruncarParaLargura(pdf, tr, nome, larguraNome-2), "1", 1, "L", false, 0, "")
+		}
+	}
+
+	if len(plano.SemMesa) > 0 || len(plano.Mesas) == 0 {
+		pdf.AddPage()
+		pdf.SetFont("Helvetica", "B", 20)
+		pdf.CellFormat(0, 12, tr("Confirmados sem mesa"), "", 1, "L", false, 0, "")
+		pdf.Ln(4)
+		pdf.SetFont("Helvetica", "", 11)
+		for _, c := range plano.SemMesa {
+			pdf.CellFormat(larguraAssento+larguraNome, 8, relatorio.TruncarParaLargura(pdf, tr, c.Nome, larguraAssento+larguraNome-2), "B", 1, "L", false, 0, "")
+		}
+	}
+
+	return pdf.Output(w)
+}
@@ -0,0 +1,316 @@
+// file: internal/seating/interfaces/rest/handler.go
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/luiszkm/wedding_backend/internal/platform/auth"
+	"github.com/luiszkm/wedding_backend/internal/platform/relatorio"
+	"github.com/luiszkm/wedding_backend/internal/platform/web"
+	"github.com/luiszkm/wedding_backend/internal/seating/application"
+	"github.com/luiszkm/wedding_backend/internal/seating/domain"
+)
+
+type SeatingHandler struct {
+	service *application.SeatingService
+}
+
+func NewSeatingHandler(service *application.SeatingService) *SeatingHandler {
+	return &SeatingHandler{service: service}
+}
+
+func (h *SeatingHandler) HandleObterPlanoDeMesas(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	eventID, err := uuid.Parse(chi.URLParam(r, "idEvento"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do evento é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	plano, err := h.service.ObterPlanoDeMesas(r.Context(), userID, eventID)
+	if err != nil {
+		responderErroMesa(w, r, err)
+		return
+	}
+
+	web.Respond(w, r, toPlanoDeMesasDTO(plano), http.StatusOK)
+}
+
+func (h *SeatingHandler) HandleCriarMesa(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	eventID, err := uuid.Parse(chi.URLParam(r, "idEvento"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do evento é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	var reqDTO MesaRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&reqDTO); err != nil {
+		web.RespondError(w, r, "CORPO_INVALIDO", "O corpo da requisição está malformado.", http.StatusBadRequest)
+		return
+	}
+
+	mesa, err := h.service.CriarMesa(r.Context(), userID, eventID, reqDTO.Nome, reqDTO.Capacidade, reqDTO.Formato)
+	if err != nil {
+		responderErroMesa(w, r, err)
+		return
+	}
+
+	web.Respond(w, r, toMesaDTO(mesa), http.StatusCreated)
+}
+
+func (h *SeatingHandler) HandleEditarMesa(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	mesaID, err := uuid.Parse(chi.URLParam(r, "idMesa"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID da mesa é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	var reqDTO MesaRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&reqDTO); err != nil {
+		web.RespondError(w, r, "CORPO_INVALIDO", "O corpo da requisição está malformado.", http.StatusBadRequest)
+		return
+	}
+
+	mesa, err := h.service.EditarMesa(r.Context(), userID, mesaID, reqDTO.Nome, reqDTO.Capacidade, reqDTO.Formato)
+	if err != nil {
+		responderErroMesa(w, r, err)
+		return
+	}
+
+	web.Respond(w, r, toMesaDTO(mesa), http.StatusOK)
+}
+
+func (h *SeatingHandler) HandleRemoverMesa(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	mesaID, err := uuid.Parse(chi.URLParam(r, "idMesa"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID da mesa é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.RemoverMesa(r.Context(), userID, mesaID); err != nil {
+		responderErroMesa(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *SeatingHandler) HandleSentarConvidado(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	mesaID, err := uuid.Parse(chi.URLParam(r, "idMesa"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID da mesa é inválido.", http.StatusBadRequest)
+		return
+	}
+	convidadoID, err := uuid.Parse(chi.URLParam(r, "idConvidado"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do convidado é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	// O corpo é opcional: sem ele, o convidado vai para o primeiro lugar livre.
+	var reqDTO SentarConvidadoRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&reqDTO); err != nil && !errors.Is(err, io.EOF) {
+		web.RespondError(w, r, "CORPO_INVALIDO", "O corpo da requisição está malformado.", http.StatusBadRequest)
+		return
+	}
+
+	mesa, err := h.service.SentarConvidado(r.Context(), userID, mesaID, convidadoID, reqDTO.Assento)
+	if err != nil {
+		responderErroMesa(w, r, err)
+		return
+	}
+
+	web.Respond(w, r, toMesaDTO(mesa), http.StatusOK)
+}
+
+func (h *SeatingHandler) HandleLevantarConvidado(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	mesaID, err := uuid.Parse(chi.URLParam(r, "idMesa"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID da mesa é inválido.", http.StatusBadRequest)
+		return
+	}
+	convidadoID, err := uuid.Parse(chi.URLParam(r, "idConvidado"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do convidado é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.LevantarConvidado(r.Context(), userID, mesaID, convidadoID); err != nil {
+		responderErroMesa(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *SeatingHandler) HandleDistribuirConvidados(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	eventID, err := uuid.Parse(chi.URLParam(r, "idEvento"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do evento é inválido.", http.StatusBadRequest)
+		return
+	}
+	reiniciar := false
+	if valor := r.URL.Query().Get("reiniciar"); valor != "" {
+		if reiniciar, err = strconv.ParseBool(valor); err != nil {
+			web.RespondError(w, r, "PARAMETRO_INVALIDO", "O parâmetro 'reiniciar' deve ser true ou false.", http.StatusBadRequest)
+			return
+		}
+	}
+
+	resultado, err := h.service.DistribuirAutomaticamente(r.Context(), userID, eventID, reiniciar)
+	if err != nil {
+		responderErroMesa(w, r, err)
+		return
+	}
+
+	web.Respond(w, r, DistribuicaoResponseDTO{
+		Sentados:        resultado.Sentados,
+		PlanoDeMesasDTO: toPlanoDeMesasDTO(resultado.Plano),
+	}, http.StatusOK)
+}
+
+func (h *SeatingHandler) HandleExportarPlanoDeMesas(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(auth.UserContextKey).(uuid.UUID)
+	if !ok {
+		web.RespondError(w, r, "TOKEN_INVALIDO", "ID de usuário ausente ou inválido no token.", http.StatusUnauthorized)
+		return
+	}
+	eventID, err := uuid.Parse(chi.URLParam(r, "idEvento"))
+	if err != nil {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "O ID do evento é inválido.", http.StatusBadRequest)
+		return
+	}
+
+	formato := r.URL.Query().Get("formato")
+	if formato == "" {
+		formato = formatoPDF
+	}
+	contentType, ok := contentTypesExportacao[formato]
+	if !ok {
+		web.RespondError(w, r, "PARAMETRO_INVALIDO", "Formato inválido. Use pdf ou csv.", http.StatusBadRequest)
+		return
+	}
+
+	plano, err := h.service.ObterPlanoDeMesas(r.Context(), userID, eventID)
+	if err != nil {
+		responderErroMesa(w, r, err)
+		return
+	}
+
+	escrever := escreverPDFPlanoDeMesas
+	if formato == formatoCSV {
+		escrever = escreverCSVPlanoDeMesas
+	}
+	nomeArquivo := fmt.Sprintf("mesas-%s.%s", plano.GeradoEm.Format("2006-01-02"), formato)
+	relatorio.EnviarArquivo(w, r, nomeArquivo, contentType, func(saida io.Writer) error {
+		return escrever(saida, plano)
+	})
+}
+
+func responderErroMesa(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, domain.ErrNomeMesaInvalido), errors.Is(err, domain.ErrCapacidadeInvalida),
+		errors.Is(err, domain.ErrFormatoMesaInvalido), errors.Is(err, domain.ErrAssentoInvalido):
+		web.RespondError(w, r, "DADOS_INVALIDOS", err.Error(), http.StatusBadRequest)
+	case errors.Is(err, domain.ErrEventoNaoEncontrado):
+		web.RespondError(w, r, "EVENTO_NAO_ENCONTRADO", "Evento não encontrado.", http.StatusNotFound)
+	case errors.Is(err, domain.ErrMesaNaoEncontrada):
+		web.RespondError(w, r, "MESA_NAO_ENCONTRADA", "Mesa não encontrada.", http.StatusNotFound)
+	case errors.Is(err, domain.ErrConvidadoNaoEncontrado):
+		web.RespondError(w, r, "CONVIDADO_NAO_ENCONTRADO", "Convidado não encontrado.", http.StatusNotFound)
+	case errors.Is(err, domain.ErrConvidadoForaDaMesa):
+		web.RespondError(w, r, "CONVIDADO_FORA_DA_MESA", err.Error(), http.StatusNotFound)
+	case errors.Is(err, domain.ErrMesaJaExiste):
+		web.RespondError(w, r, "MESA_EXISTENTE", err.Error(), http.StatusConflict)
+	case errors.Is(err, domain.ErrCapacidadeMenorQueOcupacao):
+		web.RespondError(w, r, "CAPACIDADE_INSUFICIENTE", err.Error(), http.StatusConflict)
+	case errors.Is(err, domain.ErrMesaLotada):
+		web.RespondError(w, r, "MESA_LOTADA", err.Error(), http.StatusConflict)
+	case errors.Is(err, domain.ErrAssentoOcupado):
+		web.RespondError(w, r, "ASSENTO_OCUPADO", err.Error(), http.StatusConflict)
+	case errors.Is(err, domain.ErrConvidadoJaSentado):
+		web.RespondError(w, r, "CONVIDADO_JA_SENTADO", "O convidado mudou de mesa durante a operação. Tente novamente.", http.StatusConflict)
+	case errors.Is(err, domain.ErrConvidadoNaoConfirmado):
+		web.RespondError(w, r, "CONVIDADO_NAO_CONFIRMADO", err.Error(), http.StatusUnprocessableEntity)
+	default:
+		log.Printf("ERRO: %v\n", err)
+		web.RespondError(w, r, "ERRO_INTERNO", "Falha ao processar sua requisição.", http.StatusInternalServerError)
+	}
+}
+
+func toConvidadoMesaDTO(c domain.Convidado) ConvidadoMesaDTO {
+	return ConvidadoMesaDTO{ID: c.ID.String(), IDGrupo: c.IDGrupo.String(), Nome: c.Nome, StatusRSVP: c.StatusRSVP}
+}
+
+func toMesaDTO(mesa *domain.Mesa) MesaDTO {
+	assentos := make([]AssentoDTO, len(mesa.Assentos()))
+	for i, a := range mesa.Assentos() {
+		assentos[i] = AssentoDTO{Numero: a.Numero, Convidado: toConvidadoMesaDTO(a.Convidado)}
+	}
+	return MesaDTO{
+		ID:            mesa.ID().String(),
+		Nome:          mesa.Nome(),
+		Capacidade:    mesa.Capacidade(),
+		Formato:       mesa.Formato(),
+		LugaresLivres: mesa.LugaresLivres(),
+		Assentos:      assentos,
+	}
+}
+
+func toPlanoDeMesasDTO(plano *application.PlanoDeMesas) PlanoDeMesasDTO {
+	dto := PlanoDeMesasDTO{
+		Mesas:   make([]MesaDTO, len(plano.Mesas)),
+		SemMesa: make([]ConvidadoMesaDTO, len(plano.SemMesa)),
+	}
+	for i, m := range plano.Mesas {
+		dto.Mesas[i] = toMesaDTO(m)
+		dto.TotalLugares += m.Capacidade()
+		dto.TotalSentados += len(m.Assentos())
+	}
+	for i, c := range plano.SemMesa {
+		dto.SemMesa[i] = toConvidadoMesaDTO(c)
+	}
+	return dto
+}